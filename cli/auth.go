@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/JECSand/go-grpc-server-boilerplate/client"
+	authsService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
+	"os"
+)
+
+// defaultProfile is the profile logged in to when none is named
+const defaultProfile = "default"
+
+// login logs in to a server, or stores an API key for it, and caches the credentials in a profile that becomes the
+// default
+func (c *CLI) login(args []string) error {
+	fs := c.newFlagSet("login")
+	server := fs.String("server", "", "host:port of the gRPC server, required for a new profile")
+	email := fs.String("email", "", "email to log in with")
+	password := fs.String("password", "", "password to log in with, overrides TASKCTL_PASSWORD; read from stdin when neither is set")
+	apiKey := fs.String("api-key", "", "authenticate with an API key instead of logging in")
+	useTLS := fs.Bool("tls", false, "connect with TLS")
+	caFile := fs.String("ca-file", "", "PEM file of the CA that signed the server certificate")
+	insecure := fs.Bool("insecure-skip-verify", false, "skip verification of the server certificate")
+	if _, err := c.parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	cfg, err := loadConfig(c.configPath())
+	if err != nil {
+		return err
+	}
+	name := c.profile
+	if name == "" {
+		name = defaultProfile
+	}
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		p = &Profile{}
+	}
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "server":
+			p.Address = *server
+		case "email":
+			p.Email = *email
+		case "tls":
+			p.TLS = *useTLS
+		case "ca-file":
+			p.CAFile = *caFile
+		case "insecure-skip-verify":
+			p.InsecureSkipVerify = *insecure
+		}
+	})
+	if p.Address == "" {
+		return errors.New("login: -server is required for a new profile")
+	}
+	ctx := context.Background()
+	if *apiKey != "" {
+		p.APIKey, p.Token = *apiKey, ""
+	} else {
+		if p.Email == "" {
+			return errors.New("login: -email or -api-key is required")
+		}
+		if *password == "" {
+			*password = os.Getenv("TASKCTL_PASSWORD")
+		}
+		if *password == "" {
+			if *password, err = c.readPassword(); err != nil {
+				return err
+			}
+		}
+		cl, err := c.dial(ctx, p, client.Options{})
+		if err != nil {
+			return err
+		}
+		defer cl.Close()
+		if _, err = cl.Login(ctx, p.Email, *password); err != nil {
+			return err
+		}
+		p.Token, p.APIKey = cl.Token(), ""
+	}
+	cfg.Profiles[name], cfg.Current = p, name
+	if err = cfg.save(c.configPath()); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout(), "Logged in to %s (profile %s)\n", p.Address, name)
+	return nil
+}
+
+// logout ends the session of a profile and removes its cached credentials
+func (c *CLI) logout(args []string) error {
+	fs := c.newFlagSet("logout")
+	if _, err := c.parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Client.Close()
+	if s.profile.APIKey == "" {
+		if _, err = s.Auth.Logout(ctx, &authsService.Empty{}); err != nil {
+			return err
+		}
+	}
+	s.profile.Token, s.profile.APIKey = "", ""
+	if err = s.cfg.save(c.configPath()); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.stdout(), "Logged out of %s (profile %s)\n", s.profile.Address, s.name)
+	return nil
+}
@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/JECSand/go-grpc-server-boilerplate/client"
+	"google.golang.org/grpc/credentials"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+// command is a subcommand of the command-line client
+type command struct {
+	name    string
+	summary string
+	run     func(c *CLI, args []string) error
+}
+
+// commands lists the subcommands of the command-line client in the order they are documented
+var commands = []*command{
+	{"login", "log in to a server and cache the session in a profile", (*CLI).login},
+	{"logout", "end the session of a profile", (*CLI).logout},
+	{"profiles", "list the profiles", (*CLI).listProfiles},
+	{"profiles use", "make a profile the default: profiles use NAME", (*CLI).useProfile},
+	{"tasks list", "find tasks", (*CLI).tasksList},
+	{"tasks get", "get a task: tasks get ID", (*CLI).tasksGet},
+	{"tasks create", "create a task", (*CLI).tasksCreate},
+	{"tasks update", "update a task: tasks update ID", (*CLI).tasksUpdate},
+	{"tasks delete", "delete a task: tasks delete ID", (*CLI).tasksDelete},
+	{"tasks attachments", "list the attachments of a task: tasks attachments TASK_ID", (*CLI).tasksAttachments},
+	{"tasks attach", "upload a file to a task: tasks attach TASK_ID FILE", (*CLI).tasksAttach},
+	{"tasks download", "download an attachment: tasks download TASK_ID ATTACHMENT_ID", (*CLI).tasksDownload},
+	{"users find", "find users", (*CLI).usersFind},
+	{"users get", "get a user: users get ID", (*CLI).usersGet},
+	{"groups find", "find groups", (*CLI).groupsFind},
+	{"groups get", "get a group: groups get ID", (*CLI).groupsGet},
+}
+
+// CLI is the command-line client of the API
+type CLI struct {
+	Out        io.Writer // where results are printed, os.Stdout when nil
+	In         io.Reader // where a password is read from when not given, os.Stdin when nil
+	ConfigPath string    // the profiles file, DefaultConfigPath when empty
+	// Dial connects a client.Client, client.Dial when nil
+	Dial    func(ctx context.Context, opts client.Options) (*client.Client, error)
+	profile string
+	output  string
+}
+
+// Execute runs the command-line client with the given arguments
+func Execute(args []string) error {
+	var c CLI
+	return c.Run(args)
+}
+
+// Run runs the subcommand named by the first one or two arguments
+func (c *CLI) Run(args []string) error {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		c.printUsage()
+		return nil
+	}
+	for _, n := range []int{2, 1} {
+		if len(args) < n {
+			continue
+		}
+		name := strings.Join(args[:n], " ")
+		for _, cmd := range commands {
+			if cmd.name == name {
+				return cmd.run(c, args[n:])
+			}
+		}
+	}
+	c.printUsage()
+	return fmt.Errorf("unknown command %q", strings.Join(args, " "))
+}
+
+// stdout returns the writer the subcommands print their results to
+func (c *CLI) stdout() io.Writer {
+	if c.Out == nil {
+		return os.Stdout
+	}
+	return c.Out
+}
+
+// printUsage prints the subcommands of the command-line client
+func (c *CLI) printUsage() {
+	w := tabwriter.NewWriter(c.stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "Usage: taskctl <command> [flags]\n\nCommands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %s\t%s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w, "\nEvery command takes -profile NAME, -o table|json|yaml, and -config FILE.")
+	w.Flush()
+}
+
+// newFlagSet returns a flag.FlagSet for a subcommand that reports errors instead of exiting, with the global flags
+// bound to it
+func (c *CLI) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(c.stdout())
+	fs.StringVar(&c.profile, "profile", os.Getenv("TASKCTL_PROFILE"), "profile to use, overrides TASKCTL_PROFILE and the default profile")
+	fs.StringVar(&c.output, "o", "table", "output format: table, json, or yaml")
+	fs.StringVar(&c.ConfigPath, "config", c.ConfigPath, "profiles file")
+	return fs
+}
+
+// parseArgs parses the flags of a subcommand, which may come before or after its positional arguments, and returns the
+// positional arguments
+func (c *CLI) parseArgs(fs *flag.FlagSet, args []string, positional int) ([]string, error) {
+	var rest []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		rest = append(rest, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(rest) != positional {
+		return nil, fmt.Errorf("%s: expected %d argument(s), got %d", fs.Name(), positional, len(rest))
+	}
+	if !outputFormats[c.output] {
+		return nil, fmt.Errorf("%s: unknown output format %q, expected table, json, or yaml", fs.Name(), c.output)
+	}
+	return rest, nil
+}
+
+// session is a connected client.Client of a profile
+type session struct {
+	*client.Client
+	cli     *CLI
+	cfg     *Config
+	name    string
+	profile *Profile
+}
+
+// connect returns a session of the selected profile, authenticated by its cached session token or API key
+func (c *CLI) connect(ctx context.Context) (*session, error) {
+	cfg, err := loadConfig(c.configPath())
+	if err != nil {
+		return nil, err
+	}
+	name, p, err := cfg.profile(c.profile)
+	if err != nil {
+		return nil, err
+	}
+	if p.Token == "" && p.APIKey == "" {
+		return nil, fmt.Errorf("profile %s is not logged in, run taskctl login", name)
+	}
+	cl, err := c.dial(ctx, p, client.Options{Token: p.Token, APIKey: p.APIKey})
+	if err != nil {
+		return nil, err
+	}
+	return &session{Client: cl, cli: c, cfg: cfg, name: name, profile: p}, nil
+}
+
+// close closes the session, caching its session token when the client refreshed it
+func (s *session) close() error {
+	defer s.Client.Close()
+	if token := s.Token(); s.profile.APIKey == "" && token != "" && token != s.profile.Token {
+		s.profile.Token = token
+		return s.cfg.save(s.cli.configPath())
+	}
+	return nil
+}
+
+// dial connects a client.Client to the server of a profile
+func (c *CLI) dial(ctx context.Context, p *Profile, opts client.Options) (*client.Client, error) {
+	opts.Address = p.Address
+	if p.TLS {
+		tlsConfig := &tls.Config{InsecureSkipVerify: p.InsecureSkipVerify}
+		if p.CAFile != "" {
+			pem, err := os.ReadFile(p.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificates found in %s", p.CAFile)
+			}
+		}
+		opts.Credentials = credentials.NewTLS(tlsConfig)
+	}
+	if c.Dial != nil {
+		return c.Dial(ctx, opts)
+	}
+	return client.Dial(ctx, opts)
+}
+
+// readPassword reads a password from the first line of the input
+func (c *CLI) readPassword() (string, error) {
+	in := c.In
+	if in == nil {
+		in = os.Stdin
+		fmt.Fprint(os.Stderr, "Password: ")
+	}
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
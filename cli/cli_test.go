@@ -0,0 +1,338 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/client"
+	authsService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
+	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	usersService "github.com/JECSand/go-grpc-server-boilerplate/protos/user"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubStore keeps the sessions and tasks of the stub services in memory
+type stubStore struct {
+	mu       sync.Mutex
+	sessions map[string]bool
+	tasks    map[string]*tasksService.Task
+}
+
+// newStubStore returns a stubStore with the task 000000000000000000000021
+func newStubStore() *stubStore {
+	return &stubStore{
+		sessions: make(map[string]bool),
+		tasks: map[string]*tasksService.Task{
+			"000000000000000000000021": {
+				Id:      "000000000000000000000021",
+				Name:    "testTask",
+				Status:  tasksService.TaskStatus_NOT_STARTED,
+				Due:     timestamppb.New(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)),
+				UserId:  "000000000000000000000011",
+				GroupId: "000000000000000000000001",
+			},
+		},
+	}
+}
+
+// authenticate checks the session token of a call
+func (s *stubStore) authenticate(ctx context.Context) error {
+	token, err := utilities.GetTokenFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.sessions[token] {
+		err = utilities.Unauthenticated("invalid token")
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	return nil
+}
+
+// task returns a copy of a task, or a NotFound error
+func (s *stubStore) task(id string) (*tasksService.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tasks[id]
+	if !ok {
+		err := utilities.NotFound("task", id)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return proto.Clone(t).(*tasksService.Task), nil
+}
+
+// authServer is a stub Auth service
+type authServer struct {
+	authsService.UnimplementedAuthServiceServer
+	*stubStore
+}
+
+func (s authServer) Login(ctx context.Context, req *authsService.LoginReq) (*authsService.LoginRes, error) {
+	if req.GetEmail() != "master@test.com" || req.GetPassword() != "321test123" {
+		err := utilities.Unauthenticated("invalid email or password")
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	token := utilities.GenerateObjectID()
+	s.sessions[token] = true
+	return &authsService.LoginRes{AccessToken: token}, nil
+}
+
+func (s authServer) Logout(ctx context.Context, req *authsService.Empty) (*authsService.LogoutRes, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	token, _ := utilities.GetTokenFromContext(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, token)
+	return &authsService.LogoutRes{Status: 200}, nil
+}
+
+// taskServer is a stub Task service
+type taskServer struct {
+	tasksService.UnimplementedTaskServiceServer
+	*stubStore
+}
+
+func (s taskServer) Find(ctx context.Context, req *tasksService.FindReq) (*tasksService.FindRes, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &tasksService.FindRes{Size: req.GetSize()}
+	for _, t := range s.tasks {
+		if req.GetTask().GetStatus() == t.Status {
+			res.Tasks = append(res.Tasks, t)
+		}
+	}
+	if res.TotalCount = int64(len(res.Tasks)); res.TotalCount > 0 {
+		res.Page, res.TotalPages = 1, 1
+	}
+	return res, nil
+}
+
+func (s taskServer) Get(ctx context.Context, req *tasksService.GetReq) (*tasksService.GetRes, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	t, err := s.task(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &tasksService.GetRes{Task: t}, nil
+}
+
+func (s taskServer) Create(ctx context.Context, req *tasksService.CreateReq) (*tasksService.CreateRes, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	t := &tasksService.Task{
+		Id:      utilities.GenerateObjectID(),
+		Name:    req.GetName(),
+		Status:  tasksService.TaskStatus_NOT_STARTED,
+		Due:     req.GetDue(),
+		UserId:  req.GetUserId(),
+		GroupId: req.GetGroupId(),
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks[t.Id] = t
+	return &tasksService.CreateRes{Task: t}, nil
+}
+
+func (s taskServer) Update(ctx context.Context, req *tasksService.UpdateReq) (*tasksService.UpdateRes, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	t, err := s.task(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetStatus() != tasksService.TaskStatus_NOT_STARTED {
+		t.Status = req.GetStatus()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks[t.Id] = t
+	return &tasksService.UpdateRes{Task: t}, nil
+}
+
+func (s taskServer) ListAttachments(ctx context.Context, req *tasksService.ListAttachmentsReq) (*tasksService.ListAttachmentsRes, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.task(req.GetTaskId()); err != nil {
+		return nil, err
+	}
+	return &tasksService.ListAttachmentsRes{Size: req.GetSize()}, nil
+}
+
+func (s taskServer) AddAttachment(stream tasksService.TaskService_AddAttachmentServer) error {
+	if err := s.authenticate(stream.Context()); err != nil {
+		return err
+	}
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if _, err = s.task(req.GetTaskId()); err != nil {
+		return err
+	}
+	return stream.SendAndClose(&tasksService.AddAttachmentRes{Attachment: &tasksService.Attachment{
+		Id:       utilities.GenerateObjectID(),
+		TaskId:   req.GetTaskId(),
+		Name:     req.GetName(),
+		FileType: req.GetFileType(),
+		Size:     int64(len(req.GetChunk())),
+	}})
+}
+
+func (s taskServer) DownloadAttachment(req *tasksService.DownloadAttachmentReq, stream tasksService.TaskService_DownloadAttachmentServer) error {
+	if err := s.authenticate(stream.Context()); err != nil {
+		return err
+	}
+	if _, err := s.task(req.GetTaskId()); err != nil {
+		return err
+	}
+	err := utilities.NotFound("attachment", req.GetId())
+	return utilities.ErrorResponse(err, err.Error())
+}
+
+// userServer is a stub User service
+type userServer struct {
+	usersService.UnimplementedUserServiceServer
+	*stubStore
+}
+
+func (s userServer) Find(ctx context.Context, req *usersService.FindReq) (*usersService.FindRes, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return &usersService.FindRes{
+		TotalCount: 1,
+		TotalPages: 1,
+		Page:       1,
+		Size:       req.GetSize(),
+		Users:      []*usersService.User{{Id: "000000000000000000000011", Email: req.GetUser().GetEmail(), Username: "MasterAdmin", Role: "master_admin", RootAdmin: true}},
+	}, nil
+}
+
+// groupServer is a stub Group service
+type groupServer struct {
+	groupsService.UnimplementedGroupServiceServer
+	*stubStore
+}
+
+func (s groupServer) Get(ctx context.Context, req *groupsService.GetReq) (*groupsService.GetRes, error) {
+	if err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return &groupsService.GetRes{Group: &groupsService.Group{Id: req.GetId(), Name: "test2"}}, nil
+}
+
+// startStubServer serves the stub services over an in-memory listener and returns a connection to it
+func startStubServer(t *testing.T) *grpc.ClientConn {
+	store := newStubStore()
+	l := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	authsService.RegisterAuthServiceServer(s, authServer{stubStore: store})
+	tasksService.RegisterTaskServiceServer(s, taskServer{stubStore: store})
+	usersService.RegisterUserServiceServer(s, userServer{stubStore: store})
+	groupsService.RegisterGroupServiceServer(s, groupServer{stubStore: store})
+	go func() {
+		_ = s.Serve(l)
+	}()
+	conn, err := grpc.DialContext(context.Background(), "",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return l.Dial()
+		}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.DialContext() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+		s.Stop()
+	})
+	return conn
+}
+
+func Test_CLI(t *testing.T) {
+	conn := startStubServer(t)
+	upload := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(upload, []byte("attached notes"), 0600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	var out bytes.Buffer
+	c := &CLI{
+		Out:        &out,
+		ConfigPath: filepath.Join(t.TempDir(), "config.yaml"),
+		Dial: func(ctx context.Context, opts client.Options) (*client.Client, error) {
+			return client.NewClient(ctx, conn, opts)
+		},
+	}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string   // The name of the test
+		args    []string // The arguments of the command
+		wantErr string   // A substring we want the error to contain, if any
+		wantOut []string // Substrings we want the output to contain
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"not logged in", []string{"tasks", "list"}, "no profile selected", nil},
+		{"login", []string{"login", "-server", "bufnet", "-email", "master@test.com", "-password", "321test123"}, "", []string{"Logged in to bufnet (profile default)"}},
+		{"wrong password", []string{"login", "-profile", "other", "-server", "bufnet", "-email", "master@test.com", "-password", "wrong"}, "Unauthenticated", nil},
+		{"tasks list", []string{"tasks", "list", "--status=NOT_STARTED"}, "", []string{"STATUS", "testTask", "NOT_STARTED", "page 1 of 1, 1 total"}},
+		{"tasks list filtered", []string{"tasks", "list", "--status=IN_PROGRESS"}, "", []string{"page 0 of 0, 0 total"}},
+		{"tasks create json", []string{"tasks", "create", "-name", "cliTask", "-due", "2030-01-02", "-user", "000000000000000000000014", "-group", "000000000000000000000002", "-o", "json"}, "", []string{`"Name": "cliTask"`, `"Status": "NOT_STARTED"`, `"Due": "2030-01-02T00:00:00Z"`}},
+		{"tasks update", []string{"tasks", "update", "000000000000000000000021", "-status", "in_progress"}, "", []string{"IN_PROGRESS"}},
+		{"tasks get yaml", []string{"tasks", "get", "000000000000000000000021", "-o", "yaml"}, "", []string{"Task:\n    Id: \"000000000000000000000021\"\n    Name: testTask\n    Status: IN_PROGRESS"}},
+		{"task not found", []string{"tasks", "get", "000000000000000000000099"}, "NotFound", nil},
+		{"invalid due", []string{"tasks", "create", "-name", "cliTask", "-due", "tomorrow"}, "invalid due date", nil},
+		{"tasks attachments", []string{"tasks", "attachments", "000000000000000000000021"}, "", []string{"FILETYPE", "page 0 of 0, 0 total"}},
+		{"attach", []string{"tasks", "attach", "000000000000000000000021", upload}, "", []string{"notes.txt", "text/plain"}},
+		{"attach missing file", []string{"tasks", "attach", "000000000000000000000021", filepath.Join(t.TempDir(), "missing.txt")}, "no such file", nil},
+		{"attach to missing task", []string{"tasks", "attach", "000000000000000000000099", upload}, "NotFound", nil},
+		{"download missing attachment", []string{"tasks", "download", "000000000000000000000021", "000000000000000000000099"}, "NotFound", nil},
+		{"users find", []string{"users", "find", "-email", "master@test.com"}, "", []string{"master@test.com"}},
+		{"groups get", []string{"groups", "get", "000000000000000000000002"}, "", []string{"test2"}},
+		{"unknown output", []string{"groups", "get", "000000000000000000000002", "-o", "xml"}, "unknown output format", nil},
+		{"invalid status", []string{"tasks", "list", "-status", "DONE"}, "unknown task status", nil},
+		{"missing argument", []string{"tasks", "get"}, "expected 1 argument(s), got 0", nil},
+		{"profiles", []string{"profiles"}, "", []string{"*        default  bufnet   master@test.com  session"}},
+		{"logout", []string{"logout"}, "", []string{"Logged out of bufnet (profile default)"}},
+		{"logged out", []string{"tasks", "list"}, "profile default is not logged in", nil},
+		{"unknown command", []string{"tasks", "archive"}, "unknown command", nil},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out.Reset()
+			err := c.Run(tt.args)
+			if (err != nil) != (tt.wantErr != "") || (err != nil && !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("CLI.Run(%q) error = %v, want %q", tt.args, err, tt.wantErr)
+				return
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("CLI.Run(%q) output = %q, want it to contain %q", tt.args, out.String(), want)
+				}
+			}
+		})
+	}
+}
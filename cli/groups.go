@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"context"
+	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
+	"google.golang.org/protobuf/proto"
+)
+
+// groupColumns are the table columns of groups
+var groupColumns = []string{"Id", "Name", "RootAdmin", "CreatedAt"}
+
+// groupsFind finds the groups matching the filter flags
+func (c *CLI) groupsFind(args []string) error {
+	fs := c.newFlagSet("groups find")
+	name := fs.String("name", "", "only groups with this name")
+	page := fs.Int64("page", 1, "page of the results")
+	size := fs.Int64("size", 20, "results per page")
+	if _, err := c.parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Groups.Find(ctx, &groupsService.FindReq{Group: &groupsService.Group{Name: *name}, Page: *page, Size: *size})
+	if err != nil {
+		return err
+	}
+	rows := make([]proto.Message, len(res.Groups))
+	for i, g := range res.Groups {
+		rows[i] = g
+	}
+	return c.print(res, view{columns: groupColumns, rows: rows, footer: pageFooter(res.Page, res.TotalPages, res.TotalCount)})
+}
+
+// groupsGet prints a group
+func (c *CLI) groupsGet(args []string) error {
+	fs := c.newFlagSet("groups get")
+	rest, err := c.parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Groups.Get(ctx, &groupsService.GetReq{Id: rest[0]})
+	if err != nil {
+		return err
+	}
+	return c.print(res, view{columns: groupColumns, rows: []proto.Message{res.Group}})
+}
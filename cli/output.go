@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// outputFormats are the values of the -o flag
+var outputFormats = map[string]bool{"table": true, "json": true, "yaml": true}
+
+// view is how a response prints as a table: a row of the columns, which are proto field names, per message
+type view struct {
+	columns []string
+	rows    []proto.Message
+	footer  string
+}
+
+// print prints a response in the output format of the CLI
+func (c *CLI) print(res proto.Message, v view) error {
+	switch c.output {
+	case "json", "yaml":
+		data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(res)
+		if err != nil {
+			return err
+		}
+		if c.output == "json" {
+			// protojson output is deliberately unstable, so it is indented by encoding/json
+			var buf bytes.Buffer
+			if err = json.Indent(&buf, data, "", "  "); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(c.stdout(), buf.String())
+			return err
+		}
+		return c.printYAML(data)
+	}
+	w := tabwriter.NewWriter(c.stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.ToUpper(strings.Join(v.columns, "\t")))
+	for _, row := range v.rows {
+		cells := make([]string, len(v.columns))
+		for i, col := range v.columns {
+			cells[i] = cell(row, col)
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+	if v.footer != "" {
+		fmt.Fprintln(w, v.footer)
+	}
+	return w.Flush()
+}
+
+// printYAML prints the JSON of a response as YAML, keeping the order of its fields
+func (c *CLI) printYAML(data []byte) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	blockStyle(&doc)
+	out, err := yaml.Marshal(&doc)
+	if err != nil {
+		return err
+	}
+	_, err = c.stdout().Write(out)
+	return err
+}
+
+// blockStyle sets a YAML document parsed from JSON to print in block style
+func blockStyle(n *yaml.Node) {
+	n.Style &^= yaml.FlowStyle | yaml.DoubleQuotedStyle
+	for _, child := range n.Content {
+		blockStyle(child)
+	}
+}
+
+// cell returns the table cell of a message field: enums by name, timestamps in RFC 3339, and unset fields as -
+func cell(m proto.Message, name string) string {
+	msg := m.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil || !msg.Has(fd) {
+		return "-"
+	}
+	v := msg.Get(fd)
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+	case protoreflect.MessageKind:
+		if ts, ok := v.Message().Interface().(*timestamppb.Timestamp); ok {
+			return ts.AsTime().Format(time.RFC3339)
+		}
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// pageFooter describes the page of a paginated response
+func pageFooter(page, totalPages, totalCount int64) string {
+	return fmt.Sprintf("\npage %d of %d, %d total", page, totalPages, totalCount)
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+)
+
+// Config is the profiles file, which caches the session of every server the client logged in to
+type Config struct {
+	Current  string              `yaml:"current"`
+	Profiles map[string]*Profile `yaml:"profiles"`
+}
+
+// Profile is a server and the credentials cached for it
+type Profile struct {
+	Address            string `yaml:"address"`
+	TLS                bool   `yaml:"tls,omitempty"`
+	CAFile             string `yaml:"ca_file,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify,omitempty"`
+	Email              string `yaml:"email,omitempty"`
+	Token              string `yaml:"token,omitempty"`
+	APIKey             string `yaml:"api_key,omitempty"`
+}
+
+// DefaultConfigPath returns the profiles file path, TASKCTL_CONFIG or else taskctl/config.yaml in the user config
+// directory
+func DefaultConfigPath() string {
+	if path := os.Getenv("TASKCTL_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, "taskctl", "config.yaml")
+}
+
+// configPath returns the profiles file of the CLI
+func (c *CLI) configPath() string {
+	if c.ConfigPath == "" {
+		return DefaultConfigPath()
+	}
+	return c.ConfigPath
+}
+
+// loadConfig reads the profiles file, returning an empty Config when it does not exist yet
+func loadConfig(path string) (*Config, error) {
+	cfg := &Config{Profiles: map[string]*Profile{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("profiles file %s: %w", path, err)
+	}
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]*Profile{}
+	}
+	return cfg, nil
+}
+
+// save writes the profiles file, readable only by its owner as it holds credentials
+func (cfg *Config) save(path string) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// profile returns the named profile, or the current one when name is empty
+func (cfg *Config) profile(name string) (string, *Profile, error) {
+	if name == "" {
+		name = cfg.Current
+	}
+	if name == "" {
+		return "", nil, errors.New("no profile selected, run taskctl login")
+	}
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		return "", nil, fmt.Errorf("profile %s does not exist", name)
+	}
+	return name, p, nil
+}
+
+// listProfiles prints the profiles, marking the current one
+func (c *CLI) listProfiles(args []string) error {
+	fs := c.newFlagSet("profiles")
+	if _, err := c.parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	cfg, err := loadConfig(c.configPath())
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(cfg.Profiles))
+	for name := range cfg.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	w := tabwriter.NewWriter(c.stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "CURRENT\tNAME\tADDRESS\tEMAIL\tAUTH")
+	for _, name := range names {
+		p, current, auth := cfg.Profiles[name], "", "-"
+		if name == cfg.Current {
+			current = "*"
+		}
+		if p.APIKey != "" {
+			auth = "api key"
+		} else if p.Token != "" {
+			auth = "session"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", current, name, p.Address, p.Email, auth)
+	}
+	return w.Flush()
+}
+
+// useProfile makes a profile the default of later commands
+func (c *CLI) useProfile(args []string) error {
+	fs := c.newFlagSet("profiles use")
+	rest, err := c.parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	cfg, err := loadConfig(c.configPath())
+	if err != nil {
+		return err
+	}
+	if _, _, err = cfg.profile(rest[0]); err != nil {
+		return err
+	}
+	cfg.Current = rest[0]
+	return cfg.save(c.configPath())
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"fmt"
+	"github.com/JECSand/go-grpc-server-boilerplate/cli"
+	"os"
+)
+
+func main() {
+	if err := cli.Execute(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
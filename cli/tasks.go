@@ -0,0 +1,329 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// taskColumns are the table columns of tasks
+var taskColumns = []string{"Id", "Name", "Status", "Due", "UserId", "GroupId"}
+
+// taskView is the table of a single task
+func taskView(t *tasksService.Task) view {
+	return view{columns: taskColumns, rows: []proto.Message{t}}
+}
+
+// parseStatus returns the TaskStatus of its name, e.g. IN_PROGRESS
+func parseStatus(s string) (tasksService.TaskStatus, error) {
+	if v, ok := tasksService.TaskStatus_value[strings.ToUpper(s)]; ok {
+		return tasksService.TaskStatus(v), nil
+	}
+	return 0, fmt.Errorf("unknown task status %q, expected NOT_STARTED, IN_PROGRESS, or COMPLETED", s)
+}
+
+// parseDue returns the timestamp of a due date in RFC 3339 or YYYY-MM-DD format
+func parseDue(s string) (*timestamppb.Timestamp, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return timestamppb.New(t), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid due date %q, expected RFC 3339 or YYYY-MM-DD", s)
+}
+
+// tasksList finds the tasks matching the filter flags
+func (c *CLI) tasksList(args []string) error {
+	fs := c.newFlagSet("tasks list")
+	status := fs.String("status", "", "only tasks with this status: NOT_STARTED, IN_PROGRESS, or COMPLETED")
+	userId := fs.String("user", "", "only tasks assigned to this user id")
+	groupId := fs.String("group", "", "only tasks of this group id")
+	page := fs.Int64("page", 1, "page of the results")
+	size := fs.Int64("size", 20, "results per page")
+	if _, err := c.parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	filter := &tasksService.Task{UserId: *userId, GroupId: *groupId}
+	if *status != "" {
+		s, err := parseStatus(*status)
+		if err != nil {
+			return err
+		}
+		filter.Status = s
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Tasks.Find(ctx, &tasksService.FindReq{Task: filter, Page: *page, Size: *size})
+	if err != nil {
+		return err
+	}
+	rows := make([]proto.Message, len(res.Tasks))
+	for i, t := range res.Tasks {
+		rows[i] = t
+	}
+	return c.print(res, view{columns: taskColumns, rows: rows, footer: pageFooter(res.Page, res.TotalPages, res.TotalCount)})
+}
+
+// tasksGet prints a task
+func (c *CLI) tasksGet(args []string) error {
+	fs := c.newFlagSet("tasks get")
+	rest, err := c.parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Tasks.Get(ctx, &tasksService.GetReq{Id: rest[0]})
+	if err != nil {
+		return err
+	}
+	return c.print(res, taskView(res.Task))
+}
+
+// taskFlags binds the flags of the task fields a task is created or updated with
+func taskFlags(fs *flag.FlagSet) (name, description, due, userId *string) {
+	name = fs.String("name", "", "name of the task")
+	description = fs.String("description", "", "description of the task")
+	due = fs.String("due", "", "due date of the task, RFC 3339 or YYYY-MM-DD; on create, defaults to the group settings")
+	userId = fs.String("user", "", "id of the user the task is assigned to")
+	return
+}
+
+// tasksCreate creates a task
+func (c *CLI) tasksCreate(args []string) error {
+	fs := c.newFlagSet("tasks create")
+	name, description, due, userId := taskFlags(fs)
+	groupId := fs.String("group", "", "id of the group of the task")
+	if _, err := c.parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	req := &tasksService.CreateReq{Name: *name, Description: *description, UserId: *userId, GroupId: *groupId}
+	if *due != "" {
+		d, err := parseDue(*due)
+		if err != nil {
+			return err
+		}
+		req.Due = d
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Tasks.Create(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(res, taskView(res.Task))
+}
+
+// tasksUpdate updates the fields of a task given by flags
+func (c *CLI) tasksUpdate(args []string) error {
+	fs := c.newFlagSet("tasks update")
+	name, description, due, userId := taskFlags(fs)
+	status := fs.String("status", "", "status of the task: NOT_STARTED, IN_PROGRESS, or COMPLETED")
+	rest, err := c.parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	req := &tasksService.UpdateReq{Id: rest[0], Name: *name, Description: *description, UserId: *userId}
+	if *status != "" {
+		if req.Status, err = parseStatus(*status); err != nil {
+			return err
+		}
+	}
+	if *due != "" {
+		if req.Due, err = parseDue(*due); err != nil {
+			return err
+		}
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Tasks.Update(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.print(res, taskView(res.Task))
+}
+
+// tasksDelete deletes a task
+func (c *CLI) tasksDelete(args []string) error {
+	fs := c.newFlagSet("tasks delete")
+	rest, err := c.parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Tasks.Delete(ctx, &tasksService.DeleteReq{Id: rest[0]})
+	if err != nil {
+		return err
+	}
+	return c.print(res, taskView(res.Task))
+}
+
+// attachmentColumns are the table columns of attachments
+var attachmentColumns = []string{"Id", "Name", "FileType", "Size", "CreatedAt"}
+
+// attachmentChunkSize is the size of the chunks an attachment is uploaded in
+const attachmentChunkSize = 64 * 1024
+
+// tasksAttachments lists the files attached to a task
+func (c *CLI) tasksAttachments(args []string) error {
+	fs := c.newFlagSet("tasks attachments")
+	page := fs.Int64("page", 1, "page of the results")
+	size := fs.Int64("size", 20, "results per page")
+	rest, err := c.parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Tasks.ListAttachments(ctx, &tasksService.ListAttachmentsReq{TaskId: rest[0], Page: *page, Size: *size})
+	if err != nil {
+		return err
+	}
+	rows := make([]proto.Message, len(res.Attachments))
+	for i, a := range res.Attachments {
+		rows[i] = a
+	}
+	return c.print(res, view{columns: attachmentColumns, rows: rows, footer: pageFooter(res.Page, res.TotalPages, res.TotalCount)})
+}
+
+// tasksAttach uploads a file as an attachment of a task
+func (c *CLI) tasksAttach(args []string) error {
+	fs := c.newFlagSet("tasks attach")
+	name := fs.String("name", "", "name of the attachment, defaults to the name of the file")
+	fileType := fs.String("type", "", "media type of the attachment, defaults to the type of the file extension")
+	rest, err := c.parseArgs(fs, args, 2)
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(rest[1])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if *name == "" {
+		*name = filepath.Base(rest[1])
+	}
+	if *fileType == "" {
+		if *fileType = mime.TypeByExtension(filepath.Ext(rest[1])); *fileType == "" {
+			*fileType = "application/octet-stream"
+		}
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	stream, err := s.Tasks.AddAttachment(ctx)
+	if err != nil {
+		return err
+	}
+	req := &tasksService.AddAttachmentReq{TaskId: rest[0], Name: *name, FileType: *fileType}
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, readErr := f.Read(buf)
+		if readErr != nil && readErr != io.EOF {
+			return readErr
+		}
+		if n > 0 || req.TaskId != "" { // the first message is sent even for an empty file
+			req.Chunk = buf[:n]
+			if err = stream.Send(req); err == io.EOF {
+				break // the server ended the upload, and CloseAndRecv returns why
+			} else if err != nil {
+				return err
+			}
+			req = &tasksService.AddAttachmentReq{}
+		}
+		if readErr == io.EOF {
+			break
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	return c.print(res, view{columns: attachmentColumns, rows: []proto.Message{res.Attachment}})
+}
+
+// tasksDownload downloads an attachment of a task to a file
+func (c *CLI) tasksDownload(args []string) error {
+	fs := c.newFlagSet("tasks download")
+	out := fs.String("out", "", "file to write the attachment to, defaults to the name of the attachment")
+	rest, err := c.parseArgs(fs, args, 2)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	stream, err := s.Tasks.DownloadAttachment(ctx, &tasksService.DownloadAttachmentReq{TaskId: rest[0], Id: rest[1]})
+	if err != nil {
+		return err
+	}
+	res, err := stream.Recv() // the first message carries the attachment
+	if err != nil {
+		return err
+	}
+	attachment := res.GetAttachment()
+	if *out == "" {
+		*out = filepath.Base(attachment.GetName())
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	for {
+		if _, err = f.Write(res.Chunk); err != nil {
+			return err
+		}
+		res, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return c.print(&tasksService.DownloadAttachmentRes{Attachment: attachment}, view{columns: attachmentColumns, rows: []proto.Message{attachment}})
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+	usersService "github.com/JECSand/go-grpc-server-boilerplate/protos/user"
+	"google.golang.org/protobuf/proto"
+)
+
+// userColumns are the table columns of users
+var userColumns = []string{"Id", "Email", "Username", "Role", "GroupId", "RootAdmin"}
+
+// usersFind finds the users matching the filter flags
+func (c *CLI) usersFind(args []string) error {
+	fs := c.newFlagSet("users find")
+	email := fs.String("email", "", "only users with this email")
+	username := fs.String("username", "", "only users with this username")
+	role := fs.String("role", "", "only users with this role")
+	groupId := fs.String("group", "", "only users of this group id")
+	page := fs.Int64("page", 1, "page of the results")
+	size := fs.Int64("size", 20, "results per page")
+	if _, err := c.parseArgs(fs, args, 0); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Users.Find(ctx, &usersService.FindReq{
+		User: &usersService.User{Email: *email, Username: *username, Role: *role, GroupId: *groupId},
+		Page: *page,
+		Size: *size,
+	})
+	if err != nil {
+		return err
+	}
+	rows := make([]proto.Message, len(res.Users))
+	for i, u := range res.Users {
+		rows[i] = u
+	}
+	return c.print(res, view{columns: userColumns, rows: rows, footer: pageFooter(res.Page, res.TotalPages, res.TotalCount)})
+}
+
+// usersGet prints a user
+func (c *CLI) usersGet(args []string) error {
+	fs := c.newFlagSet("users get")
+	rest, err := c.parseArgs(fs, args, 1)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	s, err := c.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.close()
+	res, err := s.Users.Get(ctx, &usersService.GetReq{Id: rest[0]})
+	if err != nil {
+		return err
+	}
+	return c.print(res, view{columns: userColumns, rows: []proto.Message{res.User}})
+}
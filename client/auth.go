@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	authsService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
+	"github.com/dgrijalva/jwt-go"
+	"sync"
+	"time"
+)
+
+// refreshWindow is how long before it expires a session token is refreshed
+const refreshWindow = 5 * time.Minute
+
+// tokenSource keeps the access token of a Client and renews its session
+type tokenSource struct {
+	mu       sync.Mutex
+	auth     authsService.AuthServiceClient // calls the server without the interceptors of the Client
+	token    string
+	expiry   time.Time
+	apiKey   bool
+	email    string
+	password string
+}
+
+// newTokenSource constructs a tokenSource for the credentials or API key of opts
+func newTokenSource(auth authsService.AuthServiceClient, opts Options) *tokenSource {
+	t := &tokenSource{auth: auth, email: opts.Email, password: opts.Password}
+	if opts.APIKey != "" {
+		t.token, t.apiKey = opts.APIKey, true
+	} else if opts.Token != "" {
+		t.setLocked(opts.Token)
+	}
+	return t
+}
+
+// current returns the access token
+func (t *tokenSource) current() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.token
+}
+
+// get returns the access token to call with, logging in or refreshing the session first when it is missing or about
+// to expire. A session that cannot be refreshed ahead of time is still used, and renewed if the server rejects it.
+func (t *tokenSource) get(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.apiKey {
+		return t.token, nil
+	}
+	if t.token == "" && t.email != "" {
+		if _, err := t.loginLocked(ctx); err != nil {
+			return "", err
+		}
+	} else if t.token != "" && !t.expiry.IsZero() && time.Until(t.expiry) < refreshWindow {
+		_ = t.refreshLocked(ctx)
+	}
+	return t.token, nil
+}
+
+// renew replaces a rejected session token by refreshing it or, when that fails, logging in again. A token another call
+// already renewed is returned as is.
+func (t *tokenSource) renew(ctx context.Context, rejected string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != rejected {
+		return t.token, nil
+	}
+	if t.apiKey {
+		return "", ErrUnauthenticated
+	}
+	if err := t.refreshLocked(ctx); err == nil {
+		return t.token, nil
+	}
+	if t.email == "" {
+		return "", ErrUnauthenticated
+	}
+	if _, err := t.loginLocked(ctx); err != nil {
+		return "", err
+	}
+	return t.token, nil
+}
+
+// login logs in with new credentials
+func (t *tokenSource) login(ctx context.Context, email string, password string) (*authsService.LoginRes, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.email, t.password, t.apiKey = email, password, false
+	return t.loginLocked(ctx)
+}
+
+// set replaces the session token, keeping the credentials to log in again with
+func (t *tokenSource) set(token string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.setLocked(token)
+}
+
+// clear forgets the session and credentials
+func (t *tokenSource) clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.token, t.expiry, t.email, t.password = "", time.Time{}, "", ""
+}
+
+// loginLocked logs in with the credentials; t.mu must be held
+func (t *tokenSource) loginLocked(ctx context.Context) (*authsService.LoginRes, error) {
+	res, err := t.auth.Login(ctx, &authsService.LoginReq{Email: t.email, Password: t.password})
+	if err != nil {
+		return nil, err
+	}
+	t.setLocked(res.GetAccessToken())
+	return res, nil
+}
+
+// refreshLocked exchanges the session token for a new one; t.mu must be held
+func (t *tokenSource) refreshLocked(ctx context.Context) error {
+	if t.token == "" {
+		return ErrUnauthenticated
+	}
+	res, err := t.auth.Refresh(withToken(ctx, t.token), &authsService.Empty{})
+	if err != nil {
+		return err
+	}
+	t.setLocked(res.GetAccessToken())
+	return nil
+}
+
+// setLocked stores a session token and its expiry; t.mu must be held
+func (t *tokenSource) setLocked(token string) {
+	t.token, t.expiry = token, tokenExpiry(token)
+}
+
+// tokenExpiry returns the expiry of a JWT, read without verifying its signature, or the zero time when it has none
+func tokenExpiry(token string) time.Time {
+	claims := jwt.MapClaims{}
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, claims); err != nil {
+		return time.Time{}
+	}
+	if exp, ok := claims["exp"].(float64); ok {
+		return time.Unix(int64(exp), 0)
+	}
+	return time.Time{}
+}
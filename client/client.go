@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	authsService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
+	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	usersService "github.com/JECSand/go-grpc-server-boilerplate/protos/user"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// DefaultTimeout is the deadline of unary calls made without one when Options.Timeout is not set
+const DefaultTimeout = 10 * time.Second
+
+// publicMethods are the RPCs that are called without an access token
+var publicMethods = map[string]bool{
+	"/authService.AuthService/Register": true,
+	"/authService.AuthService/Login":    true,
+}
+
+// Options configure a Client
+type Options struct {
+	Address     string                           // host:port of the gRPC server, used by Dial
+	Credentials credentials.TransportCredentials // TLS credentials of the connection; nil dials without TLS
+	Email       string                           // logs in with Email and Password, and again whenever the session cannot be refreshed
+	Password    string
+	APIKey      string        // authenticates every call with an API key instead of a session
+	Token       string        // a session token to start from, e.g. one cached by an earlier Client
+	Timeout     time.Duration // deadline of unary calls made without one; a negative Timeout sets none
+	Retry       RetryPolicy   // retries of idempotent RPCs, used by Dial
+	DialOptions []grpc.DialOption
+}
+
+// Client calls the Auth, User, Group, and Task services. It attaches the access token of its session or API key to
+// every call, refreshes the session when the server rejects the token, sets a deadline on unary calls made without
+// one, and returns failed calls as an *Error.
+type Client struct {
+	Auth    authsService.AuthServiceClient
+	Users   usersService.UserServiceClient
+	Groups  groupsService.GroupServiceClient
+	Tasks   tasksService.TaskServiceClient
+	conn    grpc.ClientConnInterface
+	owned   *grpc.ClientConn
+	tokens  *tokenSource
+	timeout time.Duration
+}
+
+// Dial connects to the server at opts.Address, retrying idempotent RPCs by opts.Retry, and returns a Client. When
+// opts has an Email but no Token, the Client logs in before it is returned.
+func Dial(ctx context.Context, opts Options) (*Client, error) {
+	creds := opts.Credentials
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+	serviceConfig, err := opts.Retry.serviceConfig()
+	if err != nil {
+		return nil, err
+	}
+	dialOpts := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+	}, opts.DialOptions...)
+	conn, err := grpc.DialContext(ctx, opts.Address, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+	c, err := NewClient(ctx, conn, opts)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	c.owned = conn
+	return c, nil
+}
+
+// NewClient returns a Client that calls the services over an existing connection. When opts has an Email but no Token,
+// the Client logs in before it is returned.
+func NewClient(ctx context.Context, conn grpc.ClientConnInterface, opts Options) (*Client, error) {
+	c := &Client{
+		conn:    conn,
+		tokens:  newTokenSource(authsService.NewAuthServiceClient(conn), opts),
+		timeout: opts.Timeout,
+	}
+	if c.timeout == 0 {
+		c.timeout = DefaultTimeout
+	}
+	c.Auth = authsService.NewAuthServiceClient(c)
+	c.Users = usersService.NewUserServiceClient(c)
+	c.Groups = groupsService.NewGroupServiceClient(c)
+	c.Tasks = tasksService.NewTaskServiceClient(c)
+	if opts.Email != "" && opts.APIKey == "" && opts.Token == "" {
+		if _, err := c.Login(ctx, opts.Email, opts.Password); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Close closes the connection of a Client created by Dial
+func (c *Client) Close() error {
+	if c.owned == nil {
+		return nil
+	}
+	return c.owned.Close()
+}
+
+// Login logs in with the given credentials, which the Client keeps to log in again when its session cannot be
+// refreshed
+func (c *Client) Login(ctx context.Context, email string, password string) (*authsService.LoginRes, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	res, err := c.tokens.login(ctx, email, password)
+	return res, wrapError(err)
+}
+
+// Logout revokes the session of the Client and forgets its credentials
+func (c *Client) Logout(ctx context.Context) error {
+	_, err := c.Auth.Logout(ctx, &authsService.Empty{})
+	c.tokens.clear()
+	return err
+}
+
+// SwitchGroup makes groupId the active group of the session; later calls of the Client are scoped to it
+func (c *Client) SwitchGroup(ctx context.Context, groupId string) (*authsService.SwitchGroupRes, error) {
+	res, err := c.Auth.SwitchGroup(ctx, &authsService.SwitchGroupReq{GroupId: groupId})
+	if err != nil {
+		return nil, err
+	}
+	c.tokens.set(res.GetAccessToken())
+	return res, nil
+}
+
+// AcceptInvite joins the group of an invite code and makes it the active group of the session
+func (c *Client) AcceptInvite(ctx context.Context, code string) (*authsService.AcceptInviteRes, error) {
+	res, err := c.Auth.AcceptInvite(ctx, &authsService.AcceptInviteReq{Code: code})
+	if err != nil {
+		return nil, err
+	}
+	c.tokens.set(res.GetAccessToken())
+	return res, nil
+}
+
+// Token returns the access token the Client attaches to its calls
+func (c *Client) Token() string {
+	return c.tokens.current()
+}
+
+// withTimeout returns ctx with the default deadline of the Client, unless it already has one
+func (c *Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || c.timeout < 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.timeout)
+}
+
+// Invoke performs a unary RPC with the access token of the Client, refreshing the session and trying once more when the
+// server rejects the token
+func (c *Client) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	if publicMethods[method] {
+		return wrapError(c.conn.Invoke(ctx, method, args, reply, opts...))
+	}
+	token, err := c.tokens.get(ctx)
+	if err != nil {
+		return wrapError(err)
+	}
+	err = c.conn.Invoke(withToken(ctx, token), method, args, reply, opts...)
+	if status.Code(err) == codes.Unauthenticated {
+		if renewed, rErr := c.tokens.renew(ctx, token); rErr == nil {
+			err = c.conn.Invoke(withToken(ctx, renewed), method, args, reply, opts...)
+		}
+	}
+	return wrapError(err)
+}
+
+// NewStream begins a streaming RPC with the access token of the Client. Streams get no default deadline.
+func (c *Client) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	token, err := c.tokens.get(ctx)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	stream, err := c.conn.NewStream(withToken(ctx, token), desc, method, opts...)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+	return &clientStream{ClientStream: stream}, nil
+}
+
+// clientStream is a grpc.ClientStream that returns failed calls as an *Error
+type clientStream struct {
+	grpc.ClientStream
+}
+
+// RecvMsg receives the next message of the stream
+func (s *clientStream) RecvMsg(m interface{}) error {
+	return wrapError(s.ClientStream.RecvMsg(m))
+}
+
+// SendMsg sends a message on the stream
+func (s *clientStream) SendMsg(m interface{}) error {
+	return wrapError(s.ClientStream.SendMsg(m))
+}
+
+// withToken attaches an access token to the outgoing metadata of ctx, unless there is none
+func withToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return utilities.AttachTokenToContext(ctx, token)
+}
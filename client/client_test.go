@@ -0,0 +1,261 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	authsService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubServer is an Auth and Task service that keeps its valid tokens in memory
+type stubServer struct {
+	authsService.UnimplementedAuthServiceServer
+	tasksService.UnimplementedTaskServiceServer
+	mu     sync.Mutex
+	tokens map[string]bool
+	issued int
+}
+
+// issue returns a new valid token
+func (s *stubServer) issue(prefix string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.issued++
+	token := fmt.Sprintf("%s-%d", prefix, s.issued)
+	s.tokens[token] = true
+	return token
+}
+
+// authenticate returns the token of the call when it is valid
+func (s *stubServer) authenticate(ctx context.Context) (string, error) {
+	token, err := utilities.GetTokenFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.tokens[token] {
+		err = utilities.Unauthenticated("invalid token")
+		return "", utilities.ErrorResponse(err, err.Error())
+	}
+	return token, nil
+}
+
+// revoke invalidates a token
+func (s *stubServer) revoke(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tokens, token)
+}
+
+func (s *stubServer) Login(ctx context.Context, req *authsService.LoginReq) (*authsService.LoginRes, error) {
+	if req.GetEmail() != "master@test.com" || req.GetPassword() != "321test123" {
+		err := utilities.Unauthenticated("invalid email or password")
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &authsService.LoginRes{AccessToken: s.issue("session")}, nil
+}
+
+func (s *stubServer) Logout(ctx context.Context, req *authsService.Empty) (*authsService.LogoutRes, error) {
+	token, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.revoke(token)
+	return &authsService.LogoutRes{Status: 200}, nil
+}
+
+func (s *stubServer) Refresh(ctx context.Context, req *authsService.Empty) (*authsService.RefreshRes, error) {
+	token, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.revoke(token)
+	return &authsService.RefreshRes{AccessToken: s.issue("session")}, nil
+}
+
+func (s *stubServer) GenerateKey(ctx context.Context, req *authsService.Empty) (*authsService.GenerateKeyRes, error) {
+	if _, err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	return &authsService.GenerateKeyRes{APIKey: s.issue("key")}, nil
+}
+
+func (s *stubServer) Get(ctx context.Context, req *tasksService.GetReq) (*tasksService.GetRes, error) {
+	if _, err := s.authenticate(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetId() != "000000000000000000000021" {
+		err := utilities.NotFound("task", req.GetId())
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &tasksService.GetRes{Task: &tasksService.Task{Id: req.GetId(), Name: "testTask"}}, nil
+}
+
+// startStubServer serves a stubServer over an in-memory listener and returns a connection to it
+func startStubServer(t *testing.T) (*grpc.ClientConn, *stubServer) {
+	stub := &stubServer{tokens: make(map[string]bool)}
+	l := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	authsService.RegisterAuthServiceServer(s, stub)
+	tasksService.RegisterTaskServiceServer(s, stub)
+	go func() {
+		_ = s.Serve(l)
+	}()
+	conn, err := grpc.DialContext(context.Background(), "",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return l.Dial()
+		}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.DialContext() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+		s.Stop()
+	})
+	return conn, stub
+}
+
+func Test_Client(t *testing.T) {
+	ctx := context.Background()
+	conn, stub := startStubServer(t)
+	sc, err := NewClient(ctx, conn, Options{Email: "master@test.com", Password: "321test123"})
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	taskReq := &tasksService.GetReq{Id: "000000000000000000000021"}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name     string       // The name of the test
+		call     func() error // The calls of the test
+		code     codes.Code   // The status code we want
+		sentinel error        // The sentinel error we want the error to match, if any
+		reason   string       // The ErrorInfo reason we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"session",
+			func() error {
+				_, err := sc.Tasks.Get(ctx, taskReq)
+				return err
+			},
+			codes.OK,
+			nil,
+			"",
+		},
+		{
+			"typed error",
+			func() error {
+				_, err := sc.Tasks.Get(ctx, &tasksService.GetReq{Id: "000000000000000000000099"})
+				return err
+			},
+			codes.NotFound,
+			ErrNotFound,
+			"TASK_NOT_FOUND",
+		},
+		{
+			"wrong password",
+			func() error {
+				_, err := NewClient(ctx, conn, Options{Email: "master@test.com", Password: "wrong"})
+				return err
+			},
+			codes.Unauthenticated,
+			ErrUnauthenticated,
+			"UNAUTHENTICATED",
+		},
+		{
+			"api key",
+			func() error {
+				key, err := sc.Auth.GenerateKey(ctx, &authsService.Empty{})
+				if err != nil {
+					return err
+				}
+				kc, err := NewClient(ctx, conn, Options{APIKey: key.APIKey})
+				if err != nil {
+					return err
+				}
+				_, err = kc.Tasks.Get(ctx, taskReq)
+				return err
+			},
+			codes.OK,
+			nil,
+			"",
+		},
+		{
+			"rejected api key",
+			func() error {
+				kc, err := NewClient(ctx, conn, Options{APIKey: "key-0"})
+				if err != nil {
+					return err
+				}
+				_, err = kc.Tasks.Get(ctx, taskReq)
+				return err
+			},
+			codes.Unauthenticated,
+			ErrUnauthenticated,
+			"UNAUTHENTICATED",
+		},
+		{
+			"renews revoked session",
+			func() error {
+				revoked := sc.Token()
+				stub.revoke(revoked)
+				if _, err := sc.Tasks.Get(ctx, taskReq); err != nil {
+					return err
+				}
+				if sc.Token() == revoked {
+					return fmt.Errorf("the session token was not renewed")
+				}
+				return nil
+			},
+			codes.OK,
+			nil,
+			"",
+		},
+		{
+			"default deadline",
+			func() error {
+				dc, err := NewClient(ctx, conn, Options{APIKey: sc.Token(), Timeout: time.Nanosecond})
+				if err != nil {
+					return err
+				}
+				_, err = dc.Tasks.Get(ctx, taskReq)
+				return err
+			},
+			codes.DeadlineExceeded,
+			nil,
+			"",
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if status.Code(err) != tt.code {
+				t.Errorf("code = %v, want %v: %v", status.Code(err), tt.code, err)
+				return
+			}
+			if tt.sentinel != nil && !errors.Is(err, tt.sentinel) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tt.sentinel)
+			}
+			if tt.reason == "" {
+				return
+			}
+			var cErr *Error
+			if !errors.As(err, &cErr) || cErr.Reason != tt.reason {
+				t.Errorf("error = %#v, want a *Error with reason %q", err, tt.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"errors"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// Sentinel errors; every *Error matches the sentinel of its code with errors.Is. They are the sentinels the server
+// uses, so errors.Is(err, utilities.ErrNotFound) holds on both sides.
+var (
+	ErrNotFound           = utilities.ErrNotFound
+	ErrAlreadyExists      = utilities.ErrConflict
+	ErrPermissionDenied   = utilities.ErrPermissionDenied
+	ErrUnauthenticated    = utilities.ErrUnauthenticated
+	ErrInvalidArgument    = utilities.ErrInvalidArgument
+	ErrFailedPrecondition = utilities.ErrFailedPrecondition
+	ErrResourceExhausted  = errors.New("Resource exhausted")
+	ErrUnavailable        = errors.New("Unavailable")
+)
+
+// codeErrors are the sentinel errors of the status codes
+var codeErrors = map[codes.Code]error{
+	codes.NotFound:           ErrNotFound,
+	codes.AlreadyExists:      ErrAlreadyExists,
+	codes.PermissionDenied:   ErrPermissionDenied,
+	codes.Unauthenticated:    ErrUnauthenticated,
+	codes.InvalidArgument:    ErrInvalidArgument,
+	codes.FailedPrecondition: ErrFailedPrecondition,
+	codes.ResourceExhausted:  ErrResourceExhausted,
+	codes.Unavailable:        ErrUnavailable,
+}
+
+// Error is a failed call with the details the server attached to its status
+type Error struct {
+	Code         codes.Code
+	Message      string
+	Reason       string // errdetails.ErrorInfo reason, e.g. TASK_NOT_FOUND
+	ResourceType string
+	ResourceName string
+	Violations   []*errdetails.BadRequest_FieldViolation
+	RetryAfter   time.Duration // how long a rate limited caller should wait
+	status       *status.Status
+}
+
+// Error returns the code and message of the failed call
+func (e *Error) Error() string {
+	return e.Code.String() + ": " + e.Message
+}
+
+// Is reports whether target is the sentinel error of the code
+func (e *Error) Is(target error) bool {
+	return codeErrors[e.Code] == target
+}
+
+// GRPCStatus returns the status of the failed call, so status.Code and status.Convert work on an *Error
+func (e *Error) GRPCStatus() *status.Status {
+	return e.status
+}
+
+// wrapError returns a gRPC status error as an *Error; other errors, such as a cancelled context, are returned as is
+func wrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var cErr *Error
+	if errors.As(err, &cErr) {
+		return err
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	e := &Error{Code: st.Code(), Message: st.Message(), status: st}
+	for _, d := range st.Details() {
+		switch detail := d.(type) {
+		case *errdetails.ErrorInfo:
+			e.Reason = detail.Reason
+		case *errdetails.ResourceInfo:
+			e.ResourceType, e.ResourceName = detail.ResourceType, detail.ResourceName
+		case *errdetails.BadRequest:
+			e.Violations = detail.FieldViolations
+		case *errdetails.RetryInfo:
+			e.RetryAfter = detail.RetryDelay.AsDuration()
+		}
+	}
+	return e
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"encoding/json"
+	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	usersService "github.com/JECSand/go-grpc-server-boilerplate/protos/user"
+	"google.golang.org/grpc"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// readPrefixes start the names of the RPCs that only read, which can be retried without side effects
+var readPrefixes = []string{"Get", "Find", "List"}
+
+// retriedServices are the services of the Client whose reading unary RPCs are retried
+var retriedServices = []grpc.ServiceDesc{
+	usersService.UserService_ServiceDesc,
+	groupsService.GroupService_ServiceDesc,
+	tasksService.TaskService_ServiceDesc,
+}
+
+// idempotentMethods returns the unary RPCs, by service, whose names start with a readPrefix
+func idempotentMethods() map[string][]string {
+	methods := make(map[string][]string, len(retriedServices))
+	for _, sd := range retriedServices {
+		for _, md := range sd.Methods {
+			for _, prefix := range readPrefixes {
+				if strings.HasPrefix(md.MethodName, prefix) {
+					methods[sd.ServiceName] = append(methods[sd.ServiceName], md.MethodName)
+					break
+				}
+			}
+		}
+	}
+	return methods
+}
+
+// RetryPolicy configures the retries of idempotent RPCs that fail as UNAVAILABLE. Zero values take the defaults of 4
+// attempts with a backoff from 100ms doubling up to 2s; a negative MaxAttempts disables retries
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+}
+
+// methodName names an RPC in a gRPC service config
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+// retryPolicy is the retry policy of a gRPC service config
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+// methodConfig is the config of a set of RPCs in a gRPC service config
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy *retryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// serviceConfig returns the gRPC service config JSON that retries the idempotent RPCs by the policy
+func (p RetryPolicy) serviceConfig() (string, error) {
+	if p.MaxAttempts < 0 {
+		return "{}", nil
+	}
+	if p.MaxAttempts == 0 {
+		p.MaxAttempts = 4
+	}
+	if p.InitialBackoff == 0 {
+		p.InitialBackoff = 100 * time.Millisecond
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = 2 * time.Second
+	}
+	if p.Multiplier == 0 {
+		p.Multiplier = 2
+	}
+	mc := methodConfig{RetryPolicy: &retryPolicy{
+		MaxAttempts:          p.MaxAttempts,
+		InitialBackoff:       durationString(p.InitialBackoff),
+		MaxBackoff:           durationString(p.MaxBackoff),
+		BackoffMultiplier:    p.Multiplier,
+		RetryableStatusCodes: []string{"UNAVAILABLE"},
+	}}
+	for service, methods := range idempotentMethods() {
+		for _, m := range methods {
+			mc.Name = append(mc.Name, methodName{Service: service, Method: m})
+		}
+	}
+	data, err := json.Marshal(map[string][]methodConfig{"methodConfig": {mc}})
+	return string(data), err
+}
+
+// durationString formats a duration as a service config duration, in seconds with an s suffix
+func durationString(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_RetryServiceConfig(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name   string      // The name of the test
+		want   bool        // whether we want the method to be retried
+		method string      // The service and method of the test
+		policy RetryPolicy // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"get", true, `{"service":"usersService.UserService","method":"Get"}`, RetryPolicy{}},
+		{"list members", true, `{"service":"groupsService.GroupService","method":"ListMembers"}`, RetryPolicy{}},
+		{"get settings", true, `{"service":"groupsService.GroupService","method":"GetSettings"}`, RetryPolicy{}},
+		{"list descendants", true, `{"service":"groupsService.GroupService","method":"ListDescendants"}`, RetryPolicy{}},
+		{"list invites", true, `{"service":"groupsService.GroupService","method":"ListInvites"}`, RetryPolicy{}},
+		{"list comments", true, `{"service":"tasksService.TaskService","method":"ListComments"}`, RetryPolicy{}},
+		{"list activity", true, `{"service":"tasksService.TaskService","method":"ListActivity"}`, RetryPolicy{}},
+		{"list attachments", true, `{"service":"tasksService.TaskService","method":"ListAttachments"}`, RetryPolicy{}},
+		{"create", false, `{"service":"tasksService.TaskService","method":"Create"}`, RetryPolicy{}},
+		{"stream", false, `{"service":"tasksService.TaskService","method":"DownloadAttachment"}`, RetryPolicy{}},
+		{"disabled", false, `{"service":"usersService.UserService","method":"Get"}`, RetryPolicy{MaxAttempts: -1}},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.policy.serviceConfig()
+			// Checking the error
+			if err != nil {
+				t.Errorf("RetryPolicy.serviceConfig() error = %v", err)
+				return
+			}
+			if strings.Contains(got, tt.method) != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("RetryPolicy.serviceConfig() = %v, want %s retried %v", got, tt.method, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"github.com/JECSand/go-grpc-server-boilerplate/config"
 	"github.com/JECSand/go-grpc-server-boilerplate/database"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
@@ -9,33 +10,46 @@ import (
 	"github.com/JECSand/go-grpc-server-boilerplate/services"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"go.mongodb.org/mongo-driver/bson"
-	"os"
+	"io"
 	"time"
 )
 
 // App is the highest level struct of the rest_api application. Stores the server, client, and config settings.
 type App struct {
-	server *server.Server
-	db     database.DBClient
+	server         *server.Server
+	db             database.DBClient
+	tracerShutdown func(context.Context) error
+	conf           *config.Configuration
+	cfgFlags       *config.Flags
+	out            io.Writer
+}
+
+// loadConfigurations loads the layered config settings, overridden by the config flags of the running subcommand
+func (a *App) loadConfigurations() (err error) {
+	a.conf, err = config.Load(a.cfgFlags)
+	if err != nil {
+		return err
+	}
+	models.SetTokenSecret(a.conf.TokenSecret)
+	return nil
+}
+
+// connectDB initializes & connects the DB Client
+func (a *App) connectDB() (err error) {
+	a.db, err = database.InitializeNewClient(a.conf)
+	if err != nil {
+		return err
+	}
+	return a.db.Connect()
 }
 
 // Initialize is a function used to initialize a new instantiation of the API Application
 func (a *App) Initialize() error {
-	var err error
 	// 1) Initialize config settings & set environmental variables
-	var conf *config.Configuration
-	if os.Getenv("ENV") != "docker-dev" {
-		conf, err = config.GetConfigurations()
-		if err != nil {
-			return err
-		}
-		conf.InitializeEnvironmentalVars()
-	} else {
-		conf, err = config.GetDevConfigurations()
-		if err != nil {
-			return err
-		}
+	if err := a.loadConfigurations(); err != nil {
+		return err
 	}
+	conf := a.conf
 	appLogger := utilities.NewAPILogger(conf)
 	appLogger.InitLogger()
 	appLogger.Info("Starting user server")
@@ -44,31 +58,62 @@ func (a *App) Initialize() error {
 		conf.Logger.Level,
 		conf.ENV,
 	)
-	// 2) Initialize & Connect DB Client
-	a.db, err = database.InitializeNewClient()
+	appLogger.Debugf("Configuration: %v", conf)
+	cfgStore := config.NewStore(conf, a.cfgFlags)
+	cfgStore.OnReload(func(c *config.Configuration) {
+		if err := appLogger.SetLevel(c.Logger.Level); err != nil {
+			appLogger.Errorf("appLogger.SetLevel: %v", err)
+		}
+	})
+	var err error
+	a.tracerShutdown, err = utilities.InitTracer(conf)
 	if err != nil {
 		return err
 	}
-	err = a.db.Connect()
+	// 2) Initialize & Connect DB Client, then apply pending schema migrations
+	if err = a.connectDB(); err != nil {
+		return err
+	}
+	migrated, err := database.NewMigrator(a.db, a.db.NewMigrationHandler()).Up(context.Background())
 	if err != nil {
 		return err
 	}
+	for _, m := range migrated {
+		appLogger.Infof("Applied migration %d: %s", m.Version, m.Description)
+	}
 	// 3) Initial DB Services
 	gHandler := a.db.NewGroupHandler()
 	uHandler := a.db.NewUserHandler()
 	blHandler := a.db.NewBlacklistHandler()
 	tHandler := a.db.NewTaskHandler()
 	fHandler := a.db.NewFileHandler()
+	aHandler := a.db.NewAuditHandler()
+	oHandler := a.db.NewOutboxHandler()
+	whHandler := a.db.NewWebhookHandler()
+	dHandler := a.db.NewDeliveryHandler()
+	mHandler := a.db.NewMembershipHandler()
+	iHandler := a.db.NewInviteHandler()
+	cHandler := a.db.NewCommentHandler()
+	acHandler := a.db.NewActivityHandler()
 	gService := database.NewGroupService(a.db, gHandler)
 	uService := database.NewUserService(a.db, uHandler, gHandler)
 	bService := database.NewBlacklistService(a.db, blHandler)
-	tService := services.NewTokenService(uService, gService, bService)
-	ttService := database.NewTaskService(a.db, tHandler, uHandler, gHandler)
-	fService := database.NewFileService(a.db, fHandler, uHandler, gHandler)
+	mService := database.NewMembershipService(a.db, mHandler)
+	tService := services.NewTokenService(uService, gService, bService, mService)
+	ttService := database.NewTaskService(a.db, tHandler, uHandler, gHandler, mHandler)
+	fService := database.NewFileService(a.db, fHandler, uHandler, gHandler, tHandler)
+	aService := database.NewAuditService(a.db, aHandler)
+	oService := database.NewOutboxService(a.db, oHandler)
+	whService := database.NewWebhookService(a.db, whHandler, dHandler)
+	iService := database.NewInviteService(a.db, iHandler)
+	cService := database.NewCommentService(a.db, cHandler, acHandler)
+	eventBus := services.NewEventBus(appLogger, oService)
+	dispatcher := services.NewWebhookDispatcher(appLogger, whService, cfgStore)
+	eventBus.Subscribe(models.EventAll, dispatcher.HandleEvent)
 	// 4) Create RootAdmin user if database is empty
 	var group models.Group
 	var adminUser models.User
-	group.Name = os.Getenv("ROOT_GROUP")
+	group.Name = conf.RootGroup
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 	docCount, err := a.db.GetCollection("groups").CountDocuments(ctx, bson.M{})
@@ -76,36 +121,82 @@ func (a *App) Initialize() error {
 		return err
 	}
 	if docCount == 0 {
-		group.RootAdmin = true
-		group.Id = utilities.GenerateObjectID()
-		if os.Getenv("ENV") == "test" {
+		if conf.ENV == "test" {
 			group.Id = "000000000000000000002222"
-		}
-		adminGroup, err := gService.GroupCreate(&group)
-		if err != nil {
-			return err
-		}
-		if os.Getenv("ENV") == "test" {
 			adminUser.Id = "000000000000000000002221"
 		}
-		adminUser.Username = os.Getenv("ROOT_ADMIN")
-		adminUser.Email = os.Getenv("ROOT_EMAIL")
-		adminUser.Password = os.Getenv("ROOT_PASSWORD")
-		adminUser.FirstName = "root"
-		adminUser.LastName = "admin"
-		adminUser.GroupId = adminGroup.Id
-		_, err = uService.UserCreate(&adminUser)
+		adminUser.Username = conf.RootAdmin
+		adminUser.Email = conf.RootEmail
+		adminUser.Password = conf.RootPassword
+		_, err = bootstrapRootAdmin(ctx, gService, uService, &group, &adminUser)
 		if err != nil {
 			return err
 		}
 	}
 	// 5) Initialize Server
-	a.server = server.NewServer(appLogger, conf, uService, gService, ttService, fService, tService)
+	a.server = server.NewServer(appLogger, cfgStore, uService, gService, ttService, fService, aService, whService, mService,
+		iService, cService, eventBus, dispatcher, a.db, tService, utilities.NewMemoryRateLimitStore())
 	return nil
 }
 
+// bootstrapRootAdmin creates the root admin group, unless a root admin group with its name exists, and a root admin
+// User within it
+func bootstrapRootAdmin(ctx context.Context, gService *database.GroupService, uService *database.UserService, group *models.Group, admin *models.User) (*models.User, error) {
+	if group.Name == "" {
+		return nil, errors.New("missing the root admin group name")
+	}
+	if admin.FirstName == "" && admin.LastName == "" {
+		admin.FirstName = "root"
+		admin.LastName = "admin"
+	}
+	if err := admin.Validate("create"); err != nil {
+		return nil, err
+	}
+	groups, err := gService.GroupsFind(ctx, &models.Group{Name: group.Name})
+	if err != nil {
+		return nil, err
+	}
+	var rootGroup *models.Group
+	if len(groups) > 0 {
+		rootGroup = groups[0]
+		if !rootGroup.RootAdmin {
+			return nil, errors.New("group " + group.Name + " exists and is not a root admin group")
+		}
+	} else {
+		if group.Id == "" {
+			group.Id = utilities.GenerateObjectID()
+		}
+		group.RootAdmin = true
+		rootGroup, err = gService.GroupCreate(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if _, err = uService.UserFind(ctx, &models.User{Email: admin.Email}); err == nil {
+		return nil, errors.New("email is taken")
+	}
+	if admin.Id == "" {
+		admin.Id = utilities.GenerateObjectID()
+	}
+	admin.Role = "admin"
+	admin.RootAdmin = true
+	admin.GroupId = rootGroup.Id
+	admin.CreatedAt = time.Now().UTC()
+	admin.LastModified = admin.CreatedAt
+	user, err := uService.UserDocInsert(ctx, admin)
+	if err != nil {
+		return nil, err
+	}
+	return user.Public(), nil
+}
+
 // Run is a function used to run a previously initialized API Application
 func (a *App) Run() {
 	defer a.db.Close()
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		a.tracerShutdown(ctx)
+	}()
 	a.server.Start()
 }
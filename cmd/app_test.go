@@ -1,17 +1,38 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	adminsService "github.com/JECSand/go-grpc-server-boilerplate/protos/admin"
+	auditsService "github.com/JECSand/go-grpc-server-boilerplate/protos/audit"
 	authsService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
 	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
 	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
 	usersService "github.com/JECSand/go-grpc-server-boilerplate/protos/user"
+	webhooksService "github.com/JECSand/go-grpc-server-boilerplate/protos/webhook"
+	"github.com/JECSand/go-grpc-server-boilerplate/services"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 // Setup Tests
@@ -65,6 +86,11 @@ func Test_AuthRegister(t *testing.T) {
 	conn, closer := ta.server.StartTest(ctx)
 	client := authsService.NewAuthServiceClient(conn)
 	defer closer()
+	published := make(map[string]string) // the aggregate id of each dispatched event type
+	ta.server.EventBus.Subscribe(models.EventAll, func(ctx context.Context, e *models.DomainEvent) error {
+		published[e.Type] = e.AggregateId
+		return nil
+	})
 	// Defining our test slice. Each unit test should have the following properties:
 	tests := []struct {
 		name    string                    // The name of the test
@@ -146,6 +172,12 @@ func Test_AuthRegister(t *testing.T) {
 				if out.User.Username != tt.res.User.Username || out.User.Id == "" {
 					t.Errorf("authsService.Register() \nWant: %q\nGot: %q\n", out.User.Username, tt.res.User.Username)
 				}
+				if _, err = ta.server.EventBus.Dispatch(context.Background()); err != nil {
+					t.Fatalf("EventBus.Dispatch() error = %v", err)
+				}
+				if published[models.EventGroupCreated] != out.User.GroupId || published[models.EventUserCreated] != out.User.Id {
+					t.Errorf("authsService.Register() published %v, want the group and user created", published)
+				}
 			default:
 				if out != tt.res { // Asserting whether we get the correct wanted value
 					t.Errorf("authsService.Register() \nWant: %q\\nGot: %q\n", out, tt.res)
@@ -1168,7 +1200,18 @@ func Test_GroupDelete(t *testing.T) {
 	defer closer()
 	tAdmin := &models.User{Id: "000000000000000000002221", GroupId: "000000000000000000002222", Role: "admin", RootAdmin: true}
 	_ = createTestGroup(ta, 2)
-	_ = createTestUser(ta, 2)
+	tUser := createTestUser(ta, 2)
+	// a task the group's user owns in another group
+	otherTask, err := ta.server.TaskDataService.TaskDocInsert(ctx, &models.Task{
+		Id:      utilities.GenerateObjectID(),
+		Name:    "otherGroupTask",
+		Due:     time.Now().Add(time.Hour).UTC(),
+		UserId:  tUser.Id,
+		GroupId: "000000000000000000000002",
+	})
+	if err != nil {
+		t.Fatalf("TaskDataService.TaskDocInsert() error = %v", err)
+	}
 	// Defining our test slice. Each unit test should have the following properties:
 	tests := []struct {
 		name    string                   // The name of the test
@@ -1220,6 +1263,9 @@ func Test_GroupDelete(t *testing.T) {
 				if out.Group.Name != tt.res.Group.Name || out.Group.Id == "" {
 					t.Errorf("groupsService.Delete() \nWant: %q\nGot: %q\n", out.Group.Name, tt.res.Group.Name)
 				}
+				if _, err = ta.server.TaskDataService.TaskFind(ctx, &models.Task{Id: otherTask.Id}); err == nil {
+					t.Errorf("groupsService.Delete() kept the task %s of a deleted user in another group", otherTask.Id)
+				}
 			default:
 				if out != tt.res { // Asserting whether we get the correct wanted value
 					t.Errorf("groupsService.Delete() \nWant: %q\\nGot: %q\n", out, tt.res)
@@ -1493,6 +1539,59 @@ func Test_TaskFind(t *testing.T) {
 	}
 }
 
+func Test_TaskFindScope(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	client := tasksService.NewTaskServiceClient(conn)
+	defer closer()
+	tUser := setupTestUser(ta, true, 1)
+	tAdmin := setupTestAdminUser(ta, false, true, 2)
+	tTasks := []*models.Task{
+		createTestTask(ta, 1),
+		createTestTask(ta, 3),
+	}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string                // The name of the test
+		user *models.User          // The user making the request
+		req  *tasksService.FindReq // The input of the test
+		want []string              // The ids of the tasks we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"member finds its group's tasks", tUser, &tasksService.FindReq{Page: 1, Size: 10}, []string{tTasks[0].Id, tTasks[1].Id}},
+		{"admin of another group without filter", tAdmin, &tasksService.FindReq{Page: 1, Size: 10}, []string{}},
+		{
+			"admin of another group by group",
+			tAdmin,
+			&tasksService.FindReq{Task: &tasksService.Task{GroupId: tUser.GroupId}, Page: 1, Size: 10},
+			[]string{},
+		},
+		{
+			"admin of another group by id",
+			tAdmin,
+			&tasksService.FindReq{Task: &tasksService.Task{Id: tTasks[1].Id}, Page: 1, Size: 10},
+			[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := client.Find(setupTestAuthCtx(ta, ctx, tt.user, ""), tt.req)
+			if err != nil {
+				t.Fatalf("tasksService.Find() error = %v", err)
+			}
+			if len(out.Tasks) != len(tt.want) {
+				t.Fatalf("tasksService.Find() = %v, want %v", out.Tasks, tt.want)
+			}
+			for i, task := range out.Tasks {
+				if task.Id != tt.want[i] {
+					t.Errorf("tasksService.Find()[%d] = %v, want %v", i, task.Id, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
 func Test_TaskGetGroupTasks(t *testing.T) {
 	ctx := context.Background()
 	ta := setup()
@@ -1694,3 +1793,2660 @@ func Test_TaskDelete(t *testing.T) {
 		})
 	}
 }
+
+func Test_TaskWatch(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	client := tasksService.NewTaskServiceClient(conn)
+	defer closer()
+	tUser := setupTestUser(ta, true, 1)
+	tAdmin := createTestAdminUser(ta, 1, false)
+	adminCtx := setupTestAuthCtx(ta, ctx, tAdmin, "")
+	var resumeToken string
+	var taskId string
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name     string // The name of the test
+		wantType string // The type of the first event we want the stream to deliver
+		wantErr  bool   // whether we want an error.
+		resume   bool   // whether the watch resumes from the last received token
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"member scope",
+			models.TaskChangeCreated,
+			false,
+			false,
+		},
+		{
+			"resume",
+			models.TaskChangeUpdated,
+			false,
+			true,
+		},
+		{
+			"invalid resume token",
+			"",
+			true,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &tasksService.WatchTasksReq{}
+			switch tt.name {
+			case "resume":
+				// the task changes while the watcher is disconnected
+				_, err := client.Update(adminCtx, &tasksService.UpdateReq{Id: taskId, Name: "watchedTask", Status: tasksService.TaskStatus_IN_PROGRESS})
+				if err != nil {
+					t.Fatalf("tasksService.Update() error = %v", err)
+				}
+				req.ResumeToken = resumeToken
+			case "invalid resume token":
+				req.ResumeToken = "not-a-token"
+			}
+			watchCtx, cancel := context.WithCancel(setupTestAuthCtx(ta, ctx, tUser, ""))
+			defer cancel()
+			stream, err := client.WatchTasks(watchCtx, req)
+			if err != nil {
+				t.Fatalf("tasksService.WatchTasks() error = %v", err)
+			}
+			if tt.name == "member scope" {
+				// the headers arrive once the watch is live
+				if _, err = stream.Header(); err != nil {
+					t.Fatalf("stream.Header() error = %v", err)
+				}
+				for _, userId := range []string{tAdmin.Id, tUser.Id} {
+					_, err = client.Create(adminCtx, &tasksService.CreateReq{
+						Name:    "watchedTask",
+						Due:     timestamppb.Now(),
+						UserId:  userId,
+						GroupId: tAdmin.GroupId,
+					})
+					if err != nil {
+						t.Fatalf("tasksService.Create() error = %v", err)
+					}
+				}
+			}
+			out, err := stream.Recv()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("tasksService.WatchTasks() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if out.Type != tt.wantType || out.Task.UserId != tUser.Id || out.ResumeToken == "" {
+				t.Errorf("tasksService.WatchTasks() \nWant: %q for user %q\nGot: %q for user %q\n", tt.wantType, tUser.Id, out.Type, out.Task.UserId)
+			}
+			taskId = out.Task.Id
+			resumeToken = out.ResumeToken
+		})
+	}
+}
+
+/*
+AUDIT TESTS
+*/
+
+func Test_AuditFind(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	client := auditsService.NewAuditServiceClient(conn)
+	authClient := authsService.NewAuthServiceClient(conn)
+	defer closer()
+	_, err := authClient.Login(ctx, &authsService.LoginReq{Email: "master@test.com", Password: "321test123"})
+	if err != nil {
+		t.Fatalf("authsService.Login() error = %v", err)
+	}
+	tRoot := &models.User{Id: "000000000000000000002221", Role: "admin", RootAdmin: true, GroupId: "000000000000000000002222"}
+	tUser := setupTestUser(ta, true, 1)
+	tAdmin := setupTestAdminUser(ta, false, false, 1)
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string                 // The name of the test
+		want    int64                  // What out instance we want our function to return.
+		wantErr bool                   // whether we want an error.
+		user    *models.User           // The requesting user
+		req     *auditsService.FindReq // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"root admin",
+			1,
+			false,
+			tRoot,
+			&auditsService.FindReq{Action: models.AuditLogin, Page: 1, Size: 10},
+		},
+		{
+			"group admin scoped",
+			0,
+			false,
+			tAdmin,
+			&auditsService.FindReq{GroupId: tRoot.GroupId, Action: models.AuditLogin, Page: 1, Size: 10},
+		},
+		{
+			"member denied",
+			0,
+			true,
+			tUser,
+			&auditsService.FindReq{Page: 1, Size: 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx = setupTestAuthCtx(ta, ctx, tt.user, "")
+			out, err := client.Find(ctx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("auditsService.Find() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && out.TotalCount != tt.want {
+				t.Errorf("auditsService.Find() \nWant: %d\nGot: %d\n", tt.want, out.TotalCount)
+			}
+		})
+	}
+}
+
+/*
+ADMIN TESTS
+*/
+
+func Test_AdminGetConfig(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	client := adminsService.NewAdminServiceClient(conn)
+	defer closer()
+	tRoot := &models.User{Id: "000000000000000000002221", Role: "admin", RootAdmin: true, GroupId: "000000000000000000002222"}
+	tAdmin := setupTestAdminUser(ta, false, true, 1)
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string       // The name of the test
+		wantErr bool         // whether we want an error.
+		user    *models.User // The requesting user
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"root admin",
+			false,
+			tRoot,
+		},
+		{
+			"group admin denied",
+			true,
+			tAdmin,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx = setupTestAuthCtx(ta, ctx, tt.user, "")
+			out, err := client.GetConfig(ctx, &adminsService.GetConfigReq{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("adminsService.GetConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if !strings.Contains(out.Config, `"TokenSecret":"[REDACTED]"`) || strings.Contains(out.Config, "TESTINGSALT") ||
+				strings.Contains(out.Config, "321test123") {
+				t.Errorf("adminsService.GetConfig() secrets are not redacted: %s", out.Config)
+			}
+			if !strings.Contains(out.Config, `"Registration":"ON"`) || len(out.Reloadable) == 0 {
+				t.Errorf("adminsService.GetConfig() = %v", out)
+			}
+		})
+	}
+}
+
+func Test_AdminReloadConfig(t *testing.T) {
+	ctx := context.Background()
+	tRoot := &models.User{Id: "000000000000000000002221", Role: "admin", RootAdmin: true, GroupId: "000000000000000000002222"}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name             string            // The name of the test
+		env              map[string]string // The environment variables set before the reload
+		wantApplied      []string          // The settings we want the reload to apply
+		wantIgnored      []string          // The settings we want the reload to ignore
+		wantErr          bool              // whether we want an error.
+		wantRegistration bool              // whether we want registration to be open afterwards
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"unchanged",
+			nil,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"registration and log level",
+			map[string]string{"REGISTRATION": "OFF", "LOG_LEVEL": "debug", "PORT": ":6000"},
+			[]string{"Server.Registration", "Logger.Level"},
+			[]string{"Server.Port"},
+			false,
+			false,
+		},
+		{
+			"invalid config rejected",
+			map[string]string{"REGISTRATION": "OFF", "LOG_LEVEL": "loud"},
+			nil,
+			nil,
+			true,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ta := setup()
+			conn, closer := ta.server.StartTest(ctx)
+			client := adminsService.NewAdminServiceClient(conn)
+			authClient := authsService.NewAuthServiceClient(conn)
+			defer closer()
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			reqCtx := setupTestAuthCtx(ta, ctx, tRoot, "")
+			out, err := client.ReloadConfig(reqCtx, &adminsService.ReloadConfigReq{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("adminsService.ReloadConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && (strings.Join(out.Applied, ",") != strings.Join(tt.wantApplied, ",") ||
+				strings.Join(out.Ignored, ",") != strings.Join(tt.wantIgnored, ",")) {
+				t.Errorf("adminsService.ReloadConfig() \nApplied: %v, Ignored: %v\nWant: %v, %v\n", out.Applied, out.Ignored, tt.wantApplied, tt.wantIgnored)
+			}
+			_, err = authClient.Register(ctx, &authsService.RegisterReq{
+				FirstName: "Reload",
+				LastName:  "Tester",
+				Username:  "reload_tester",
+				Email:     "reload@test.com",
+				Password:  "abc123",
+			})
+			if (err == nil) != tt.wantRegistration {
+				t.Errorf("authsService.Register() error = %v, want registration open %v", err, tt.wantRegistration)
+			}
+		})
+	}
+}
+
+/*
+WEBHOOK TESTS
+*/
+
+func Test_WebhookDelivery(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	client := webhooksService.NewWebhookServiceClient(conn)
+	taskClient := tasksService.NewTaskServiceClient(conn)
+	defer closer()
+	tAdmin := setupTestAdminUser(ta, false, true, 1)
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name   string // The name of the test
+		want   string // What out instance we want our function to return.
+		status int    // The status code returned by the webhook endpoint
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"delivered", models.DeliverySucceeded, http.StatusOK},
+		{"dead lettered", models.DeliveryDead, http.StatusInternalServerError},
+		{"redirect not followed", models.DeliveryDead, http.StatusFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received := make(chan *http.Request, 10)
+			bodies := make(chan []byte, 10)
+			endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				received <- r
+				bodies <- body
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", "http://169.254.169.254/latest/meta-data")
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer endpoint.Close()
+			ctx = setupTestAuthCtx(ta, ctx, tAdmin, "")
+			hook, err := client.Create(ctx, &webhooksService.CreateReq{Url: endpoint.URL, EventTypes: []string{models.EventTaskCreated}})
+			if err != nil {
+				t.Fatalf("webhooksService.Create() error = %v", err)
+			}
+			if hook.Secret == "" || hook.Webhook.GroupId != tAdmin.GroupId {
+				t.Fatalf("webhooksService.Create() = %v", hook)
+			}
+			_, err = taskClient.Create(ctx, &tasksService.CreateReq{
+				Name:    "webhookTask",
+				Due:     timestamppb.Now(),
+				UserId:  tAdmin.Id,
+				GroupId: tAdmin.GroupId,
+			})
+			if err != nil {
+				t.Fatalf("tasksService.Create() error = %v", err)
+			}
+			if _, err = ta.server.EventBus.Dispatch(context.Background()); err != nil {
+				t.Fatalf("EventBus.Dispatch() error = %v", err)
+			}
+			for i := 0; i < 3; i++ { // test_conf.json allows 3 attempts without backoff
+				if _, err = ta.server.WebhookDispatcher.ProcessDue(context.Background()); err != nil {
+					t.Fatalf("WebhookDispatcher.ProcessDue() error = %v", err)
+				}
+			}
+			r, body := <-received, <-bodies
+			timestamp := r.Header.Get(services.WebhookTimestampHeader)
+			wantSig := "sha256=" + utilities.SignPayload(hook.Secret, timestamp, body)
+			if r.Header.Get(services.WebhookSignatureHeader) != wantSig || r.Header.Get(services.WebhookEventHeader) != models.EventTaskCreated {
+				t.Errorf("webhook request headers = %v, want signature %s", r.Header, wantSig)
+			}
+			history, err := client.GetDeliveries(ctx, &webhooksService.GetDeliveriesReq{WebhookId: hook.Webhook.Id, Page: 1, Size: 10})
+			if err != nil {
+				t.Fatalf("webhooksService.GetDeliveries() error = %v", err)
+			}
+			if history.TotalCount != 1 || history.Deliveries[0].Status != tt.want {
+				t.Errorf("webhooksService.GetDeliveries() \nWant: %s\nGot: %v\n", tt.want, history.Deliveries)
+				return
+			}
+			if history.Deliveries[0].ResponseCode != int64(tt.status) {
+				t.Errorf("webhooksService.GetDeliveries() response code = %d, want %d", history.Deliveries[0].ResponseCode, tt.status)
+			}
+			if tt.want == models.DeliveryDead {
+				out, err := client.Redeliver(ctx, &webhooksService.RedeliverReq{DeliveryId: history.Deliveries[0].Id})
+				if err != nil || out.Delivery.Status != models.DeliveryPending || out.Delivery.Attempts != 0 {
+					t.Errorf("webhooksService.Redeliver() = %v, %v", out, err)
+				}
+			}
+		})
+	}
+}
+
+func Test_WebhookPrivateAddress(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	client := webhooksService.NewWebhookServiceClient(conn)
+	defer closer()
+	tAdmin := setupTestAdminUser(ta, false, true, 1)
+	received := make(chan *http.Request, 10)
+	endpoint := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r
+	}))
+	defer endpoint.Close()
+	ta.conf.Events.WebhookAllowPrivate = false // the endpoint listens on a loopback address
+	ctx = setupTestAuthCtx(ta, ctx, tAdmin, "")
+	hook, err := client.Create(ctx, &webhooksService.CreateReq{Url: endpoint.URL, EventTypes: []string{models.EventTaskCreated}})
+	if err != nil {
+		t.Fatalf("webhooksService.Create() error = %v", err)
+	}
+	_, err = ta.server.WebhookDataService.DeliveryCreate(ctx, &models.WebhookDelivery{
+		WebhookId: hook.Webhook.Id,
+		GroupId:   tAdmin.GroupId,
+		EventId:   "000000000000000000000091",
+		EventType: models.EventTaskCreated,
+		Payload:   "{}",
+		Status:    models.DeliveryPending,
+	})
+	if err != nil {
+		t.Fatalf("WebhookDataService.DeliveryCreate() error = %v", err)
+	}
+	if _, err = ta.server.WebhookDispatcher.ProcessDue(context.Background()); err != nil {
+		t.Fatalf("WebhookDispatcher.ProcessDue() error = %v", err)
+	}
+	if len(received) != 0 {
+		t.Errorf("the webhook endpoint on a loopback address was called")
+	}
+	history, err := client.GetDeliveries(ctx, &webhooksService.GetDeliveriesReq{WebhookId: hook.Webhook.Id, Page: 1, Size: 10})
+	if err != nil {
+		t.Fatalf("webhooksService.GetDeliveries() error = %v", err)
+	}
+	if history.TotalCount != 1 || !strings.Contains(history.Deliveries[0].LastError, "not a public address") {
+		t.Errorf("webhooksService.GetDeliveries() = %v, want a delivery refused as not public", history.Deliveries)
+	}
+}
+
+/*
+INTERCEPTOR TESTS
+*/
+
+func Test_RequestID(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	client := authsService.NewAuthServiceClient(conn)
+	defer closer()
+	tests := []struct {
+		name      string // The name of the test
+		requestID string // The request ID sent by the client
+		wantEcho  bool   // whether we want the sent request ID returned
+	}{
+		{
+			"incoming",
+			"test-request-id",
+			true,
+		},
+		{
+			"generated",
+			"",
+			false,
+		},
+		{
+			"invalid",
+			strings.Repeat("x", 200),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqCtx := ctx
+			if tt.requestID != "" {
+				reqCtx = metadata.AppendToOutgoingContext(ctx, utilities.RequestIDHeader, tt.requestID)
+			}
+			var header metadata.MD
+			_, _ = client.Login(reqCtx, &authsService.LoginReq{Email: "master@test.com", Password: "wrong"}, grpc.Header(&header))
+			got := header.Get(utilities.RequestIDHeader)
+			if len(got) != 1 || got[0] == "" {
+				t.Errorf("authsService.Login() missing %s header, got %v", utilities.RequestIDHeader, got)
+				return
+			}
+			if (got[0] == tt.requestID) != tt.wantEcho {
+				t.Errorf("authsService.Login() \nRequest ID: %q\nGot: %q\n", tt.requestID, got[0])
+			}
+		})
+	}
+}
+
+func Test_RequestValidation(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	tUser := setupTestAdminUser(ta, false, true, 1)
+	authCtx := setupTestAuthCtx(ta, ctx, tUser, "")
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name   string       // The name of the test
+		call   func() error // The request of the test
+		fields []string     // The fields we want reported as violations, in order
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"register email and username",
+			func() error {
+				_, err := authsService.NewAuthServiceClient(conn).Register(ctx, &authsService.RegisterReq{
+					Email:    "not-an-email",
+					Username: "ab",
+					Password: "321test123",
+				})
+				return err
+			},
+			[]string{"Email", "Username"},
+		},
+		{
+			"task bad user id",
+			func() error {
+				_, err := tasksService.NewTaskServiceClient(conn).Create(authCtx, &tasksService.CreateReq{
+					Name:   "testTask",
+					UserId: "123",
+				})
+				return err
+			},
+			[]string{"UserId"},
+		},
+		{
+			"task due out of range",
+			func() error {
+				_, err := tasksService.NewTaskServiceClient(conn).Create(authCtx, &tasksService.CreateReq{
+					Name: "testTask",
+					Due:  timestamppb.New(time.Now().AddDate(500, 0, 0)),
+				})
+				return err
+			},
+			[]string{"Due"},
+		},
+		{
+			"user get bad id",
+			func() error {
+				_, err := usersService.NewUserServiceClient(conn).Get(authCtx, &usersService.GetReq{Id: "xyz"})
+				return err
+			},
+			[]string{"Id"},
+		},
+		{
+			"user find nested bad group id",
+			func() error {
+				_, err := usersService.NewUserServiceClient(conn).Find(authCtx, &usersService.FindReq{
+					User: &usersService.User{GroupId: "xyz"},
+				})
+				return err
+			},
+			[]string{"User.GroupId"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(tt.call())
+			if st.Code() != codes.InvalidArgument {
+				t.Errorf("code = %v, want %v: %v", st.Code(), codes.InvalidArgument, st.Message())
+				return
+			}
+			var got []string
+			for _, d := range st.Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					for _, v := range br.FieldViolations {
+						got = append(got, v.Field)
+					}
+				}
+			}
+			if strings.Join(got, ",") != strings.Join(tt.fields, ",") {
+				t.Errorf("field violations = %v, want %v", got, tt.fields)
+			}
+		})
+	}
+}
+
+func Test_ErrorDetails(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	tUser := setupTestAdminUser(ta, false, true, 1)
+	authCtx := setupTestAuthCtx(ta, ctx, tUser, "")
+	missingId := "000000000000000000000099"
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name     string       // The name of the test
+		call     func() error // The request of the test
+		code     codes.Code   // The status code we want
+		reason   string       // The ErrorInfo reason we want
+		resource string       // The ResourceInfo type we want, if any
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"task not found",
+			func() error {
+				_, err := tasksService.NewTaskServiceClient(conn).Get(authCtx, &tasksService.GetReq{Id: missingId})
+				return err
+			},
+			codes.NotFound,
+			"TASK_NOT_FOUND",
+			"task",
+		},
+		{
+			"email taken",
+			func() error {
+				_, err := authsService.NewAuthServiceClient(conn).Register(ctx, &authsService.RegisterReq{
+					Email:    "master@test.com",
+					Username: "tester123",
+					Password: "321test123",
+				})
+				return err
+			},
+			codes.AlreadyExists,
+			"USER_ALREADY_EXISTS",
+			"user",
+		},
+		{
+			"wrong password",
+			func() error {
+				_, err := authsService.NewAuthServiceClient(conn).Login(ctx, &authsService.LoginReq{
+					Email:    "master@test.com",
+					Password: "wrong",
+				})
+				return err
+			},
+			codes.Unauthenticated,
+			"UNAUTHENTICATED",
+			"",
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(tt.call())
+			if st.Code() != tt.code {
+				t.Errorf("code = %v, want %v: %v", st.Code(), tt.code, st.Message())
+				return
+			}
+			var reason, resource string
+			for _, d := range st.Details() {
+				switch detail := d.(type) {
+				case *errdetails.ErrorInfo:
+					reason = detail.Reason
+				case *errdetails.ResourceInfo:
+					resource = detail.ResourceType
+				}
+			}
+			if reason != tt.reason || resource != tt.resource {
+				t.Errorf("details reason = %q, resource = %q, want %q, %q", reason, resource, tt.reason, tt.resource)
+			}
+		})
+	}
+}
+
+func Test_RateLimit(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name      string // The name of the test
+		key       string // The identity the limit is kept per
+		otherPass bool   // whether another user's request passes once the first user is limited
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"per ip",
+			"ip",
+			false,
+		},
+		{
+			"per user",
+			"user",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("RATE_LIMIT_RATE", "0.01")
+			t.Setenv("RATE_LIMIT_BURST", "2")
+			t.Setenv("RATE_LIMIT_KEY", tt.key)
+			ctx := context.Background()
+			ta := setup()
+			conn, closer := ta.server.StartTest(ctx)
+			client := usersService.NewUserServiceClient(conn)
+			defer closer()
+			tUser := setupTestUser(ta, true, 1)
+			oUser := setupTestAdminUser(ta, false, false, 1)
+			uCtx := setupTestAuthCtx(ta, ctx, tUser, "")
+			for i := 0; i < 2; i++ {
+				if _, err := client.Get(uCtx, &usersService.GetReq{Id: tUser.Id}); err != nil {
+					t.Errorf("usersService.Get() request %d error = %v", i+1, err)
+					return
+				}
+			}
+			var header metadata.MD
+			_, err := client.Get(uCtx, &usersService.GetReq{Id: tUser.Id}, grpc.Header(&header))
+			st := status.Convert(err)
+			if st.Code() != codes.ResourceExhausted {
+				t.Errorf("usersService.Get() code = %v, want %v", st.Code(), codes.ResourceExhausted)
+				return
+			}
+			if got := header.Get(utilities.RetryAfterHeader); len(got) != 1 || got[0] != "100" {
+				t.Errorf("usersService.Get() %s header = %v, want [100]", utilities.RetryAfterHeader, got)
+			}
+			if len(st.Details()) != 1 {
+				t.Errorf("usersService.Get() details = %v, want a RetryInfo", st.Details())
+			} else if _, ok := st.Details()[0].(*errdetails.RetryInfo); !ok {
+				t.Errorf("usersService.Get() details = %v, want a RetryInfo", st.Details())
+			}
+			_, err = client.Get(setupTestAuthCtx(ta, ctx, oUser, ""), &usersService.GetReq{Id: oUser.Id})
+			if (err == nil) != tt.otherPass {
+				t.Errorf("usersService.Get() other user error = %v, want pass %v", err, tt.otherPass)
+			}
+		})
+	}
+}
+
+func Test_GroupQuotas(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name  string // The name of the test
+		env   string // The quota environment variable
+		limit string // The quota of the test group, which already holds the admin user
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"max users",
+			"QUOTA_MAX_USERS_PER_GROUP",
+			"2",
+		},
+		{
+			"max tasks",
+			"QUOTA_MAX_TASKS_PER_GROUP",
+			"1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.env, tt.limit)
+			ctx := context.Background()
+			ta := setup()
+			conn, closer := ta.server.StartTest(ctx)
+			defer closer()
+			tUser := setupTestAdminUser(ta, false, true, 1)
+			ctx = setupTestAuthCtx(ta, ctx, tUser, "")
+			create := func(i int) error {
+				if tt.name == "max users" {
+					_, err := usersService.NewUserServiceClient(conn).Create(ctx, &usersService.CreateReq{
+						FirstName: "Jack",
+						LastName:  "Testings",
+						Email:     fmt.Sprintf("quota%d@test.com", i),
+						Username:  fmt.Sprintf("quota%d", i),
+						Password:  "321test123",
+					})
+					return err
+				}
+				_, err := tasksService.NewTaskServiceClient(conn).Create(ctx, &tasksService.CreateReq{
+					Name:    fmt.Sprintf("quotaTask%d", i),
+					Due:     timestamppb.Now(),
+					UserId:  tUser.Id,
+					GroupId: tUser.GroupId,
+				})
+				return err
+			}
+			if err := create(1); err != nil {
+				t.Errorf("Create() within quota error = %v", err)
+				return
+			}
+			if err := create(2); status.Code(err) != codes.ResourceExhausted {
+				t.Errorf("Create() over quota error = %v, want %v", err, codes.ResourceExhausted)
+			}
+		})
+	}
+}
+
+func Test_GroupMemberships(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	groups := groupsService.NewGroupServiceClient(conn)
+	auth := authsService.NewAuthServiceClient(conn)
+	tAdmin := setupTestAdminUser(ta, false, true, 1)
+	tUser := setupTestUser(ta, true, 2)
+	adminCtx := setupTestAuthCtx(ta, ctx, tAdmin, "")
+	userCtx := setupTestAuthCtx(ta, ctx, tUser, "")
+	var switchedCtx context.Context
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string       // The name of the test
+		call func() error // The calls of the test, which build on the previous tests
+		code codes.Code   // The status code we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"non member cannot list",
+			func() error {
+				_, err := groups.ListMembers(userCtx, &groupsService.ListMembersReq{GroupId: tAdmin.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"member cannot add",
+			func() error {
+				_, err := groups.AddMember(userCtx, &groupsService.AddMemberReq{GroupId: tUser.GroupId, UserId: tAdmin.Id})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"add member",
+			func() error {
+				res, err := groups.AddMember(adminCtx, &groupsService.AddMemberReq{GroupId: tAdmin.GroupId, UserId: tUser.Id})
+				if err == nil && (res.Member.Role != "member" || res.Member.Primary) {
+					return fmt.Errorf("AddMember() = %v, want a member", res.Member)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"add member twice",
+			func() error {
+				_, err := groups.AddMember(adminCtx, &groupsService.AddMemberReq{GroupId: tAdmin.GroupId, UserId: tUser.Id})
+				return err
+			},
+			codes.AlreadyExists,
+		},
+		{
+			"member lists members",
+			func() error {
+				res, err := groups.ListMembers(userCtx, &groupsService.ListMembersReq{GroupId: tAdmin.GroupId})
+				if err == nil && (res.TotalCount != 2 || res.Members[1].UserId != tAdmin.Id || !res.Members[1].Primary) {
+					return fmt.Errorf("ListMembers() = %v, want the admin and the member", res.Members)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"switch group",
+			func() error {
+				res, err := auth.SwitchGroup(userCtx, &authsService.SwitchGroupReq{GroupId: tAdmin.GroupId})
+				if err != nil {
+					return err
+				}
+				if res.GroupId != tAdmin.GroupId || res.Role != "member" {
+					return fmt.Errorf("SwitchGroup() = %v, want the member role in %s", res, tAdmin.GroupId)
+				}
+				switchedCtx = utilities.AttachTokenToContext(ctx, res.AccessToken)
+				refresh, err := auth.Refresh(switchedCtx, &authsService.Empty{})
+				if err != nil {
+					return err
+				}
+				claims, err := models.DecodeJWT(refresh.AccessToken)
+				if err == nil && claims.GroupId != tAdmin.GroupId {
+					return fmt.Errorf("Refresh() group = %s, want the active group %s", claims.GroupId, tAdmin.GroupId)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"switch to a group of another user",
+			func() error {
+				_, err := auth.SwitchGroup(adminCtx, &authsService.SwitchGroupReq{GroupId: tUser.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"member of the active group cannot update it",
+			func() error {
+				_, err := groups.Update(switchedCtx, &groupsService.UpdateReq{Id: tAdmin.GroupId, Name: "renamed"})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"remove from primary group",
+			func() error {
+				_, err := groups.RemoveMember(userCtx, &groupsService.RemoveMemberReq{GroupId: tUser.GroupId, UserId: tUser.Id})
+				return err
+			},
+			codes.FailedPrecondition,
+		},
+		{
+			"remove member",
+			func() error {
+				_, err := groups.RemoveMember(adminCtx, &groupsService.RemoveMemberReq{GroupId: tAdmin.GroupId, UserId: tUser.Id})
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"removed member's token",
+			func() error {
+				_, err := groups.Get(switchedCtx, &groupsService.GetReq{Id: tAdmin.GroupId})
+				return err
+			},
+			codes.Unauthenticated,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.code {
+				t.Errorf("%s error = %v, want %v", tt.name, err, tt.code)
+			}
+		})
+	}
+}
+
+func Test_MembershipScope(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	groups := groupsService.NewGroupServiceClient(conn)
+	users := usersService.NewUserServiceClient(conn)
+	auth := authsService.NewAuthServiceClient(conn)
+	tasks := tasksService.NewTaskServiceClient(conn)
+	tAdmin := setupTestAdminUser(ta, false, true, 1)
+	tUser := setupTestUser(ta, true, 2)
+	adminCtx := setupTestAuthCtx(ta, ctx, tAdmin, "")
+	adminTask := createTestTask(ta, 1)
+	// a task of a group the admin is not a member of
+	userTask, err := ta.server.TaskDataService.TaskDocInsert(ctx, &models.Task{
+		Id:      utilities.GenerateObjectID(),
+		Name:    "userGroupTask",
+		Due:     time.Now().Add(time.Hour).UTC(),
+		UserId:  tUser.Id,
+		GroupId: tUser.GroupId,
+	})
+	if err != nil {
+		t.Fatalf("TaskDataService.TaskDocInsert() error = %v", err)
+	}
+	var switchedCtx context.Context
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string       // The name of the test
+		call func() error // The calls of the test, which build on the previous tests
+		code codes.Code   // The status code we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"user tasks of a user in no shared group",
+			func() error {
+				_, err := tasks.GetUserTasks(adminCtx, &tasksService.GetUserTasksReq{UserId: tUser.Id})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"find users of a group of another user",
+			func() error {
+				_, err := users.Find(adminCtx, &usersService.FindReq{User: &usersService.User{GroupId: tUser.GroupId}})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"find a user in no shared group",
+			func() error {
+				res, err := users.Find(adminCtx, &usersService.FindReq{User: &usersService.User{Email: tUser.Email}})
+				if err == nil && res.TotalCount != 0 {
+					return fmt.Errorf("Find() = %v, want no user", res.Users)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"find groups of the requester",
+			func() error {
+				res, err := groups.Find(adminCtx, &groupsService.FindReq{Group: &groupsService.Group{}})
+				if err == nil && (res.TotalCount != 1 || res.Groups[0].Id != tAdmin.GroupId) {
+					return fmt.Errorf("Find() = %v, want the admin's group", res.Groups)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"switch to a group the user administers",
+			func() error {
+				if _, err := groups.AddMember(adminCtx, &groupsService.AddMemberReq{GroupId: tAdmin.GroupId, UserId: tUser.Id, Role: "admin"}); err != nil {
+					return err
+				}
+				res, err := auth.SwitchGroup(setupTestAuthCtx(ta, ctx, tUser, ""), &authsService.SwitchGroupReq{GroupId: tAdmin.GroupId})
+				if err != nil {
+					return err
+				}
+				switchedCtx = utilities.AttachTokenToContext(ctx, res.AccessToken)
+				return nil
+			},
+			codes.OK,
+		},
+		{
+			"self update keeps the primary group role",
+			func() error {
+				res, err := users.Update(switchedCtx, &usersService.UpdateReq{Id: tUser.Id, FirstName: "Promoted", Role: "admin"})
+				if err == nil && (res.User.Role != "member" || res.User.FirstName != "Promoted") {
+					return fmt.Errorf("Update() = %v, want the member role", res.User)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"non member cannot update a task",
+			func() error {
+				_, err := tasks.Update(adminCtx, &tasksService.UpdateReq{Id: userTask.Id, Name: "renamed"})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"cannot move a task to a group of another user",
+			func() error {
+				_, err := tasks.Update(adminCtx, &tasksService.UpdateReq{Id: adminTask.Id, GroupId: tUser.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"find a member of the requester's group",
+			func() error {
+				res, err := users.Find(adminCtx, &usersService.FindReq{User: &usersService.User{Email: tUser.Email}})
+				if err == nil && (res.TotalCount != 1 || res.Users[0].Id != tUser.Id) {
+					return fmt.Errorf("Find() = %v, want the member", res.Users)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"user tasks of a member in the shared groups",
+			func() error {
+				res, err := tasks.GetUserTasks(adminCtx, &tasksService.GetUserTasksReq{UserId: tUser.Id})
+				if err == nil && res.TotalCount != 0 {
+					return fmt.Errorf("GetUserTasks() = %v, want no task of the user's primary group", res.Tasks)
+				}
+				return err
+			},
+			codes.OK,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.code {
+				t.Errorf("%s error = %v, want %v", tt.name, err, tt.code)
+			}
+		})
+	}
+}
+
+func Test_GroupInvites(t *testing.T) {
+	ctx := context.Background()
+	t.Setenv("REGISTRATION", "INVITE")
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	groups := groupsService.NewGroupServiceClient(conn)
+	auth := authsService.NewAuthServiceClient(conn)
+	tAdmin := setupTestAdminUser(ta, false, true, 1)
+	tUser := setupTestUser(ta, true, 2)
+	adminCtx := setupTestAuthCtx(ta, ctx, tAdmin, "")
+	userCtx := setupTestAuthCtx(ta, ctx, tUser, "")
+	var codeInvite, emailInvite *groupsService.Invite
+	register := func(email string, code string) (*authsService.RegisterRes, error) {
+		return auth.Register(ctx, &authsService.RegisterReq{
+			Email:      email,
+			Username:   strings.Split(email, "@")[0],
+			Password:   "abc123",
+			InviteCode: code,
+		})
+	}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string       // The name of the test
+		call func() error // The calls of the test, which build on the previous tests
+		code codes.Code   // The status code we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"member cannot invite",
+			func() error {
+				_, err := groups.CreateInvite(userCtx, &groupsService.CreateInviteReq{GroupId: tAdmin.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"create code invite",
+			func() error {
+				res, err := groups.CreateInvite(adminCtx, &groupsService.CreateInviteReq{GroupId: tAdmin.GroupId, MaxUses: 1})
+				if err == nil && (res.Invite.Code == "" || res.Invite.Role != "member") {
+					return fmt.Errorf("CreateInvite() = %v, want a member invite with a code", res.Invite)
+				}
+				codeInvite = res.GetInvite()
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"create email invite",
+			func() error {
+				res, err := groups.CreateInvite(adminCtx, &groupsService.CreateInviteReq{GroupId: tAdmin.GroupId, Email: tUser.Email, Role: "admin"})
+				emailInvite = res.GetInvite()
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"create expired invite",
+			func() error {
+				_, err := groups.CreateInvite(adminCtx, &groupsService.CreateInviteReq{GroupId: tAdmin.GroupId, ExpiresAt: timestamppb.New(time.Now().Add(-time.Hour))})
+				return err
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"list invites",
+			func() error {
+				res, err := groups.ListInvites(adminCtx, &groupsService.ListInvitesReq{GroupId: tAdmin.GroupId})
+				if err == nil && (res.TotalCount != 2 || res.Invites[0].Code != "") {
+					return fmt.Errorf("ListInvites() = %v, want 2 invites without codes", res.Invites)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"register without an invite",
+			func() error {
+				_, err := register("uninvited@test.com", "")
+				return err
+			},
+			codes.FailedPrecondition,
+		},
+		{
+			"register with an invite",
+			func() error {
+				res, err := register("invited@test.com", codeInvite.Code)
+				if err == nil && (res.User.GroupId != tAdmin.GroupId || res.User.Role != "member") {
+					return fmt.Errorf("Register() = %v, want a member of %s", res.User, tAdmin.GroupId)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"register with a used up invite",
+			func() error {
+				_, err := register("late@test.com", codeInvite.Code)
+				return err
+			},
+			codes.FailedPrecondition,
+		},
+		{
+			"register with an invite for another email",
+			func() error {
+				_, err := register("someone@test.com", emailInvite.Code)
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"accept invite",
+			func() error {
+				res, err := auth.AcceptInvite(userCtx, &authsService.AcceptInviteReq{Code: emailInvite.Code})
+				if err == nil && (res.GroupId != tAdmin.GroupId || res.Role != "admin") {
+					return fmt.Errorf("AcceptInvite() = %v, want the admin role in %s", res, tAdmin.GroupId)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"accept invite twice",
+			func() error {
+				_, err := auth.AcceptInvite(userCtx, &authsService.AcceptInviteReq{Code: emailInvite.Code})
+				return err
+			},
+			codes.AlreadyExists,
+		},
+		{
+			"revoke invite",
+			func() error {
+				_, err := groups.RevokeInvite(adminCtx, &groupsService.RevokeInviteReq{GroupId: tAdmin.GroupId, Id: emailInvite.Id})
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"accept revoked invite",
+			func() error {
+				_, err := auth.AcceptInvite(userCtx, &authsService.AcceptInviteReq{Code: emailInvite.Code})
+				return err
+			},
+			codes.NotFound,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.code {
+				t.Errorf("%s error = %v, want %v", tt.name, err, tt.code)
+			}
+		})
+	}
+}
+
+func Test_GroupHierarchy(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	groups := groupsService.NewGroupServiceClient(conn)
+	tasks := tasksService.NewTaskServiceClient(conn)
+	tAdmin := setupTestAdminUser(ta, false, true, 1)
+	tUser := setupTestUser(ta, true, 2)
+	adminCtx := setupTestAuthCtx(ta, ctx, tAdmin, "")
+	userCtx := setupTestAuthCtx(ta, ctx, tUser, "")
+	_ = createTestTask(ta, 1)
+	var teamA, teamB *groupsService.Group
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string       // The name of the test
+		call func() error // The calls of the test, which build on the previous tests
+		code codes.Code   // The status code we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"admin cannot create top level group",
+			func() error {
+				_, err := groups.Create(adminCtx, &groupsService.CreateReq{Name: "org"})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"create team",
+			func() error {
+				res, err := groups.Create(adminCtx, &groupsService.CreateReq{Name: "teamA", ParentId: tAdmin.GroupId})
+				if err == nil && res.Group.ParentId != tAdmin.GroupId {
+					return fmt.Errorf("Create() parent = %q, want %q", res.Group.ParentId, tAdmin.GroupId)
+				}
+				teamA = res.GetGroup()
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"create sub team as inherited admin",
+			func() error {
+				res, err := groups.Create(adminCtx, &groupsService.CreateReq{Name: "teamB", ParentId: teamA.Id})
+				teamB = res.GetGroup()
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"member cannot create team",
+			func() error {
+				_, err := groups.Create(userCtx, &groupsService.CreateReq{Name: "teamC", ParentId: tUser.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"inherited admin updates sub team",
+			func() error {
+				_, err := groups.Update(adminCtx, &groupsService.UpdateReq{Id: teamB.Id, Name: "teamB2"})
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"move team within its descendants",
+			func() error {
+				_, err := groups.Move(adminCtx, &groupsService.MoveReq{Id: teamA.Id, ParentId: teamB.Id})
+				return err
+			},
+			codes.FailedPrecondition,
+		},
+		{
+			"move team within itself",
+			func() error {
+				_, err := groups.Move(adminCtx, &groupsService.MoveReq{Id: teamA.Id, ParentId: teamA.Id})
+				return err
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"move team to another organization",
+			func() error {
+				_, err := groups.Move(adminCtx, &groupsService.MoveReq{Id: teamB.Id, ParentId: tUser.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"admin cannot move team to top level",
+			func() error {
+				_, err := groups.Move(adminCtx, &groupsService.MoveReq{Id: teamB.Id})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"move sub team to organization",
+			func() error {
+				res, err := groups.Move(adminCtx, &groupsService.MoveReq{Id: teamB.Id, ParentId: tAdmin.GroupId})
+				if err == nil && res.Group.ParentId != tAdmin.GroupId {
+					return fmt.Errorf("Move() parent = %q, want %q", res.Group.ParentId, tAdmin.GroupId)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"move sub team back",
+			func() error {
+				_, err := groups.Move(adminCtx, &groupsService.MoveReq{Id: teamB.Id, ParentId: teamA.Id})
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"list descendants",
+			func() error {
+				res, err := groups.ListDescendants(adminCtx, &groupsService.ListDescendantsReq{Id: tAdmin.GroupId})
+				if err == nil && res.TotalCount != 2 {
+					return fmt.Errorf("ListDescendants() = %v, want 2 groups", res.Groups)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"member cannot list descendants of another group",
+			func() error {
+				_, err := groups.ListDescendants(userCtx, &groupsService.ListDescendantsReq{Id: tAdmin.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"roll up tasks",
+			func() error {
+				_, err := ta.server.TaskDataService.TaskDocInsert(ctx, &models.Task{
+					Id:      utilities.GenerateObjectID(),
+					Name:    "teamTask",
+					Due:     time.Now().Add(time.Hour).UTC(),
+					UserId:  tAdmin.Id,
+					GroupId: teamB.Id,
+				})
+				if err != nil {
+					return err
+				}
+				res, err := tasks.GetGroupTasks(adminCtx, &tasksService.GetGroupTasksReq{GroupId: tAdmin.GroupId, IncludeDescendants: true})
+				if err == nil && res.TotalCount != 2 {
+					return fmt.Errorf("GetGroupTasks() = %v, want 2 tasks", res.Tasks)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"admin cannot delete top level group",
+			func() error {
+				_, err := groups.Delete(adminCtx, &groupsService.DeleteReq{Id: tAdmin.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"delete team",
+			func() error {
+				_, err := groups.Delete(adminCtx, &groupsService.DeleteReq{Id: teamA.Id})
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"sub team deleted with team",
+			func() error {
+				_, err := groups.Get(adminCtx, &groupsService.GetReq{Id: teamB.Id})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"sub team tasks deleted with team",
+			func() error {
+				res, err := tasks.GetGroupTasks(adminCtx, &tasksService.GetGroupTasksReq{GroupId: tAdmin.GroupId, IncludeDescendants: true})
+				if err == nil && res.TotalCount != 1 {
+					return fmt.Errorf("GetGroupTasks() = %v, want 1 task", res.Tasks)
+				}
+				return err
+			},
+			codes.OK,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.code {
+				t.Errorf("%s error = %v, want %v", tt.name, err, tt.code)
+			}
+		})
+	}
+}
+
+func Test_GroupSettings(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	groups := groupsService.NewGroupServiceClient(conn)
+	tasks := tasksService.NewTaskServiceClient(conn)
+	users := usersService.NewUserServiceClient(conn)
+	tAdmin := setupTestAdminUser(ta, false, true, 1)
+	tMember := setupTestUser(ta, false, 1)
+	tOther := setupTestUser(ta, true, 2)
+	adminCtx := setupTestAuthCtx(ta, ctx, tAdmin, "")
+	memberCtx := setupTestAuthCtx(ta, ctx, tMember, "")
+	auth := authsService.NewAuthServiceClient(conn)
+	addMember := func() error {
+		_, err := groups.AddMember(adminCtx, &groupsService.AddMemberReq{GroupId: tAdmin.GroupId, UserId: tOther.Id})
+		return err
+	}
+	createUser := func() error {
+		_, err := users.Create(adminCtx, &usersService.CreateReq{
+			FirstName: "Seat",
+			LastName:  "Three",
+			Email:     "seat3@test.com",
+			Username:  "seat3",
+			Password:  "321test123",
+		})
+		return err
+	}
+	updateSettings := func(ctx context.Context, settings *groupsService.GroupSettings) error {
+		_, err := groups.UpdateSettings(ctx, &groupsService.UpdateSettingsReq{Id: tAdmin.GroupId, Settings: settings})
+		return err
+	}
+	var task *tasksService.Task
+	createTask := func(ctx context.Context) error {
+		res, err := tasks.Create(ctx, &tasksService.CreateReq{Name: "settingsTask", Due: timestamppb.Now()})
+		task = res.GetTask()
+		return err
+	}
+	updateStatus := func(status tasksService.TaskStatus) error {
+		_, err := tasks.Update(adminCtx, &tasksService.UpdateReq{Id: task.Id, Status: status})
+		return err
+	}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string       // The name of the test
+		call func() error // The calls of the test, which build on the previous tests
+		code codes.Code   // The status code we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"member cannot update settings",
+			func() error {
+				return updateSettings(memberCtx, &groupsService.GroupSettings{AdminOnlyTasks: true})
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"unknown timezone",
+			func() error {
+				return updateSettings(adminCtx, &groupsService.GroupSettings{Timezone: "Mars/Olympus_Mons"})
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"unknown status",
+			func() error {
+				return updateSettings(adminCtx, &groupsService.GroupSettings{AllowedStatuses: []string{"BLOCKED"}})
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"new tasks not allowed",
+			func() error {
+				return updateSettings(adminCtx, &groupsService.GroupSettings{AllowedStatuses: []string{"COMPLETED"}})
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"update settings",
+			func() error {
+				return updateSettings(adminCtx, &groupsService.GroupSettings{
+					Timezone:        "America/New_York",
+					DefaultDueDays:  3,
+					AllowedStatuses: []string{"NOT_STARTED", "IN_PROGRESS"},
+					MemberLimit:     2,
+					AdminOnlyTasks:  true,
+				})
+			},
+			codes.OK,
+		},
+		{
+			"member gets settings",
+			func() error {
+				res, err := groups.GetSettings(memberCtx, &groupsService.GetSettingsReq{Id: tAdmin.GroupId})
+				if err == nil && (res.Settings.MemberLimit != 2 || res.Settings.Timezone != "America/New_York") {
+					return fmt.Errorf("GetSettings() = %v, want the updated settings", res.Settings)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"member cannot create task",
+			func() error {
+				return createTask(memberCtx)
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"admin creates task",
+			func() error {
+				return createTask(adminCtx)
+			},
+			codes.OK,
+		},
+		{
+			"default due date",
+			func() error {
+				res, err := tasks.Create(adminCtx, &tasksService.CreateReq{Name: "defaultDueTask"})
+				settings := &models.GroupSettings{Timezone: "America/New_York", DefaultDueDays: 3}
+				if want := settings.DefaultDue(time.Now()); err == nil && !res.Task.Due.AsTime().Equal(want) {
+					return fmt.Errorf("Create() due = %v, want %v", res.Task.Due.AsTime(), want)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"status allowed",
+			func() error {
+				return updateStatus(tasksService.TaskStatus_IN_PROGRESS)
+			},
+			codes.OK,
+		},
+		{
+			"status not allowed",
+			func() error {
+				return updateStatus(tasksService.TaskStatus_COMPLETED)
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"member limit reached",
+			func() error {
+				return createUser()
+			},
+			codes.ResourceExhausted,
+		},
+		{
+			"member limit reached by a new member",
+			func() error {
+				return addMember()
+			},
+			codes.ResourceExhausted,
+		},
+		{
+			"member limit reached by an invite",
+			func() error {
+				invite, err := groups.CreateInvite(adminCtx, &groupsService.CreateInviteReq{GroupId: tAdmin.GroupId, Email: tOther.Email})
+				if err != nil {
+					return err
+				}
+				_, err = auth.AcceptInvite(setupTestAuthCtx(ta, ctx, tOther, ""), &authsService.AcceptInviteReq{Code: invite.Invite.Code})
+				return err
+			},
+			codes.ResourceExhausted,
+		},
+		{
+			"clear settings",
+			func() error {
+				return updateSettings(adminCtx, &groupsService.GroupSettings{})
+			},
+			codes.OK,
+		},
+		{
+			"missing due date without a default",
+			func() error {
+				_, err := tasks.Create(memberCtx, &tasksService.CreateReq{Name: "noDueTask"})
+				return err
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"member creates task",
+			func() error {
+				return createTask(memberCtx)
+			},
+			codes.OK,
+		},
+		{
+			"any status allowed",
+			func() error {
+				return updateStatus(tasksService.TaskStatus_COMPLETED)
+			},
+			codes.OK,
+		},
+		{
+			"add a member within the limit",
+			func() error {
+				if err := updateSettings(adminCtx, &groupsService.GroupSettings{MemberLimit: 3}); err != nil {
+					return err
+				}
+				return addMember()
+			},
+			codes.OK,
+		},
+		{
+			"member limit counts members",
+			func() error {
+				return createUser()
+			},
+			codes.ResourceExhausted,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.code {
+				t.Errorf("%s error = %v, want %v", tt.name, err, tt.code)
+			}
+		})
+	}
+}
+
+func Test_MoveUser(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	groups := groupsService.NewGroupServiceClient(conn)
+	tasks := tasksService.NewTaskServiceClient(conn)
+	users := usersService.NewUserServiceClient(conn)
+	tRoot := setupTestAdminUser(ta, true, true, 1)
+	tAdmin := setupTestAdminUser(ta, false, true, 2)
+	tUser := setupTestUser(ta, false, 1)
+	tTask := createTestTask(ta, 3)
+	rootCtx := setupTestAuthCtx(ta, ctx, tRoot, "")
+	adminCtx := setupTestAuthCtx(ta, ctx, tAdmin, "")
+	userCtx := setupTestAuthCtx(ta, ctx, tUser, "")
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string       // The name of the test
+		call func() error // The calls of the test, which build on the previous tests
+		code codes.Code   // The status code we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"admin of another group cannot update",
+			func() error {
+				_, err := users.Update(adminCtx, &usersService.UpdateReq{Id: tUser.Id, FirstName: "Moved"})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"add member",
+			func() error {
+				_, err := groups.AddMember(adminCtx, &groupsService.AddMemberReq{GroupId: tAdmin.GroupId, UserId: tUser.Id})
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"admin of a member's group cannot update",
+			func() error {
+				_, err := users.Update(adminCtx, &usersService.UpdateReq{Id: tUser.Id, GroupId: tAdmin.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"update keeps the group",
+			func() error {
+				res, err := users.Update(rootCtx, &usersService.UpdateReq{Id: tUser.Id, GroupId: tAdmin.GroupId})
+				if err == nil && res.User.GroupId != tUser.GroupId {
+					return fmt.Errorf("Update() = %v, want the user in %s", res.User, tUser.GroupId)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"admin of one group cannot move",
+			func() error {
+				_, err := users.MoveUser(adminCtx, &usersService.MoveUserReq{Id: tUser.Id, GroupId: tAdmin.GroupId})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"reassign to a user of another group",
+			func() error {
+				_, err := users.MoveUser(rootCtx, &usersService.MoveUserReq{
+					Id:         tUser.Id,
+					GroupId:    tAdmin.GroupId,
+					Tasks:      usersService.TaskTransfer_REASSIGN,
+					AssigneeId: tAdmin.Id,
+				})
+				return err
+			},
+			codes.FailedPrecondition,
+		},
+		{
+			"reassign to the moved user",
+			func() error {
+				_, err := users.MoveUser(rootCtx, &usersService.MoveUserReq{
+					Id:         tUser.Id,
+					GroupId:    tAdmin.GroupId,
+					Tasks:      usersService.TaskTransfer_REASSIGN,
+					AssigneeId: tUser.Id,
+				})
+				return err
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"fill the group of the member",
+			func() error {
+				_, err := groups.UpdateSettings(adminCtx, &groupsService.UpdateSettingsReq{Id: tAdmin.GroupId, Settings: &groupsService.GroupSettings{MemberLimit: 2}})
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"move with tasks",
+			func() error {
+				res, err := users.MoveUser(rootCtx, &usersService.MoveUserReq{Id: tUser.Id, GroupId: tAdmin.GroupId})
+				if err == nil && (res.User.GroupId != tAdmin.GroupId || res.TaskCount != 1) {
+					return fmt.Errorf("MoveUser() = %v, want the user and its task in %s", res, tAdmin.GroupId)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"task moved",
+			func() error {
+				res, err := tasks.Get(rootCtx, &tasksService.GetReq{Id: tTask.Id})
+				if err == nil && res.Task.GroupId != tAdmin.GroupId {
+					return fmt.Errorf("Get() = %v, want the task in %s", res.Task, tAdmin.GroupId)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"moved user's token",
+			func() error {
+				_, err := users.Get(userCtx, &usersService.GetReq{Id: tUser.Id})
+				return err
+			},
+			codes.Unauthenticated,
+		},
+		{
+			"move last admin",
+			func() error {
+				_, err := users.MoveUser(rootCtx, &usersService.MoveUserReq{Id: tAdmin.Id, GroupId: tRoot.GroupId})
+				return err
+			},
+			codes.FailedPrecondition,
+		},
+		{
+			"transfer admin to self",
+			func() error {
+				_, err := groups.TransferAdmin(adminCtx, &groupsService.TransferAdminReq{GroupId: tAdmin.GroupId, UserId: tAdmin.Id})
+				return err
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"transfer admin",
+			func() error {
+				res, err := groups.TransferAdmin(adminCtx, &groupsService.TransferAdminReq{GroupId: tAdmin.GroupId, UserId: tUser.Id})
+				if err == nil && (res.Member.UserId != tUser.Id || res.Member.Role != "admin") {
+					return fmt.Errorf("TransferAdmin() = %v, want the user as admin", res.Member)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"move former admin",
+			func() error {
+				_, err := users.MoveUser(rootCtx, &usersService.MoveUserReq{
+					Id:         tAdmin.Id,
+					GroupId:    tRoot.GroupId,
+					Tasks:      usersService.TaskTransfer_REASSIGN,
+					AssigneeId: tUser.Id,
+				})
+				return err
+			},
+			codes.OK,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.code {
+				t.Errorf("%s error = %v, want %v", tt.name, err, tt.code)
+			}
+		})
+	}
+}
+
+func Test_TaskComments(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	tasks := tasksService.NewTaskServiceClient(conn)
+	tAdmin := setupTestAdminUser(ta, false, true, 1)
+	tUser := setupTestUser(ta, false, 1)
+	tOutsider := setupTestUser(ta, true, 2)
+	tTask := createTestTask(ta, 1)
+	adminCtx := setupTestAuthCtx(ta, ctx, tAdmin, "")
+	userCtx := setupTestAuthCtx(ta, ctx, tUser, "")
+	outsiderCtx := setupTestAuthCtx(ta, ctx, tOutsider, "")
+	var commentId string
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string       // The name of the test
+		call func() error // The calls of the test, which build on the previous tests
+		code codes.Code   // The status code we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"comment outside of the task's group",
+			func() error {
+				_, err := tasks.AddComment(outsiderCtx, &tasksService.AddCommentReq{TaskId: tTask.Id, Body: "hello"})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"empty comment",
+			func() error {
+				_, err := tasks.AddComment(userCtx, &tasksService.AddCommentReq{TaskId: tTask.Id})
+				return err
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"add comment",
+			func() error {
+				res, err := tasks.AddComment(userCtx, &tasksService.AddCommentReq{TaskId: tTask.Id, Body: " hello "})
+				if err == nil {
+					commentId = res.Comment.Id
+					if res.Comment.UserId != tUser.Id || res.Comment.Body != "hello" {
+						return fmt.Errorf("AddComment() = %v, want a trimmed comment by %s", res.Comment, tUser.Id)
+					}
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"edit another user's comment",
+			func() error {
+				_, err := tasks.UpdateComment(adminCtx, &tasksService.UpdateCommentReq{Id: commentId, Body: "edited"})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"edit own comment",
+			func() error {
+				res, err := tasks.UpdateComment(userCtx, &tasksService.UpdateCommentReq{Id: commentId, Body: "edited"})
+				if err == nil && res.Comment.Body != "edited" {
+					return fmt.Errorf("UpdateComment() = %v, want the body edited", res.Comment)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"list comments",
+			func() error {
+				res, err := tasks.ListComments(adminCtx, &tasksService.ListCommentsReq{TaskId: tTask.Id})
+				if err == nil && (res.TotalCount != 1 || res.Comments[0].Id != commentId) {
+					return fmt.Errorf("ListComments() = %v, want comment %s", res.Comments, commentId)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"list comments outside of the task's group",
+			func() error {
+				_, err := tasks.ListComments(outsiderCtx, &tasksService.ListCommentsReq{TaskId: tTask.Id})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"update status and assignee",
+			func() error {
+				_, err := tasks.Update(adminCtx, &tasksService.UpdateReq{Id: tTask.Id, Status: tasksService.TaskStatus_IN_PROGRESS, UserId: tUser.Id})
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"list activity",
+			func() error {
+				res, err := tasks.ListActivity(userCtx, &tasksService.ListActivityReq{TaskId: tTask.Id})
+				if err != nil {
+					return err
+				}
+				if res.TotalCount != 2 || res.Activities[0].Type != models.ActivityStatusChanged || res.Activities[1].Type != models.ActivityReassigned {
+					return fmt.Errorf("ListActivity() = %v, want a status change then a reassignment", res.Activities)
+				}
+				if res.Activities[0].From != "NOT_STARTED" || res.Activities[0].To != "IN_PROGRESS" || res.Activities[1].UserId != tAdmin.Id {
+					return fmt.Errorf("ListActivity() = %v, want the changes made by %s", res.Activities, tAdmin.Id)
+				}
+				return nil
+			},
+			codes.OK,
+		},
+		{
+			"delete comment as group admin",
+			func() error {
+				_, err := tasks.DeleteComment(adminCtx, &tasksService.DeleteCommentReq{Id: commentId})
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"deleted comment",
+			func() error {
+				_, err := tasks.UpdateComment(userCtx, &tasksService.UpdateCommentReq{Id: commentId, Body: "edited again"})
+				return err
+			},
+			codes.NotFound,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.code {
+				t.Errorf("%s error = %v, want %v", tt.name, err, tt.code)
+			}
+		})
+	}
+}
+
+func Test_TaskAttachments(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	conn, closer := ta.server.StartTest(ctx)
+	defer closer()
+	tasks := tasksService.NewTaskServiceClient(conn)
+	tUser := setupTestUser(ta, true, 1)
+	tOutsider := setupTestUser(ta, true, 2)
+	tTask := createTestTask(ta, 3)
+	userCtx := setupTestAuthCtx(ta, ctx, tUser, "")
+	outsiderCtx := setupTestAuthCtx(ta, ctx, tOutsider, "")
+	// upload streams the messages of an attachment upload until the server ends the stream
+	upload := func(ctx context.Context, reqs ...*tasksService.AddAttachmentReq) error {
+		stream, err := tasks.AddAttachment(ctx)
+		if err != nil {
+			return err
+		}
+		for _, req := range reqs {
+			if err = stream.Send(req); err != nil {
+				break
+			}
+		}
+		_, err = stream.CloseAndRecv()
+		return err
+	}
+	chunk := bytes.Repeat([]byte("a"), 1<<20)
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string       // The name of the test
+		call func() error // The calls of the test
+		code codes.Code   // The status code we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"list attachments",
+			func() error {
+				res, err := tasks.ListAttachments(userCtx, &tasksService.ListAttachmentsReq{TaskId: tTask.Id})
+				if err == nil && (res.TotalCount != 0 || len(res.Attachments) != 0) {
+					return fmt.Errorf("ListAttachments() = %v, want no attachments", res.Attachments)
+				}
+				return err
+			},
+			codes.OK,
+		},
+		{
+			"list attachments outside of the task's group",
+			func() error {
+				_, err := tasks.ListAttachments(outsiderCtx, &tasksService.ListAttachmentsReq{TaskId: tTask.Id})
+				return err
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"attach outside of the task's group",
+			func() error {
+				return upload(outsiderCtx, &tasksService.AddAttachmentReq{TaskId: tTask.Id, Name: "notes.txt", FileType: "text/plain", Chunk: []byte("notes")})
+			},
+			codes.PermissionDenied,
+		},
+		{
+			"attach without a name",
+			func() error {
+				return upload(userCtx, &tasksService.AddAttachmentReq{TaskId: tTask.Id, FileType: "text/plain", Chunk: []byte("notes")})
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"attach an empty upload",
+			func() error {
+				return upload(userCtx)
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"attach a file over the size limit",
+			func() error {
+				reqs := []*tasksService.AddAttachmentReq{{TaskId: tTask.Id, Name: "big.bin", FileType: "application/octet-stream"}}
+				for i := 0; i <= models.MaxAttachmentSize/len(chunk); i++ {
+					reqs = append(reqs, &tasksService.AddAttachmentReq{Chunk: chunk})
+				}
+				return upload(userCtx, reqs...)
+			},
+			codes.InvalidArgument,
+		},
+		{
+			"download a missing attachment",
+			func() error {
+				stream, err := tasks.DownloadAttachment(userCtx, &tasksService.DownloadAttachmentReq{TaskId: tTask.Id, Id: "000000000000000000000099"})
+				if err != nil {
+					return err
+				}
+				_, err = stream.Recv()
+				return err
+			},
+			codes.NotFound,
+		},
+		{
+			"delete a missing attachment",
+			func() error {
+				_, err := tasks.DeleteAttachment(userCtx, &tasksService.DeleteAttachmentReq{TaskId: tTask.Id, Id: "000000000000000000000099"})
+				return err
+			},
+			codes.NotFound,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.call(); status.Code(err) != tt.code {
+				t.Errorf("%s error = %v, want %v", tt.name, err, tt.code)
+			}
+		})
+	}
+}
+
+/*
+CLI TESTS
+*/
+
+func Test_Gateway(t *testing.T) {
+	ctx := context.Background()
+	ta := setup()
+	handler, closer := ta.server.StartTestHTTP(ctx)
+	defer closer()
+	gateway := httptest.NewServer(handler)
+	defer gateway.Close()
+	tUser := setupTestAdminUser(ta, false, true, 1)
+	_ = createTestTask(ta, 1)
+	authToken, _ := createTestToken(ta, tUser)
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name       string // The name of the test
+		method     string // The HTTP method of the request
+		path       string // The path and query of the request
+		body       string // The JSON body of the request
+		token      string // The bearer token of the request
+		wantStatus int    // The HTTP status we want
+		wantBody   string // A substring we want the response body to contain
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"login", http.MethodPost, "/v1/auth/login", `{"Email":"master@test.com","Password":"321test123"}`, "", http.StatusOK, `"AccessToken":"`},
+		{"wrong password", http.MethodPost, "/v1/auth/login", `{"Email":"master@test.com","Password":"wrong"}`, "", http.StatusUnauthorized, `"UNAUTHENTICATED"`},
+		{"invalid body", http.MethodPost, "/v1/auth/login", `{"Email":`, "", http.StatusBadRequest, "invalid request body"},
+		{"get task", http.MethodGet, "/v1/tasks/000000000000000000000021", "", authToken, http.StatusOK, `"Name":"testTask"`},
+		{"missing task", http.MethodGet, "/v1/tasks/000000000000000000000099", "", authToken, http.StatusNotFound, `"TASK_NOT_FOUND"`},
+		{"missing token", http.MethodGet, "/v1/tasks/000000000000000000000021", "", "", http.StatusUnauthorized, `"code":401`},
+		{"query", http.MethodGet, "/v1/groups/000000000000000000000002/tasks?Page=1&Size=10", "", authToken, http.StatusOK, `"TotalCount":"1"`},
+		{"invalid query", http.MethodGet, "/v1/tasks?Page=abc", "", authToken, http.StatusBadRequest, `"field":"Page"`},
+		{"update task", http.MethodPatch, "/v1/tasks/000000000000000000000021", `{"Status":"COMPLETED"}`, authToken, http.StatusOK, `"Status":"COMPLETED"`},
+		{"method not allowed", http.MethodPut, "/v1/tasks", "", authToken, http.StatusMethodNotAllowed, `"code":405`},
+		{"unknown route", http.MethodGet, "/v1/unknown", "", "", http.StatusNotFound, "no route"},
+		{"openapi", http.MethodGet, "/openapi.json", "", "", http.StatusOK, `"/v1/tasks/{Id}"`},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, gateway.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			req.Header.Set(utilities.RequestIDHeader, "gateway-request-id")
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			body, _ := io.ReadAll(res.Body)
+			if res.StatusCode != tt.wantStatus || !strings.Contains(string(body), tt.wantBody) {
+				t.Errorf("%s %s = %d %s, want %d containing %s", tt.method, tt.path, res.StatusCode, body, tt.wantStatus, tt.wantBody)
+			}
+			if res.Header.Get("Content-Type") != "application/json" {
+				t.Errorf("Content-Type = %q, want application/json", res.Header.Get("Content-Type"))
+			}
+		})
+	}
+	t.Run("request id", func(t *testing.T) {
+		req, _ := http.NewRequest(http.MethodPost, gateway.URL+"/v1/auth/login", strings.NewReader(`{"Email":"master@test.com","Password":"wrong"}`))
+		req.Header.Set(utilities.RequestIDHeader, "gateway-request-id")
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if got := res.Header.Get(utilities.RequestIDHeader); got != "gateway-request-id" {
+			t.Errorf("%s = %q, want gateway-request-id", utilities.RequestIDHeader, got)
+		}
+	})
+}
+
+// grpcWebFrames splits a gRPC-Web or Connect body into the flags and payloads of its frames
+func grpcWebFrames(t *testing.T, body []byte) ([]byte, [][]byte) {
+	var flags []byte
+	var frames [][]byte
+	for len(body) >= 5 {
+		n := int(binary.BigEndian.Uint32(body[1:5]))
+		if len(body) < 5+n {
+			t.Fatalf("truncated frame in %q", body)
+		}
+		flags = append(flags, body[0])
+		frames = append(frames, body[5:5+n])
+		body = body[5+n:]
+	}
+	return flags, frames
+}
+
+// grpcWebFrame returns msg framed as a gRPC-Web or Connect stream message
+func grpcWebFrame(msg proto.Message) []byte {
+	data, _ := proto.Marshal(msg)
+	frame := make([]byte, 5, 5+len(data))
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(data)))
+	return append(frame, data...)
+}
+
+func Test_GRPCWeb(t *testing.T) {
+	t.Setenv("GATEWAY_GRPC_WEB", "true")
+	t.Setenv("CORS_ALLOWED_ORIGINS", "https://app.example.com")
+	ctx := context.Background()
+	ta := setup()
+	handler, closer := ta.server.StartTestHTTP(ctx)
+	defer closer()
+	gateway := httptest.NewServer(handler)
+	defer gateway.Close()
+	tUser := setupTestAdminUser(ta, false, true, 1)
+	_ = createTestTask(ta, 1)
+	authToken, _ := createTestToken(ta, tUser)
+	login := &authsService.LoginReq{Email: "master@test.com", Password: "321test123"}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name        string                                              // The name of the test
+		method      string                                              // The HTTP method of the request
+		path        string                                              // The path of the request
+		contentType string                                              // The content type of the request
+		body        []byte                                              // The body of the request
+		token       string                                              // The bearer token of the request
+		origin      string                                              // The Origin of the request
+		wantStatus  int                                                 // The HTTP status we want
+		check       func(t *testing.T, res *http.Response, body []byte) // Checks the response
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"grpc-web",
+			http.MethodPost,
+			"/authService.AuthService/Login",
+			"application/grpc-web+proto",
+			grpcWebFrame(login),
+			"",
+			"",
+			http.StatusOK,
+			func(t *testing.T, res *http.Response, body []byte) {
+				flags, frames := grpcWebFrames(t, body)
+				if len(frames) != 2 || flags[1] != 0x80 || !strings.Contains(string(frames[1]), "grpc-status: 0") {
+					t.Fatalf("frames = %q, want a message and an OK trailer frame", frames)
+				}
+				loginRes := &authsService.LoginRes{}
+				if err := proto.Unmarshal(frames[0], loginRes); err != nil || loginRes.AccessToken == "" {
+					t.Errorf("LoginRes = %v, %v, want an access token", loginRes, err)
+				}
+				if res.Header.Get(utilities.RequestIDHeader) == "" {
+					t.Errorf("missing %s header", utilities.RequestIDHeader)
+				}
+			},
+		},
+		{
+			"grpc-web error",
+			http.MethodPost,
+			"/authService.AuthService/Login",
+			"application/grpc-web+proto",
+			grpcWebFrame(&authsService.LoginReq{Email: "master@test.com", Password: "wrong"}),
+			"",
+			"",
+			http.StatusOK,
+			func(t *testing.T, res *http.Response, body []byte) {
+				_, frames := grpcWebFrames(t, body)
+				if len(frames) != 1 || !strings.Contains(string(frames[0]), "grpc-status: 16") {
+					t.Errorf("frames = %q, want an UNAUTHENTICATED trailer frame", frames)
+				}
+			},
+		},
+		{
+			"grpc-web-text",
+			http.MethodPost,
+			"/tasksService.TaskService/Get",
+			"application/grpc-web-text",
+			[]byte(base64.StdEncoding.EncodeToString(grpcWebFrame(&tasksService.GetReq{Id: "000000000000000000000021"}))),
+			authToken,
+			"",
+			http.StatusOK,
+			func(t *testing.T, res *http.Response, body []byte) {
+				var decoded []byte
+				for _, chunk := range strings.SplitAfter(string(body), "=") {
+					part, _ := base64.StdEncoding.DecodeString(chunk)
+					decoded = append(decoded, part...)
+				}
+				_, frames := grpcWebFrames(t, decoded)
+				getRes := &tasksService.GetRes{}
+				if len(frames) != 2 || proto.Unmarshal(frames[0], getRes) != nil || getRes.Task.GetName() != "testTask" {
+					t.Errorf("frames = %q, want the task", frames)
+				}
+			},
+		},
+		{
+			"connect unary",
+			http.MethodPost,
+			"/tasksService.TaskService/Get",
+			"application/json",
+			[]byte(`{"Id":"000000000000000000000021"}`),
+			authToken,
+			"",
+			http.StatusOK,
+			func(t *testing.T, res *http.Response, body []byte) {
+				if !strings.Contains(string(body), `"Name":"testTask"`) {
+					t.Errorf("body = %s, want the task", body)
+				}
+			},
+		},
+		{
+			"connect unary error",
+			http.MethodPost,
+			"/tasksService.TaskService/Get",
+			"application/json",
+			[]byte(`{"Id":"000000000000000000000099"}`),
+			authToken,
+			"",
+			http.StatusNotFound,
+			func(t *testing.T, res *http.Response, body []byte) {
+				if !strings.Contains(string(body), `"code":"not_found"`) || !strings.Contains(string(body), `"type":"google.rpc.ErrorInfo"`) {
+					t.Errorf("body = %s, want a not_found error with details", body)
+				}
+			},
+		},
+		{
+			"connect unauthenticated",
+			http.MethodPost,
+			"/tasksService.TaskService/Get",
+			"application/json",
+			[]byte(`{"Id":"000000000000000000000021"}`),
+			"",
+			"",
+			http.StatusUnauthorized,
+			func(t *testing.T, res *http.Response, body []byte) {
+				if !strings.Contains(string(body), `"code":"unauthenticated"`) {
+					t.Errorf("body = %s, want an unauthenticated error", body)
+				}
+			},
+		},
+		{
+			"connect stream",
+			http.MethodPost,
+			"/authService.AuthService/Login",
+			"application/connect+json",
+			append([]byte{0, 0, 0, 0, 51}, `{"Email":"master@test.com","Password":"321test123"}`...),
+			"",
+			"",
+			http.StatusOK,
+			func(t *testing.T, res *http.Response, body []byte) {
+				flags, frames := grpcWebFrames(t, body)
+				if len(frames) != 2 || flags[1] != 0x02 || !strings.Contains(string(frames[0]), `"AccessToken"`) || strings.Contains(string(frames[1]), `"error"`) {
+					t.Errorf("frames = %q, want a message and a successful end of stream frame", frames)
+				}
+			},
+		},
+		{
+			"cors preflight",
+			http.MethodOptions,
+			"/tasksService.TaskService/Get",
+			"",
+			nil,
+			"",
+			"https://app.example.com",
+			http.StatusNoContent,
+			func(t *testing.T, res *http.Response, body []byte) {
+				if res.Header.Get("Access-Control-Allow-Origin") != "https://app.example.com" ||
+					!strings.Contains(res.Header.Get("Access-Control-Allow-Headers"), "X-Grpc-Web") {
+					t.Errorf("headers = %v, want the CORS headers of the origin", res.Header)
+				}
+			},
+		},
+		{
+			"cors disallowed origin",
+			http.MethodOptions,
+			"/tasksService.TaskService/Get",
+			"",
+			nil,
+			"",
+			"https://evil.example.com",
+			http.StatusForbidden,
+			func(t *testing.T, res *http.Response, body []byte) {
+				if res.Header.Get("Access-Control-Allow-Origin") != "" {
+					t.Errorf("Access-Control-Allow-Origin = %q, want none", res.Header.Get("Access-Control-Allow-Origin"))
+				}
+			},
+		},
+		{
+			"cors request",
+			http.MethodPost,
+			"/authService.AuthService/Login",
+			"application/grpc-web+proto",
+			grpcWebFrame(login),
+			"",
+			"https://app.example.com",
+			http.StatusOK,
+			func(t *testing.T, res *http.Response, body []byte) {
+				if res.Header.Get("Access-Control-Allow-Origin") != "https://app.example.com" ||
+					!strings.Contains(res.Header.Get("Access-Control-Expose-Headers"), "Grpc-Status") {
+					t.Errorf("headers = %v, want the CORS headers of the origin", res.Header)
+				}
+			},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, gateway.URL+tt.path, bytes.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+				if tt.method == http.MethodOptions {
+					req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				}
+			}
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer res.Body.Close()
+			body, _ := io.ReadAll(res.Body)
+			if res.StatusCode != tt.wantStatus {
+				t.Fatalf("%s %s = %d %s, want %d", tt.method, tt.path, res.StatusCode, body, tt.wantStatus)
+			}
+			tt.check(t, res, body)
+		})
+	}
+}
+
+func Test_CLICommands(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string   // The name of the test
+		args    []string // The command line arguments
+		wantOut string   // What out output should contain
+		wantErr bool     // whether we want an error.
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"help",
+			[]string{"help"},
+			"create-root-admin",
+			false,
+		},
+		{
+			"unknown command",
+			[]string{"unknown"},
+			"Commands:",
+			true,
+		},
+		{
+			"create group",
+			[]string{"create-group", "-name", "cli_group"},
+			"created group cli_group",
+			false,
+		},
+		{
+			"create group missing name",
+			[]string{"create-group"},
+			"",
+			true,
+		},
+		{
+			"create group taken name",
+			[]string{"create-group", "-name", "test2"},
+			"",
+			true,
+		},
+		{
+			"create root admin",
+			[]string{"create-root-admin", "-email", "root2@test.com", "-username", "root2", "-password", "abc123"},
+			"created root admin root2@test.com",
+			false,
+		},
+		{
+			"create root admin in non root group",
+			[]string{"create-root-admin", "-email", "root3@test.com", "-username", "root3", "-password", "abc123", "-group", "test2"},
+			"",
+			true,
+		},
+		{
+			"create root admin taken email",
+			[]string{"create-root-admin", "-email", "test2@email.com", "-username", "root4", "-password", "abc123"},
+			"",
+			true,
+		},
+		{
+			"list users",
+			[]string{"list-users", "-group", "000000000000000000000002"},
+			"test2@email.com",
+			false,
+		},
+		{
+			"list users invalid group",
+			[]string{"list-users", "-group", "abc"},
+			"",
+			true,
+		},
+		{
+			"reset password",
+			[]string{"reset-password", "-email", "test2@email.com", "-password", "newpass123"},
+			"reset the password of test2@email.com",
+			false,
+		},
+		{
+			"reset password unknown user",
+			[]string{"reset-password", "-email", "nobody@email.com", "-password", "newpass123"},
+			"",
+			true,
+		},
+		{
+			"revoke tokens missing target",
+			[]string{"revoke-tokens"},
+			"",
+			true,
+		},
+		{
+			"migrate status",
+			[]string{"migrate", "status"},
+			"applied",
+			false,
+		},
+		{
+			"invalid config flag",
+			[]string{"serve", "-log-level", "loud"},
+			"",
+			true,
+		},
+		{
+			"missing config file",
+			[]string{"serve", "-config", "missing.yaml"},
+			"",
+			true,
+		},
+		{
+			"migrate invalid steps",
+			[]string{"migrate", "down", "zero"},
+			"",
+			true,
+		},
+	}
+	ta := setup()
+	setupTestUser(ta, true, 1)
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			ta.out = &out
+			err := ta.RunCommand(tt.args)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("App.RunCommand() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !strings.Contains(out.String(), tt.wantOut) { // Asserting whether we get the correct wanted value
+				t.Errorf("App.RunCommand() \nOutput: %q\nWant: %q\n", out.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
+func Test_CLIRevokeTokens(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string   // The name of the test
+		args    []string // The revoke-tokens arguments, %s is replaced with the user's token
+		wantErr bool     // whether we want an error.
+	}{
+		{
+			"by email",
+			[]string{"revoke-tokens", "-email", "test2@email.com"},
+			true,
+		},
+		{
+			"by token",
+			[]string{"revoke-tokens", "-token", "%s"},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ta := setup()
+			conn, closer := ta.server.StartTest(ctx)
+			client := usersService.NewUserServiceClient(conn)
+			defer closer()
+			testUser := setupTestUser(ta, true, 1)
+			authToken, err := createTestToken(ta, testUser)
+			if err != nil {
+				t.Fatalf("createTestToken() error = %v", err)
+			}
+			reqCtx := utilities.AttachTokenToContext(ctx, authToken)
+			if _, err = client.Get(reqCtx, &usersService.GetReq{Id: testUser.Id}); err != nil {
+				t.Fatalf("usersService.Get() before revocation error = %v", err)
+			}
+			time.Sleep(2 * time.Millisecond) // revocations are stored to the millisecond
+			args := make([]string, len(tt.args))
+			for i, a := range tt.args {
+				args[i] = strings.Replace(a, "%s", authToken, 1)
+			}
+			ta.out = io.Discard
+			if err = ta.RunCommand(args); err != nil {
+				t.Fatalf("App.RunCommand() error = %v", err)
+			}
+			_, err = client.Get(reqCtx, &usersService.GetReq{Id: testUser.Id})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("usersService.Get() after revocation error = %v, wantErr %v", err, tt.wantErr)
+			}
+			freshToken, err := createTestToken(ta, testUser) // a session started right after the revocation is kept
+			if err != nil {
+				t.Fatalf("createTestToken() error = %v", err)
+			}
+			if _, err = client.Get(utilities.AttachTokenToContext(ctx, freshToken), &usersService.GetReq{Id: testUser.Id}); err != nil {
+				t.Errorf("usersService.Get() with a token issued after revocation error = %v", err)
+			}
+		})
+	}
+}
+
+func Test_CLIExportImport(t *testing.T) {
+	ta := setup()
+	setupTestUser(ta, true, 1)
+	path := filepath.Join(t.TempDir(), "export.json")
+	ta.out = io.Discard
+	if err := ta.RunCommand([]string{"export", "-out", path}); err != nil {
+		t.Fatalf("App.RunCommand() export error = %v", err)
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile() error = %v", err)
+	}
+	data := &exportData{}
+	if err = json.Unmarshal(raw, data); err != nil || len(data.Groups) != 2 || len(data.Users) != 2 {
+		t.Fatalf("export = %d groups, %d users, %v", len(data.Groups), len(data.Users), err)
+	}
+	tests := []struct {
+		name      string // The name of the test
+		email     string // The email the imported member is given, when set
+		wantErr   bool   // whether we want an error.
+		wantUsers int    // The number of users we want afterwards
+	}{
+		{
+			"conflict rolls back",
+			"master@test.com",
+			true,
+			1,
+		},
+		{
+			"import",
+			"",
+			false,
+			2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb := setup()
+			in := exportData{Tasks: data.Tasks}
+			for _, g := range data.Groups {
+				if !g.RootAdmin {
+					in.Groups = append(in.Groups, g)
+				}
+			}
+			for _, u := range data.Users {
+				if !u.RootAdmin {
+					member := *u
+					if tt.email != "" {
+						member.Email = tt.email
+					}
+					in.Users = append(in.Users, &member)
+				}
+			}
+			inPath := filepath.Join(t.TempDir(), "import.json")
+			b, _ := json.Marshal(&in)
+			if err := os.WriteFile(inPath, b, 0600); err != nil {
+				t.Fatalf("os.WriteFile() error = %v", err)
+			}
+			tb.out = io.Discard
+			err := tb.RunCommand([]string{"import", "-in", inPath})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("App.RunCommand() import error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			users, err := tb.server.UserDataService.UsersFind(context.Background(), &models.User{})
+			if err != nil || len(users) != tt.wantUsers {
+				t.Errorf("UsersFind() = %d users, %v, want %d", len(users), err, tt.wantUsers)
+				return
+			}
+			_, err = tb.server.GroupDataService.GroupFind(context.Background(), &models.Group{Id: "000000000000000000000002"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GroupFind() imported group error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				user, err := tb.server.UserDataService.AuthenticateUser(context.Background(), &models.User{Email: "test2@email.com", Password: "abc123"})
+				if err != nil || user.Id != "000000000000000000000012" {
+					t.Errorf("AuthenticateUser() imported user = %v, %v", user, err)
+				}
+			}
+		})
+	}
+}
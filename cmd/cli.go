@@ -0,0 +1,449 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/JECSand/go-grpc-server-boilerplate/config"
+	"github.com/JECSand/go-grpc-server-boilerplate/database"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// command is a subcommand of the server binary
+type command struct {
+	name    string
+	summary string
+	run     func(a *App, args []string) error
+}
+
+// commands lists the subcommands of the server binary in the order they are documented
+var commands = []*command{
+	{"serve", "run the gRPC server (default)", (*App).serve},
+	{"migrate", "apply, revert, or list schema migrations: migrate [flags] [up | down [steps] | status]", (*App).migrate},
+	{"create-root-admin", "create a root admin user and, if missing, its root admin group", (*App).createRootAdmin},
+	{"reset-password", "set a user's password and revoke their existing tokens", (*App).resetPassword},
+	{"create-group", "create a group", (*App).createGroup},
+	{"list-users", "list the users of every group or of a single group", (*App).listUsers},
+	{"revoke-tokens", "revoke every token issued to a user, or blacklist a single token", (*App).revokeTokens},
+	{"export", "export groups, users, and tasks as json", (*App).export},
+	{"import", "import groups, users, and tasks from an export in a single transaction", (*App).importData},
+}
+
+// cliServices are the database services used by the administration subcommands
+type cliServices struct {
+	groups     *database.GroupService
+	users      *database.UserService
+	tasks      *database.TaskService
+	blacklists *database.BlacklistService
+	audits     *database.AuditService
+}
+
+// exportData is the document written by export and read by import
+type exportData struct {
+	ExportedAt time.Time       `json:"exported_at"`
+	Groups     []*models.Group `json:"groups"`
+	Users      []*models.User  `json:"users"`
+	Tasks      []*models.Task  `json:"tasks"`
+}
+
+// Execute runs the subcommand named by the first argument, serving the API when none is given
+func Execute(args []string) error {
+	var app App
+	return app.RunCommand(args)
+}
+
+// RunCommand runs the subcommand named by the first argument against the App, serving the API when none is given
+func (a *App) RunCommand(args []string) error {
+	name := "serve"
+	if len(args) > 0 {
+		name, args = args[0], args[1:]
+	}
+	if name == "help" || name == "-h" || name == "--help" {
+		a.printUsage()
+		return nil
+	}
+	for _, c := range commands {
+		if c.name == name {
+			return c.run(a, args)
+		}
+	}
+	a.printUsage()
+	return fmt.Errorf("unknown command %q", name)
+}
+
+// stdout returns the writer the subcommands print their results to
+func (a *App) stdout() io.Writer {
+	if a.out == nil {
+		return os.Stdout
+	}
+	return a.out
+}
+
+// printUsage prints the subcommands of the server binary
+func (a *App) printUsage() {
+	w := tabwriter.NewWriter(a.stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "Usage: main <command> [flags]\n\nCommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %s\t%s\n", c.name, c.summary)
+	}
+	w.Flush()
+}
+
+// newFlagSet returns a flag.FlagSet for a subcommand that reports errors instead of exiting, with the config flags
+// bound to it
+func (a *App) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stdout())
+	a.cfgFlags = config.BindFlags(fs)
+	return fs
+}
+
+// openDB loads the config settings & connects the DB Client unless the App is already initialized, and returns the
+// database services along with a func that closes the connection it opened
+func (a *App) openDB() (*cliServices, func(), error) {
+	closer := func() {}
+	if a.db == nil {
+		if err := a.loadConfigurations(); err != nil {
+			return nil, nil, err
+		}
+		if err := a.connectDB(); err != nil {
+			return nil, nil, err
+		}
+		closer = func() { a.db.Close() }
+	}
+	gHandler := a.db.NewGroupHandler()
+	uHandler := a.db.NewUserHandler()
+	return &cliServices{
+		groups:     database.NewGroupService(a.db, gHandler),
+		users:      database.NewUserService(a.db, uHandler, gHandler),
+		tasks:      database.NewTaskService(a.db, a.db.NewTaskHandler(), uHandler, gHandler, a.db.NewMembershipHandler()),
+		blacklists: database.NewBlacklistService(a.db, a.db.NewBlacklistHandler()),
+		audits:     database.NewAuditService(a.db, a.db.NewAuditHandler()),
+	}, closer, nil
+}
+
+// recordCLIAudit appends an audit event for an administration subcommand
+func (s *cliServices) recordCLIAudit(ctx context.Context, action string, user *models.User) error {
+	event := models.NewAuditEvent(ctx, action, "user", user.Id, user.GroupId)
+	event.Metadata = map[string]string{"source": "cli"}
+	_, err := s.audits.AuditCreate(ctx, event)
+	return err
+}
+
+// serve initializes the App and runs the gRPC server
+func (a *App) serve(args []string) error {
+	if err := a.newFlagSet("serve").Parse(args); err != nil {
+		return err
+	}
+	if err := a.Initialize(); err != nil {
+		return err
+	}
+	a.Run()
+	return nil
+}
+
+// migrate applies every pending migration ("up"), reverts the latest applied migrations ("down [steps]", one by
+// default), or lists every migration ("status")
+func (a *App) migrate(args []string) error {
+	fs := a.newFlagSet("migrate")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	args = fs.Args()
+	action := "up"
+	if len(args) > 0 {
+		action = args[0]
+	}
+	steps := 1
+	if action == "down" && len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return errors.New("migrate down: steps must be a positive integer")
+		}
+		steps = n
+	}
+	_, closeDB, err := a.openDB()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	migrator := database.NewMigrator(a.db, a.db.NewMigrationHandler())
+	ctx := context.Background()
+	var ms []*models.Migration
+	switch action {
+	case "up":
+		ms, err = migrator.Up(ctx)
+	case "down":
+		ms, err = migrator.Down(ctx, steps)
+	case "status":
+		ms, err = migrator.Status(ctx)
+	default:
+		return errors.New("migrate: unknown action " + action + ", expected up, down, or status")
+	}
+	w := tabwriter.NewWriter(a.stdout(), 0, 4, 2, ' ', 0)
+	for _, m := range ms {
+		state := "pending"
+		if m.Applied {
+			state = "applied " + m.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", m.Version, m.Description, state)
+	}
+	w.Flush()
+	return err
+}
+
+// createRootAdmin creates a root admin User and, when no root admin group with the input name exists, its group
+func (a *App) createRootAdmin(args []string) error {
+	fs := a.newFlagSet("create-root-admin")
+	email := fs.String("email", "", "email of the root admin (required)")
+	username := fs.String("username", "", "username of the root admin (required)")
+	password := fs.String("password", "", "password of the root admin (required)")
+	groupName := fs.String("group", "", "name of the root admin group, defaults to the configured RootGroup")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	svc, closeDB, err := a.openDB()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	if *groupName == "" {
+		*groupName = a.conf.RootGroup
+	}
+	ctx := context.Background()
+	user, err := bootstrapRootAdmin(ctx, svc.groups, svc.users, &models.Group{Name: *groupName},
+		&models.User{Email: *email, Username: *username, Password: *password})
+	if err != nil {
+		return err
+	}
+	if err = svc.recordCLIAudit(ctx, models.AuditUserCreate, user); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout(), "created root admin %s (%s) in group %s\n", user.Email, user.Id, user.GroupId)
+	return nil
+}
+
+// resetPassword sets a User's password and revokes the tokens issued to them
+func (a *App) resetPassword(args []string) error {
+	fs := a.newFlagSet("reset-password")
+	email := fs.String("email", "", "email of the user (required)")
+	password := fs.String("password", "", "new password (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *email == "" || *password == "" {
+		return errors.New("reset-password: -email and -password are required")
+	}
+	svc, closeDB, err := a.openDB()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	ctx := context.Background()
+	user, err := svc.users.UserFind(ctx, &models.User{Email: *email})
+	if err != nil {
+		return err
+	}
+	user, err = svc.users.UserUpdate(ctx, &models.User{Id: user.Id, Password: *password, TokensRevokedAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+	if err = svc.recordCLIAudit(ctx, models.AuditPasswordUpdate, user); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout(), "reset the password of %s (%s)\n", user.Email, user.Id)
+	return nil
+}
+
+// createGroup creates a Group
+func (a *App) createGroup(args []string) error {
+	fs := a.newFlagSet("create-group")
+	name := fs.String("name", "", "name of the group (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" {
+		return errors.New("create-group: -name is required")
+	}
+	svc, closeDB, err := a.openDB()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	group, err := svc.groups.GroupCreate(context.Background(), &models.Group{Id: utilities.GenerateObjectID(), Name: *name})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout(), "created group %s (%s)\n", group.Name, group.Id)
+	return nil
+}
+
+// listUsers prints the Users of every Group, or of a single Group, as a table or as json
+func (a *App) listUsers(args []string) error {
+	fs := a.newFlagSet("list-users")
+	groupId := fs.String("group", "", "id of the group to list the users of")
+	asJSON := fs.Bool("json", false, "print the users as json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *groupId != "" && !utilities.CheckObjectID(*groupId) {
+		return errors.New("list-users: " + *groupId + " is an invalid group id")
+	}
+	svc, closeDB, err := a.openDB()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	users, err := svc.users.UsersFind(context.Background(), &models.User{GroupId: *groupId})
+	if err != nil {
+		return err
+	}
+	public := make([]*models.User, 0, len(users))
+	for _, u := range users {
+		public = append(public, u.Public())
+	}
+	if *asJSON {
+		enc := json.NewEncoder(a.stdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(public)
+	}
+	w := tabwriter.NewWriter(a.stdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tEMAIL\tUSERNAME\tROLE\tGROUP\tROOT")
+	for _, u := range public {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\n", u.Id, u.Email, u.Username, u.Role, u.GroupId, u.RootAdmin)
+	}
+	return w.Flush()
+}
+
+// revokeTokens revokes every token issued to a User, or blacklists a single token
+func (a *App) revokeTokens(args []string) error {
+	fs := a.newFlagSet("revoke-tokens")
+	email := fs.String("email", "", "email of the user whose tokens are revoked")
+	token := fs.String("token", "", "a single token to blacklist")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if (*email == "") == (*token == "") {
+		return errors.New("revoke-tokens: exactly one of -email or -token is required")
+	}
+	svc, closeDB, err := a.openDB()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	ctx := context.Background()
+	if *token != "" {
+		if err = svc.blacklists.BlacklistAuthToken(ctx, *token); err != nil {
+			return err
+		}
+		fmt.Fprintln(a.stdout(), "blacklisted the token")
+		return nil
+	}
+	user, err := svc.users.UserFind(ctx, &models.User{Email: *email})
+	if err != nil {
+		return err
+	}
+	user, err = svc.users.UserUpdate(ctx, &models.User{Id: user.Id, TokensRevokedAt: time.Now().UTC()})
+	if err != nil {
+		return err
+	}
+	if err = svc.recordCLIAudit(ctx, models.AuditTokensRevoke, user); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout(), "revoked the tokens of %s (%s)\n", user.Email, user.Id)
+	return nil
+}
+
+// export writes every Group, User, and Task as json to a file or, by default, to stdout
+func (a *App) export(args []string) error {
+	fs := a.newFlagSet("export")
+	out := fs.String("out", "-", "file to write the export to, or - for stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	svc, closeDB, err := a.openDB()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	ctx := context.Background()
+	data := &exportData{ExportedAt: time.Now().UTC()}
+	if data.Groups, err = svc.groups.GroupsFind(ctx, &models.Group{}); err != nil {
+		return err
+	}
+	if data.Users, err = svc.users.UsersFind(ctx, &models.User{}); err != nil {
+		return err
+	}
+	if data.Tasks, err = svc.tasks.TasksFind(ctx, &models.Task{}); err != nil {
+		return err
+	}
+	w := a.stdout()
+	if *out != "-" {
+		f, err := os.OpenFile(*out, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
+
+// importData inserts the Groups, Users, and Tasks of an export in a single transaction, so that nothing is imported
+// when any record fails
+func (a *App) importData(args []string) error {
+	fs := a.newFlagSet("import")
+	in := fs.String("in", "", "export file to import (required)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *in == "" {
+		return errors.New("import: -in is required")
+	}
+	raw, err := os.ReadFile(*in)
+	if err != nil {
+		return err
+	}
+	data := &exportData{}
+	if err = json.Unmarshal(raw, data); err != nil {
+		return err
+	}
+	svc, closeDB, err := a.openDB()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+	err = a.db.WithTransaction(context.Background(), func(ctx context.Context) error {
+		for _, g := range data.Groups {
+			if _, err := svc.groups.GroupDocInsert(ctx, g); err != nil {
+				return fmt.Errorf("group %s: %w", g.Id, err)
+			}
+		}
+		for _, u := range data.Users {
+			if _, err := svc.users.UserImport(ctx, u); err != nil {
+				return fmt.Errorf("user %s: %w", u.Id, err)
+			}
+		}
+		for _, t := range data.Tasks {
+			if _, err := svc.tasks.TaskDocInsert(ctx, t); err != nil {
+				return fmt.Errorf("task %s: %w", t.Id, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stdout(), "imported %d groups, %d users, and %d tasks\n", len(data.Groups), len(data.Users), len(data.Tasks))
+	return nil
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	"time"
@@ -34,7 +35,7 @@ func createTestGroup(ta *App, groupType int) *models.Group {
 		group.LastModified = time.Now().UTC()
 		group.CreatedAt = time.Now().UTC()
 	}
-	_, err := ta.server.GroupDataService.GroupDocInsert(&group)
+	_, err := ta.server.GroupDataService.GroupDocInsert(context.Background(), &group)
 	if err != nil {
 		panic(err)
 	}
@@ -69,7 +70,7 @@ func createTestUser(ta *App, userType int) *models.User {
 		user.LastModified = time.Now().UTC()
 		user.CreatedAt = time.Now().UTC()
 	}
-	_, err := ta.server.UserDataService.UserDocInsert(&user)
+	_, err := ta.server.UserDataService.UserDocInsert(context.Background(), &user)
 	if err != nil {
 		panic(err)
 	}
@@ -104,7 +105,7 @@ func createTestAdminUser(ta *App, userType int, root bool) *models.User {
 		user.LastModified = time.Now().UTC()
 		user.CreatedAt = time.Now().UTC()
 	}
-	_, err := ta.server.UserDataService.UserDocInsert(&user)
+	_, err := ta.server.UserDataService.UserDocInsert(context.Background(), &user)
 	if err != nil {
 		panic(err)
 	}
@@ -146,7 +147,7 @@ func createTestTask(ta *App, taskType int) *models.Task {
 		task.LastModified = now.UTC()
 		task.CreatedAt = now.UTC()
 	}
-	_, err := ta.server.TaskDataService.TaskDocInsert(&task)
+	_, err := ta.server.TaskDataService.TaskDocInsert(context.Background(), &task)
 	if err != nil {
 		panic(err)
 	}
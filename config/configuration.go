@@ -1,8 +1,8 @@
 package config
 
 import (
-	"encoding/json"
-	"os"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -32,11 +32,109 @@ type LoggerConfig struct {
 	Level             string
 }
 
+// TracerConfig holds config settings for OpenTelemetry tracing
+type TracerConfig struct {
+	Exporter    string
+	Endpoint    string
+	Insecure    bool
+	ServiceName string
+	SampleRatio float64
+}
+
+// AuditConfig holds config settings for the audit log
+type AuditConfig struct {
+	RetentionDays int
+	PurgeInterval time.Duration
+}
+
+// EventsConfig holds config settings for the domain event outbox relay and webhook deliveries. WebhookAllowPrivate lets
+// deliveries reach loopback, private, and link-local addresses, which are refused by default
+type EventsConfig struct {
+	RelayInterval       time.Duration
+	WebhookMaxAttempts  int
+	WebhookBackoff      time.Duration
+	WebhookTimeout      time.Duration
+	WebhookAllowPrivate bool
+}
+
+// VaultConfig holds config settings for the Vault compatible secret provider used by vault:// secret references
+type VaultConfig struct {
+	Address   string
+	Token     string
+	Namespace string
+}
+
+// CORSConfig holds the cross-origin resource sharing settings of the gateway port. An AllowedOrigins entry of "*"
+// allows every origin; AllowedHeaders adds request headers to the ones the gRPC-Web, Connect, and REST clients send
+type CORSConfig struct {
+	AllowedOrigins   []string
+	AllowedHeaders   []string
+	AllowCredentials bool
+	MaxAge           int
+}
+
+// GatewayConfig holds config settings for the HTTP/JSON gateway in front of the gRPC services; an empty Port disables it.
+// GRPCWeb also serves the gRPC-Web and Connect protocols of every registered service on the gateway port
+type GatewayConfig struct {
+	Port    string
+	GRPCWeb bool
+	CORS    CORSConfig
+}
+
+// RateLimitRule is a token bucket limit that refills Rate requests per second up to Burst, kept per user, group,
+// api_key, or ip depending on Key. A Rate of 0 disables the limit
+type RateLimitRule struct {
+	Rate  float64
+	Burst int
+	Key   string
+}
+
+// RateLimitConfig holds config settings for request rate limiting; Methods overrides the Default rule per full gRPC
+// method name such as /usersService.UserService/Login
+type RateLimitConfig struct {
+	Default RateLimitRule
+	Methods map[string]RateLimitRule
+}
+
+// GroupQuota overrides the default quotas of a single group; 0 keeps the default
+type GroupQuota struct {
+	MaxUsers int
+	MaxTasks int
+}
+
+// QuotaConfig holds config settings for the per group resource quotas; 0 is unlimited
+type QuotaConfig struct {
+	MaxUsersPerGroup int
+	MaxTasksPerGroup int
+	Groups           map[string]GroupQuota
+}
+
+// Limits returns the maximum number of users and tasks of the group with the given id
+func (q QuotaConfig) Limits(groupId string) (maxUsers int, maxTasks int) {
+	maxUsers, maxTasks = q.MaxUsersPerGroup, q.MaxTasksPerGroup
+	if g, ok := q.Groups[groupId]; ok {
+		if g.MaxUsers != 0 {
+			maxUsers = g.MaxUsers
+		}
+		if g.MaxTasks != 0 {
+			maxTasks = g.MaxTasks
+		}
+	}
+	return maxUsers, maxTasks
+}
+
 // Configuration is a struct designed to hold the applications variable configuration settings
 type Configuration struct {
 	Server       ServerConfig
 	MongoDB      MongoDBConfig
 	Logger       LoggerConfig
+	Tracer       TracerConfig
+	Audit        AuditConfig
+	Events       EventsConfig
+	Vault        VaultConfig
+	Gateway      GatewayConfig
+	RateLimit    RateLimitConfig
+	Quota        QuotaConfig
 	TokenSecret  string
 	RootAdmin    string
 	RootPassword string
@@ -47,75 +145,129 @@ type Configuration struct {
 	ENV          string
 }
 
-// GetConfigurations is a function that reads a json configuration file and outputs a Configuration struct
-func GetConfigurations() (*Configuration, error) {
-	confFile := "conf.json"
-	if os.Getenv("ENV") == "test" {
-		confFile = "../config/test_conf.json"
+// loggerLevels are the zap levels accepted by Logger.Level
+var loggerLevels = []string{"debug", "info", "warn", "error", "dpanic", "panic", "fatal"}
+
+// rateLimitKeys are the identities a RateLimitRule can be kept per
+var rateLimitKeys = []string{"user", "group", "api_key", "ip"}
+
+// Defaults returns the Configuration settings that apply when no file, environment variable, or flag overrides them
+func Defaults() *Configuration {
+	return &Configuration{
+		Server: ServerConfig{
+			Port:              ":5555",
+			Registration:      "ON",
+			SSL:               "false",
+			Timeout:           15,
+			ReadTimeout:       5,
+			WriteTimeout:      5,
+			MaxConnectionIdle: 5,
+			MaxConnectionAge:  5,
+		},
+		Logger: LoggerConfig{
+			Encoding: "json",
+			Level:    "info",
+		},
+		Tracer: TracerConfig{
+			Exporter:    "none",
+			ServiceName: "go-grpc-server-boilerplate",
+			SampleRatio: 1,
+		},
+		Audit: AuditConfig{
+			PurgeInterval: 60,
+		},
+		Events: EventsConfig{
+			RelayInterval:      1,
+			WebhookMaxAttempts: 8,
+			WebhookBackoff:     30,
+			WebhookTimeout:     10,
+		},
+		Gateway: GatewayConfig{
+			Port: ":8080",
+		},
+		RateLimit: RateLimitConfig{
+			Default: RateLimitRule{Key: "user"},
+		},
+		ENV: "development",
+	}
+}
+
+// ValidationError lists every invalid setting of a Configuration
+type ValidationError struct {
+	Problems []string
+}
+
+// Error returns the invalid settings as a single message
+func (e *ValidationError) Error() string {
+	return "invalid configuration: " + strings.Join(e.Problems, "; ")
+}
+
+// Validate checks every setting of the Configuration and returns a *ValidationError listing the invalid ones
+func (c *Configuration) Validate() error {
+	v := &ValidationError{}
+	check := func(ok bool, field string, format string, args ...interface{}) {
+		if !ok {
+			v.Problems = append(v.Problems, field+": "+fmt.Sprintf(format, args...))
+		}
 	}
-	file, err := os.Open(confFile)
-	if err != nil {
-		return nil, err
+	check(c.Server.Port != "", "Server.Port", "is required")
+	check(oneOf(c.Server.Registration, "ON", "OFF", "INVITE"), "Server.Registration", "must be ON, OFF or INVITE, got %q", c.Server.Registration)
+	check(oneOf(c.Server.SSL, "true", "false"), "Server.SSL", "must be true or false, got %q", c.Server.SSL)
+	check(c.Server.Timeout > 0, "Server.Timeout", "must be greater than 0")
+	check(c.Server.MaxConnectionIdle > 0, "Server.MaxConnectionIdle", "must be greater than 0")
+	check(c.Server.MaxConnectionAge > 0, "Server.MaxConnectionAge", "must be greater than 0")
+	check(c.MongoDB.URI != "", "MongoDB.URI", "is required")
+	check(c.MongoDB.DB != "", "MongoDB.DB", "is required")
+	check(oneOf(c.Logger.Level, loggerLevels...), "Logger.Level", "must be one of %s, got %q", strings.Join(loggerLevels, ", "), c.Logger.Level)
+	check(oneOf(c.Logger.Encoding, "json", "console"), "Logger.Encoding", "must be json or console, got %q", c.Logger.Encoding)
+	check(oneOf(c.Tracer.Exporter, "", "none", "stdout", "otlp"), "Tracer.Exporter", "must be otlp, stdout, or none, got %q", c.Tracer.Exporter)
+	check(c.Tracer.Exporter != "otlp" || c.Tracer.Endpoint != "", "Tracer.Endpoint", "is required by the otlp exporter")
+	check(c.Tracer.SampleRatio >= 0 && c.Tracer.SampleRatio <= 1, "Tracer.SampleRatio", "must be between 0 and 1, got %v", c.Tracer.SampleRatio)
+	check(c.Audit.RetentionDays >= 0, "Audit.RetentionDays", "must not be negative")
+	check(c.Audit.RetentionDays == 0 || c.Audit.PurgeInterval > 0, "Audit.PurgeInterval", "must be greater than 0 when RetentionDays is set")
+	check(c.Events.RelayInterval > 0, "Events.RelayInterval", "must be greater than 0")
+	check(c.Events.WebhookMaxAttempts >= 0, "Events.WebhookMaxAttempts", "must not be negative")
+	check(c.Events.WebhookBackoff >= 0, "Events.WebhookBackoff", "must not be negative")
+	check(c.Events.WebhookTimeout > 0, "Events.WebhookTimeout", "must be greater than 0")
+	check(c.Gateway.Port == "" || c.Gateway.Port != c.Server.Port, "Gateway.Port", "must differ from Server.Port")
+	check(!c.Gateway.CORS.AllowCredentials || !oneOf("*", c.Gateway.CORS.AllowedOrigins...), "Gateway.CORS.AllowCredentials", "cannot be used with the * origin")
+	check(c.Gateway.CORS.MaxAge >= 0, "Gateway.CORS.MaxAge", "must not be negative")
+	checkRule := func(field string, r RateLimitRule) {
+		check(r.Rate >= 0, field+".Rate", "must not be negative")
+		check(r.Rate == 0 || r.Burst > 0, field+".Burst", "must be greater than 0 when Rate is set")
+		check(oneOf(r.Key, rateLimitKeys...), field+".Key", "must be one of %s, got %q", strings.Join(rateLimitKeys, ", "), r.Key)
 	}
-	decoder := json.NewDecoder(file)
-	configurationSettings := Configuration{}
-	err = decoder.Decode(&configurationSettings)
-	if err != nil {
-		return nil, err
+	checkRule("RateLimit.Default", c.RateLimit.Default)
+	for method, r := range c.RateLimit.Methods {
+		checkRule("RateLimit.Methods["+method+"]", r)
 	}
-	return &configurationSettings, nil
-}
-
-// GetDevConfigurations returns a Configuration struct for running in Docker
-func GetDevConfigurations() (*Configuration, error) {
-	serverConfigs := ServerConfig{
-		Port:              ":5555",
-		Registration:      "ON",
-		SSL:               "false",
-		Timeout:           15,
-		ReadTimeout:       5,
-		WriteTimeout:      5,
-		MaxConnectionIdle: 5,
-		MaxConnectionAge:  5,
+	check(c.Quota.MaxUsersPerGroup >= 0, "Quota.MaxUsersPerGroup", "must not be negative")
+	check(c.Quota.MaxTasksPerGroup >= 0, "Quota.MaxTasksPerGroup", "must not be negative")
+	for id, g := range c.Quota.Groups {
+		check(g.MaxUsers >= 0 && g.MaxTasks >= 0, "Quota.Groups["+id+"]", "must not be negative")
 	}
-	mongoDBConfigs := MongoDBConfig{
-		URI: os.Getenv("MONGO_URI"),
-		DB:  "testDB",
+	check(c.TokenSecret != "", "TokenSecret", "is required")
+	check(c.RootAdmin != "", "RootAdmin", "is required")
+	check(c.RootPassword != "", "RootPassword", "is required")
+	check(c.RootEmail != "", "RootEmail", "is required")
+	check(c.RootGroup != "", "RootGroup", "is required")
+	if c.ENV != "test" { // tests serve over an in-memory listener without TLS
+		check(c.Cert != "", "Cert", "is required")
+		check(c.Key != "", "Key", "is required")
 	}
-	loggerConfigs := LoggerConfig{
-		DisableCaller:     false,
-		DisableStacktrace: false,
-		Encoding:          "json",
-		Level:             "info",
+	check(c.ENV != "", "ENV", "is required")
+	if len(v.Problems) > 0 {
+		return v
 	}
-	return &Configuration{
-		Server:       serverConfigs,
-		MongoDB:      mongoDBConfigs,
-		Logger:       loggerConfigs,
-		TokenSecret:  os.Getenv("TOKEN_SECRET"),
-		RootAdmin:    os.Getenv("ROOT_ADMIN"),
-		RootPassword: os.Getenv("ROOT_PASSWORD"),
-		RootEmail:    os.Getenv("ROOT_EMAIL"),
-		RootGroup:    os.Getenv("ROOT_GROUP"),
-		Cert:         os.Getenv("CERT"),
-		Key:          os.Getenv("KEY"),
-		ENV:          os.Getenv("ENV"),
-	}, nil
-}
-
-// InitializeEnvironmentalVars initializes the environmental variables for the application
-func (c *Configuration) InitializeEnvironmentalVars() {
-	os.Setenv("MONGO_URI", c.MongoDB.URI)
-	os.Setenv("DATABASE", c.MongoDB.DB)
-	os.Setenv("TOKEN_SECRET", c.TokenSecret)
-	os.Setenv("ROOT_ADMIN", c.RootAdmin)
-	os.Setenv("ROOT_PASSWORD", c.RootPassword)
-	os.Setenv("ROOT_EMAIL", c.RootEmail)
-	os.Setenv("ROOT_GROUP", c.RootGroup)
-	os.Setenv("REGISTRATION", c.Server.Registration)
-	os.Setenv("PORT", c.Server.Port)
-	os.Setenv("HTTPS", c.Server.SSL)
-	os.Setenv("CERT", c.Cert)
-	os.Setenv("KEY", c.Key)
-	os.Setenv("ENV", c.ENV)
+	return nil
+}
+
+// oneOf returns whether value is one of the allowed values
+func oneOf(value string, allowed ...string) bool {
+	for _, a := range allowed {
+		if value == a {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,269 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// setting is a Configuration value that can be overridden by an environment variable and, when flag is set, a flag
+type setting struct {
+	env   string
+	flag  string
+	usage string
+	set   func(c *Configuration, v string) error
+}
+
+// stringSetting returns a setting that overrides a string field
+func stringSetting(env string, flag string, usage string, field func(c *Configuration) *string) setting {
+	return setting{env, flag, usage, func(c *Configuration, v string) error {
+		*field(c) = v
+		return nil
+	}}
+}
+
+// boolSetting returns a setting that overrides a bool field
+func boolSetting(env string, flag string, usage string, field func(c *Configuration) *bool) setting {
+	return setting{env, flag, usage, func(c *Configuration, v string) error {
+		b, err := strconv.ParseBool(v)
+		*field(c) = b
+		return err
+	}}
+}
+
+// intSetting returns a setting that overrides an int field
+func intSetting(env string, flag string, usage string, field func(c *Configuration) *int) setting {
+	return setting{env, flag, usage, func(c *Configuration, v string) error {
+		n, err := strconv.Atoi(v)
+		*field(c) = n
+		return err
+	}}
+}
+
+// floatSetting returns a setting that overrides a float64 field
+func floatSetting(env string, flag string, usage string, field func(c *Configuration) *float64) setting {
+	return setting{env, flag, usage, func(c *Configuration, v string) error {
+		f, err := strconv.ParseFloat(v, 64)
+		*field(c) = f
+		return err
+	}}
+}
+
+// listSetting returns a setting that overrides a []string field with a comma separated list
+func listSetting(env string, flag string, usage string, field func(c *Configuration) *[]string) setting {
+	return setting{env, flag, usage, func(c *Configuration, v string) error {
+		*field(c) = nil
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*field(c) = append(*field(c), item)
+			}
+		}
+		return nil
+	}}
+}
+
+// durationSetting returns a setting that overrides a time.Duration field, which like the files is a count of the
+// unit the field is documented in
+func durationSetting(env string, flag string, usage string, field func(c *Configuration) *time.Duration) setting {
+	return setting{env, flag, usage, func(c *Configuration, v string) error {
+		n, err := strconv.ParseInt(v, 10, 64)
+		*field(c) = time.Duration(n)
+		return err
+	}}
+}
+
+// settings lists every Configuration value that can be overridden by the environment or by flags
+var settings = []setting{
+	stringSetting("ENV", "env", "environment: development, production, test, or docker-dev", func(c *Configuration) *string { return &c.ENV }),
+	stringSetting("PORT", "port", "address the gRPC server listens on", func(c *Configuration) *string { return &c.Server.Port }),
+	stringSetting("REGISTRATION", "registration", "ON or OFF to allow self registration, or INVITE to only allow it with an invite code", func(c *Configuration) *string { return &c.Server.Registration }),
+	stringSetting("HTTPS", "ssl", "true or false", func(c *Configuration) *string { return &c.Server.SSL }),
+	stringSetting("GATEWAY_PORT", "gateway-port", "address the HTTP/JSON gateway listens on, empty to disable it", func(c *Configuration) *string { return &c.Gateway.Port }),
+	boolSetting("GATEWAY_GRPC_WEB", "", "", func(c *Configuration) *bool { return &c.Gateway.GRPCWeb }),
+	listSetting("CORS_ALLOWED_ORIGINS", "cors-allowed-origins", "comma separated origins allowed to call the gateway port, * for any", func(c *Configuration) *[]string { return &c.Gateway.CORS.AllowedOrigins }),
+	listSetting("CORS_ALLOWED_HEADERS", "", "", func(c *Configuration) *[]string { return &c.Gateway.CORS.AllowedHeaders }),
+	durationSetting("SERVER_TIMEOUT", "", "", func(c *Configuration) *time.Duration { return &c.Server.Timeout }),
+	stringSetting("MONGO_URI", "mongo-uri", "MongoDB connection uri", func(c *Configuration) *string { return &c.MongoDB.URI }),
+	stringSetting("DATABASE", "database", "MongoDB database name", func(c *Configuration) *string { return &c.MongoDB.DB }),
+	stringSetting("LOG_LEVEL", "log-level", "debug, info, warn, error, dpanic, panic, or fatal", func(c *Configuration) *string { return &c.Logger.Level }),
+	stringSetting("LOG_ENCODING", "log-encoding", "json or console", func(c *Configuration) *string { return &c.Logger.Encoding }),
+	stringSetting("OTEL_EXPORTER", "tracer-exporter", "otlp, stdout, or none", func(c *Configuration) *string { return &c.Tracer.Exporter }),
+	stringSetting("OTEL_EXPORTER_OTLP_ENDPOINT", "tracer-endpoint", "OTLP collector host:port", func(c *Configuration) *string { return &c.Tracer.Endpoint }),
+	boolSetting("OTEL_EXPORTER_OTLP_INSECURE", "", "", func(c *Configuration) *bool { return &c.Tracer.Insecure }),
+	stringSetting("OTEL_SERVICE_NAME", "", "", func(c *Configuration) *string { return &c.Tracer.ServiceName }),
+	floatSetting("OTEL_SAMPLE_RATIO", "", "", func(c *Configuration) *float64 { return &c.Tracer.SampleRatio }),
+	intSetting("AUDIT_RETENTION_DAYS", "audit-retention-days", "days audit events are kept, 0 keeps them forever", func(c *Configuration) *int { return &c.Audit.RetentionDays }),
+	intSetting("WEBHOOK_MAX_ATTEMPTS", "", "", func(c *Configuration) *int { return &c.Events.WebhookMaxAttempts }),
+	boolSetting("WEBHOOK_ALLOW_PRIVATE", "", "", func(c *Configuration) *bool { return &c.Events.WebhookAllowPrivate }),
+	floatSetting("RATE_LIMIT_RATE", "", "", func(c *Configuration) *float64 { return &c.RateLimit.Default.Rate }),
+	intSetting("RATE_LIMIT_BURST", "", "", func(c *Configuration) *int { return &c.RateLimit.Default.Burst }),
+	stringSetting("RATE_LIMIT_KEY", "", "", func(c *Configuration) *string { return &c.RateLimit.Default.Key }),
+	intSetting("QUOTA_MAX_USERS_PER_GROUP", "", "", func(c *Configuration) *int { return &c.Quota.MaxUsersPerGroup }),
+	intSetting("QUOTA_MAX_TASKS_PER_GROUP", "", "", func(c *Configuration) *int { return &c.Quota.MaxTasksPerGroup }),
+	stringSetting("VAULT_ADDR", "vault-addr", "address of the Vault server resolving vault:// secret references", func(c *Configuration) *string { return &c.Vault.Address }),
+	stringSetting("VAULT_TOKEN", "", "", func(c *Configuration) *string { return &c.Vault.Token }),
+	stringSetting("VAULT_NAMESPACE", "", "", func(c *Configuration) *string { return &c.Vault.Namespace }),
+	stringSetting("TOKEN_SECRET", "", "", func(c *Configuration) *string { return &c.TokenSecret }),
+	stringSetting("ROOT_ADMIN", "", "", func(c *Configuration) *string { return &c.RootAdmin }),
+	stringSetting("ROOT_PASSWORD", "", "", func(c *Configuration) *string { return &c.RootPassword }),
+	stringSetting("ROOT_EMAIL", "", "", func(c *Configuration) *string { return &c.RootEmail }),
+	stringSetting("ROOT_GROUP", "", "", func(c *Configuration) *string { return &c.RootGroup }),
+	stringSetting("CERT", "cert", "TLS certificate file", func(c *Configuration) *string { return &c.Cert }),
+	stringSetting("KEY", "key", "TLS key file", func(c *Configuration) *string { return &c.Key }),
+}
+
+// Flags are the command line flags that override the file and environment settings
+type Flags struct {
+	fs     *flag.FlagSet
+	file   *string
+	values map[string]*string
+}
+
+// BindFlags registers the configuration flags on a flag.FlagSet; the returned Flags are read by Load once it is parsed
+func BindFlags(fs *flag.FlagSet) *Flags {
+	f := &Flags{
+		fs:     fs,
+		file:   fs.String("config", "", "configuration file (.json, .yaml, or .yml), overrides CONFIG_FILE"),
+		values: make(map[string]*string),
+	}
+	for _, s := range settings {
+		if s.flag != "" {
+			f.values[s.flag] = fs.String(s.flag, "", s.usage+", overrides "+s.env)
+		}
+	}
+	return f
+}
+
+// visited returns the configuration flags that were set on the command line
+func (f *Flags) visited() map[string]string {
+	set := make(map[string]string)
+	if f == nil {
+		return set
+	}
+	f.fs.Visit(func(fl *flag.Flag) {
+		if v, ok := f.values[fl.Name]; ok {
+			set[fl.Name] = *v
+		}
+	})
+	if *f.file != "" {
+		set["config"] = *f.file
+	}
+	return set
+}
+
+// Load builds the Configuration by layering, from lowest to highest precedence, the defaults, a JSON or YAML file,
+// environment variables, and flags, then resolves its secret references and validates the result. The file is the
+// -config flag, the CONFIG_FILE environment variable, or else the first of conf.json, conf.yaml, and conf.yml that
+// exists (../config/test_conf.json in test). flags may be nil.
+func Load(flags *Flags) (*Configuration, error) {
+	conf, err := load(flags)
+	if err != nil {
+		return nil, err
+	}
+	resolver := NewSecretResolver()
+	resolver.Register("vault", NewVaultProvider(&conf.Vault, nil))
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err = conf.ResolveSecrets(ctx, resolver); err != nil {
+		return nil, err
+	}
+	if err = conf.Validate(); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+// load layers the defaults, file, environment variables, and flags of the Configuration
+func load(flags *Flags) (*Configuration, error) {
+	conf := Defaults()
+	set := flags.visited()
+	file, explicit := configFile(set)
+	if file != "" {
+		if err := loadFile(conf, file, explicit); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range settings {
+		if v, ok := os.LookupEnv(s.env); ok {
+			if err := s.set(conf, v); err != nil {
+				return nil, fmt.Errorf("environment variable %s: invalid value %q", s.env, v)
+			}
+		}
+	}
+	for _, s := range settings {
+		if v, ok := set[s.flag]; ok && s.flag != "" {
+			if err := s.set(conf, v); err != nil {
+				return nil, fmt.Errorf("flag -%s: invalid value %q", s.flag, v)
+			}
+		}
+	}
+	return conf, nil
+}
+
+// configFile returns the configuration file to load, and whether it was given explicitly rather than by default
+func configFile(set map[string]string) (string, bool) {
+	if file := set["config"]; file != "" {
+		return file, true
+	}
+	if file := os.Getenv("CONFIG_FILE"); file != "" {
+		return file, true
+	}
+	return defaultFile(set), false
+}
+
+// defaultFile returns the configuration file used when none is given
+func defaultFile(set map[string]string) string {
+	env, ok := set["env"]
+	if !ok {
+		env = os.Getenv("ENV")
+	}
+	if env == "test" {
+		return "../config/test_conf.json"
+	}
+	for _, f := range []string{"conf.json", "conf.yaml", "conf.yml"} {
+		if _, err := os.Stat(f); err == nil {
+			return f
+		}
+	}
+	return ""
+}
+
+// loadFile decodes a JSON or YAML configuration file over conf, rejecting unknown settings; a missing file is only an
+// error when it was given explicitly
+func loadFile(conf *Configuration, file string, explicit bool) error {
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) && !explicit {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+	switch filepath.Ext(file) {
+	case ".yaml", ".yml":
+		// YAML is decoded through JSON so both formats share the Configuration field names
+		var doc map[string]interface{}
+		if err = yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("config file %s: %w", file, err)
+		}
+		if data, err = json.Marshal(doc); err != nil {
+			return fmt.Errorf("config file %s: %w", file, err)
+		}
+	case ".json":
+	default:
+		return fmt.Errorf("config file %s: unsupported format, expected .json, .yaml, or .yml", file)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(conf); err != nil {
+		return fmt.Errorf("config file %s: %w", file, err)
+	}
+	return nil
+}
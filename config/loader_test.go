@@ -0,0 +1,75 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_Load(t *testing.T) {
+	dir := t.TempDir()
+	yamlFile := filepath.Join(dir, "conf.yaml")
+	unknownFile := filepath.Join(dir, "unknown.json")
+	tomlFile := filepath.Join(dir, "conf.toml")
+	files := map[string]string{
+		yamlFile: "Server:\n  Port: \":6500\"\nMongoDB:\n  URI: mongodb://localhost:27017\n  DB: fromYAML\n" +
+			"TokenSecret: secret\nRootAdmin: admin\nRootPassword: password\nRootEmail: admin@test.com\nRootGroup: admins\n",
+		unknownFile: `{"Unknown": 1}`,
+		tomlFile:    "ENV = \"test\"\n",
+	}
+	for file, data := range files {
+		if err := os.WriteFile(file, []byte(data), 0600); err != nil {
+			t.Fatalf("os.WriteFile() error = %v", err)
+		}
+	}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name     string            // The name of the test
+		env      map[string]string // The environment variables set for the load
+		args     []string          // The command line flags of the load
+		wantPort string            // The Server.Port we want
+		wantDB   string            // The MongoDB.DB we want
+		wantErr  string            // A substring we want the error to contain, if any
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"file", nil, nil, ":5555", "testing", ""},
+		{"env over file", map[string]string{"PORT": ":6000", "DATABASE": "fromEnv"}, nil, ":6000", "fromEnv", ""},
+		{"flag over env", map[string]string{"PORT": ":6000"}, []string{"-port", ":7000"}, ":7000", "testing", ""},
+		{"yaml file flag", nil, []string{"-config", yamlFile}, ":6500", "fromYAML", ""},
+		{"yaml file env", map[string]string{"CONFIG_FILE": yamlFile}, nil, ":6500", "fromYAML", ""},
+		{"invalid env value", map[string]string{"SERVER_TIMEOUT": "soon"}, nil, "", "", "environment variable SERVER_TIMEOUT"},
+		{"invalid flag value", nil, []string{"-audit-retention-days", "week"}, "", "", "flag -audit-retention-days"},
+		{"missing file", nil, []string{"-config", filepath.Join(dir, "missing.json")}, "", "", "config file"},
+		{"unknown setting", nil, []string{"-config", unknownFile}, "", "", "unknown field"},
+		{"unsupported format", nil, []string{"-config", tomlFile}, "", "", "unsupported format"},
+		{"invalid setting", map[string]string{"REGISTRATION": "MAYBE"}, nil, "", "", "Server.Registration"},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV", "test")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			flags := BindFlags(fs)
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatalf("flag.FlagSet.Parse() error = %v", err)
+			}
+			conf, err := Load(flags)
+			// Checking the error
+			if (err != nil) != (tt.wantErr != "") || (err != nil && !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if conf.Server.Port != tt.wantPort || conf.MongoDB.DB != tt.wantDB { // Asserting whether we get the correct wanted value
+				t.Errorf("Load() = %s, %s, want %s, %s", conf.Server.Port, conf.MongoDB.DB, tt.wantPort, tt.wantDB)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// redactedValue replaces secret values when a Configuration is logged
+const redactedValue = "[REDACTED]"
+
+// SecretProvider resolves the secret a reference points to; ref is the reference with its scheme removed
+type SecretProvider interface {
+	Secret(ctx context.Context, ref string) (string, error)
+}
+
+// SecretProviderFunc adapts a func to a SecretProvider
+type SecretProviderFunc func(ctx context.Context, ref string) (string, error)
+
+// Secret calls f
+func (f SecretProviderFunc) Secret(ctx context.Context, ref string) (string, error) {
+	return f(ctx, ref)
+}
+
+// SecretResolver replaces the secret references of config values, such as file:///run/secrets/token, env:NAME, or
+// vault://secret/data/app#token, with the secrets they point to
+type SecretResolver struct {
+	providers map[string]SecretProvider
+}
+
+// NewSecretResolver returns a SecretResolver with the file and env providers registered
+func NewSecretResolver() *SecretResolver {
+	r := &SecretResolver{providers: make(map[string]SecretProvider)}
+	r.Register("file", SecretProviderFunc(fileSecret))
+	r.Register("env", SecretProviderFunc(envSecret))
+	return r
+}
+
+// Register adds the provider of a reference scheme, replacing any provider already registered for it
+func (r *SecretResolver) Register(scheme string, p SecretProvider) {
+	r.providers[scheme] = p
+}
+
+// Resolve returns the secret a value references, or the value itself when its scheme has no registered provider
+func (r *SecretResolver) Resolve(ctx context.Context, value string) (string, error) {
+	scheme, ref, ok := strings.Cut(value, ":")
+	if !ok {
+		return value, nil
+	}
+	p, ok := r.providers[scheme]
+	if !ok {
+		return value, nil
+	}
+	secret, err := p.Secret(ctx, strings.TrimPrefix(ref, "//"))
+	if err != nil {
+		return "", fmt.Errorf("secret %s: %w", value, err)
+	}
+	return secret, nil
+}
+
+// resolveFields resolves the secret references of every string field of a struct, naming the field in errors
+func (r *SecretResolver) resolveFields(ctx context.Context, v reflect.Value, path string) error {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		name := v.Type().Field(i).Name
+		if path != "" {
+			name = path + "." + name
+		}
+		switch f.Kind() {
+		case reflect.Struct:
+			if err := r.resolveFields(ctx, f, name); err != nil {
+				return err
+			}
+		case reflect.String:
+			secret, err := r.Resolve(ctx, f.String())
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			f.SetString(secret)
+		}
+	}
+	return nil
+}
+
+// ResolveSecrets replaces the secret references of every config value. The Vault settings are resolved first, so the
+// Vault token itself may be a file or env reference.
+func (c *Configuration) ResolveSecrets(ctx context.Context, r *SecretResolver) error {
+	if err := r.resolveFields(ctx, reflect.ValueOf(&c.Vault).Elem(), "Vault"); err != nil {
+		return err
+	}
+	return r.resolveFields(ctx, reflect.ValueOf(c).Elem(), "")
+}
+
+// fileSecret reads a secret from a file, such as a Docker or Kubernetes secret, without its trailing newline
+func fileSecret(_ context.Context, path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// envSecret reads a secret from an environment variable
+func envSecret(_ context.Context, name string) (string, error) {
+	secret, ok := os.LookupEnv(name)
+	if !ok {
+		return "", errors.New("environment variable " + name + " is not set")
+	}
+	return secret, nil
+}
+
+// VaultProvider is a SecretProvider that reads secrets from the HTTP API of a Vault compatible server. References are
+// <api path>#<key>, e.g. secret/data/app#token for a KV version 2 mount, and each path is read once.
+type VaultProvider struct {
+	cfg    *VaultConfig
+	client *http.Client
+	mu     sync.Mutex
+	cache  map[string]map[string]interface{}
+}
+
+// NewVaultProvider returns a VaultProvider that reads the address and token from cfg when a secret is first resolved
+func NewVaultProvider(cfg *VaultConfig, client *http.Client) *VaultProvider {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &VaultProvider{cfg: cfg, client: client, cache: make(map[string]map[string]interface{})}
+}
+
+// Secret returns the key of the secret at a Vault path
+func (p *VaultProvider) Secret(ctx context.Context, ref string) (string, error) {
+	path, key, ok := strings.Cut(ref, "#")
+	if !ok || path == "" || key == "" {
+		return "", errors.New("vault references must be <path>#<key>")
+	}
+	data, err := p.read(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	v, ok := data[key]
+	if !ok {
+		return "", errors.New("vault key " + key + " not found")
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// read returns the data of the secret at a Vault path, unwrapping the data of KV version 2 secrets
+func (p *VaultProvider) read(ctx context.Context, path string) (map[string]interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if data, ok := p.cache[path]; ok {
+		return data, nil
+	}
+	if p.cfg.Address == "" {
+		return nil, errors.New("vault address is not configured")
+	}
+	u, err := url.JoinPath(p.cfg.Address, "v1", path)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", p.cfg.Token)
+	if p.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.cfg.Namespace)
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault returned %s", res.Status)
+	}
+	var body struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err = json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	data := body.Data
+	if inner, ok := data["data"].(map[string]interface{}); ok {
+		if _, kv2 := data["metadata"]; kv2 {
+			data = inner
+		}
+	}
+	p.cache[path] = data
+	return data, nil
+}
+
+// Redacted returns a copy of the Configuration with its secrets and the password of its MongoDB URI replaced
+func (c *Configuration) Redacted() *Configuration {
+	r := *c
+	for _, s := range []*string{&r.TokenSecret, &r.RootPassword, &r.Vault.Token} {
+		if *s != "" {
+			*s = redactedValue
+		}
+	}
+	if u, err := url.Parse(r.MongoDB.URI); err == nil {
+		r.MongoDB.URI = u.Redacted()
+	} else {
+		r.MongoDB.URI = redactedValue
+	}
+	return &r
+}
+
+// String formats the Configuration with its secrets redacted, so it is safe to log
+func (c Configuration) String() string {
+	type plain Configuration // drops the String method to avoid recursing
+	return fmt.Sprintf("%+v", plain(*c.Redacted()))
+}
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_SecretReferences(t *testing.T) {
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "token_secret")
+	vaultTokenFile := filepath.Join(dir, "vault_token")
+	if err := os.WriteFile(secretFile, []byte("from-file\n"), 0600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	if err := os.WriteFile(vaultTokenFile, []byte("stub-token"), 0600); err != nil {
+		t.Fatalf("os.WriteFile() error = %v", err)
+	}
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "stub-token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/secret/data/app" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, `{"data":{"data":{"token_secret":"from-vault"},"metadata":{"version":1}}}`)
+	}))
+	defer vault.Close()
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string            // The name of the test
+		env     map[string]string // The environment variables set for the load
+		want    string            // The TokenSecret we want to be resolved
+		wantErr bool              // whether we want an error.
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"plain value",
+			map[string]string{"TOKEN_SECRET": "plain"},
+			"plain",
+			false,
+		},
+		{
+			"file reference",
+			map[string]string{"TOKEN_SECRET": "file://" + secretFile},
+			"from-file",
+			false,
+		},
+		{
+			"env reference",
+			map[string]string{"TOKEN_SECRET": "env:APP_TOKEN_SECRET", "APP_TOKEN_SECRET": "from-env"},
+			"from-env",
+			false,
+		},
+		{
+			"unset env reference",
+			map[string]string{"TOKEN_SECRET": "env:UNSET_TOKEN_SECRET"},
+			"",
+			true,
+		},
+		{
+			"vault reference",
+			map[string]string{"TOKEN_SECRET": "vault://secret/data/app#token_secret", "VAULT_ADDR": vault.URL, "VAULT_TOKEN": "file://" + vaultTokenFile},
+			"from-vault",
+			false,
+		},
+		{
+			"vault missing key",
+			map[string]string{"TOKEN_SECRET": "vault://secret/data/app#missing", "VAULT_ADDR": vault.URL, "VAULT_TOKEN": "stub-token"},
+			"",
+			true,
+		},
+		{
+			"vault forbidden",
+			map[string]string{"TOKEN_SECRET": "vault://secret/data/app#token_secret", "VAULT_ADDR": vault.URL, "VAULT_TOKEN": "wrong"},
+			"",
+			true,
+		},
+		{
+			"vault not configured",
+			map[string]string{"TOKEN_SECRET": "vault://secret/data/app#token_secret"},
+			"",
+			true,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENV", "test")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			conf, err := Load(nil)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Load() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				if strings.Contains(err.Error(), "from-vault") || strings.Contains(err.Error(), "stub-token") {
+					t.Errorf("Load() error leaks a secret: %v", err)
+				}
+				return
+			}
+			if conf.TokenSecret != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("Configuration.TokenSecret = %q, want %q", conf.TokenSecret, tt.want)
+			}
+			if logged := conf.String(); strings.Contains(logged, tt.want) {
+				t.Errorf("Configuration.String() = %s, leaks %q", logged, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package config
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// reloadableFields are the settings that are safe to change while the server runs; every other setting, including the
+// gRPC keepalive parameters that are fixed once the server is created, requires a restart. A path also covers every
+// setting nested below it
+var reloadableFields = []string{
+	"Logger.Level",
+	"Server.Registration",
+	"Events.WebhookMaxAttempts",
+	"Events.WebhookBackoff",
+	"Events.WebhookTimeout",
+	"Events.WebhookAllowPrivate",
+	"RateLimit",
+	"Quota",
+	"Gateway.CORS",
+}
+
+// ReloadableFields returns the paths of the settings that reloads apply
+func ReloadableFields() []string {
+	return append([]string(nil), reloadableFields...)
+}
+
+// ReloadResult lists the settings a reload changed
+type ReloadResult struct {
+	Applied []string // reloadable settings that now have their new value
+	Ignored []string // settings that changed but only take effect after a restart
+}
+
+// Store holds the effective Configuration, which services read through Get so that reloads reach them
+type Store struct {
+	current  atomic.Pointer[Configuration]
+	loadedAt atomic.Pointer[time.Time]
+	flags    *Flags
+	mu       sync.Mutex
+	onReload []func(conf *Configuration)
+}
+
+// NewStore returns a Store holding conf that reloads with the same flags conf was loaded with
+func NewStore(conf *Configuration, flags *Flags) *Store {
+	s := &Store{flags: flags}
+	s.swap(conf)
+	return s
+}
+
+// swap makes conf the effective Configuration
+func (s *Store) swap(conf *Configuration) {
+	now := time.Now().UTC()
+	s.current.Store(conf)
+	s.loadedAt.Store(&now)
+}
+
+// Get returns the effective Configuration, which must not be modified
+func (s *Store) Get() *Configuration {
+	return s.current.Load()
+}
+
+// LoadedAt returns when the effective Configuration was loaded or last reloaded
+func (s *Store) LoadedAt() time.Time {
+	return *s.loadedAt.Load()
+}
+
+// File returns the configuration file reloads read, if any
+func (s *Store) File() string {
+	file, _ := configFile(s.flags.visited())
+	return file
+}
+
+// FileModTime returns when the configuration file was last modified, or the zero time when there is none
+func (s *Store) FileModTime() time.Time {
+	file := s.File()
+	if file == "" {
+		return time.Time{}
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// OnReload registers fn to be called with the new effective Configuration after every reload that applies a change
+func (s *Store) OnReload(fn func(conf *Configuration)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onReload = append(s.onReload, fn)
+}
+
+// Reload loads the Configuration again and atomically swaps in its reloadable settings. An invalid Configuration is
+// rejected as a whole and the effective Configuration is left unchanged.
+func (s *Store) Reload() (*ReloadResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	loaded, err := Load(s.flags)
+	if err != nil {
+		return nil, err
+	}
+	cur := s.Get()
+	next := *cur
+	res := &ReloadResult{}
+	for _, path := range changedFields(reflect.ValueOf(cur).Elem(), reflect.ValueOf(loaded).Elem(), "") {
+		if !isReloadable(path) {
+			res.Ignored = append(res.Ignored, path)
+			continue
+		}
+		fieldByPath(reflect.ValueOf(&next).Elem(), path).Set(fieldByPath(reflect.ValueOf(loaded).Elem(), path))
+		res.Applied = append(res.Applied, path)
+	}
+	if len(res.Applied) == 0 {
+		return res, nil
+	}
+	s.swap(&next)
+	for _, fn := range s.onReload {
+		fn(&next)
+	}
+	return res, nil
+}
+
+// isReloadable returns whether the setting at path is, or is nested below, one of the reloadableFields
+func isReloadable(path string) bool {
+	for _, r := range reloadableFields {
+		if path == r || strings.HasPrefix(path, r+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// changedFields returns the paths of the leaf fields that differ between two structs of the same type
+func changedFields(a reflect.Value, b reflect.Value, path string) (changed []string) {
+	for i := 0; i < a.NumField(); i++ {
+		name := a.Type().Field(i).Name
+		if path != "" {
+			name = path + "." + name
+		}
+		if a.Field(i).Kind() == reflect.Struct {
+			changed = append(changed, changedFields(a.Field(i), b.Field(i), name)...)
+		} else if !reflect.DeepEqual(a.Field(i).Interface(), b.Field(i).Interface()) {
+			changed = append(changed, name)
+		}
+	}
+	return
+}
+
+// fieldByPath returns the field of a struct at a dotted path such as Logger.Level
+func fieldByPath(v reflect.Value, path string) reflect.Value {
+	for _, name := range strings.Split(path, ".") {
+		v = v.FieldByName(name)
+	}
+	return v
+}
@@ -0,0 +1,145 @@
+package database
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// activityModel structures a task activity BSON document to save in the task_activities collection
+type activityModel struct {
+	Id        primitive.ObjectID `bson:"_id,omitempty"`
+	TaskId    primitive.ObjectID `bson:"task_id,omitempty"`
+	UserId    primitive.ObjectID `bson:"user_id,omitempty"`
+	Type      string             `bson:"type,omitempty"`
+	From      string             `bson:"from,omitempty"`
+	To        string             `bson:"to,omitempty"`
+	CreatedAt time.Time          `bson:"created_at,omitempty"`
+}
+
+// newActivityModel initializes a new pointer to an activityModel struct from a pointer to a JSON Activity struct
+func newActivityModel(u *models.Activity) (um *activityModel, err error) {
+	um = &activityModel{
+		Type:      u.Type,
+		From:      u.From,
+		To:        u.To,
+		CreatedAt: u.CreatedAt,
+	}
+	if u.Id != "" && u.Id != "000000000000000000000000" {
+		um.Id, err = primitive.ObjectIDFromHex(u.Id)
+	}
+	if u.TaskId != "" && u.TaskId != "000000000000000000000000" {
+		um.TaskId, err = primitive.ObjectIDFromHex(u.TaskId)
+	}
+	if u.UserId != "" && u.UserId != "000000000000000000000000" {
+		um.UserId, err = primitive.ObjectIDFromHex(u.UserId)
+	}
+	return
+}
+
+// update the activityModel using an overwrite bson.D doc; activities are append-only, so nothing changes
+func (u *activityModel) update(doc interface{}) (err error) {
+	return
+}
+
+// bsonLoad loads a bson doc into the activityModel
+func (u *activityModel) bsonLoad(doc bson.D) (err error) {
+	bData, err := bsonMarshall(doc)
+	if err != nil {
+		return err
+	}
+	err = bson.Unmarshal(bData, u)
+	return err
+}
+
+// match compares an input bson doc and returns whether there's a match with the activityModel
+func (u *activityModel) match(doc interface{}) bool {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return false
+	}
+	um := activityModel{}
+	err = bson.Unmarshal(data, &um)
+	if !um.Id.IsZero() {
+		return u.Id == um.Id
+	}
+	if !um.TaskId.IsZero() {
+		return u.TaskId == um.TaskId
+	}
+	return false
+}
+
+// getID returns the unique identifier of the activityModel
+func (u *activityModel) getID() (id interface{}) {
+	return u.Id
+}
+
+// addTimeStamps updates an activityModel struct with a timestamp
+func (u *activityModel) addTimeStamps(newRecord bool) {
+	if newRecord {
+		u.CreatedAt = time.Now().UTC()
+	}
+}
+
+// addObjectID checks if an activityModel has a value assigned for Id if no value a new one is generated and assigned
+func (u *activityModel) addObjectID() {
+	if u.Id.Hex() == "" || u.Id.Hex() == "000000000000000000000000" {
+		u.Id = primitive.NewObjectID()
+	}
+}
+
+// postProcess updates an activityModel struct after it is read from or written to the db
+func (u *activityModel) postProcess() (err error) {
+	return
+}
+
+// toDoc converts the bson activityModel into a bson.D
+func (u *activityModel) toDoc() (doc bson.D, err error) {
+	data, err := bson.Marshal(u)
+	if err != nil {
+		return
+	}
+	err = bson.Unmarshal(data, &doc)
+	return
+}
+
+// bsonFilter generates a bson filter for MongoDB queries from the activityModel data
+func (u *activityModel) bsonFilter() (doc bson.D, err error) {
+	if !u.Id.IsZero() {
+		doc = bson.D{{Key: "_id", Value: u.Id}}
+	} else if !u.TaskId.IsZero() {
+		doc = bson.D{{Key: "task_id", Value: u.TaskId}}
+	}
+	return
+}
+
+// bsonUpdate generates a bson update for MongoDB queries from the activityModel data
+func (u *activityModel) bsonUpdate() (doc bson.D, err error) {
+	inner, err := u.toDoc()
+	if err != nil {
+		return
+	}
+	doc = bson.D{{Key: "$set", Value: inner}}
+	return
+}
+
+// toRoot creates and return a new pointer to an Activity JSON struct from a pointer to a BSON activityModel
+func (u *activityModel) toRoot() *models.Activity {
+	return &models.Activity{
+		Id:        u.Id.Hex(),
+		TaskId:    u.TaskId.Hex(),
+		UserId:    u.UserId.Hex(),
+		Type:      u.Type,
+		From:      u.From,
+		To:        u.To,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+func rootActivities(ms []*activityModel) (activities []*models.Activity) {
+	for _, m := range ms {
+		activities = append(activities, m.toRoot())
+	}
+	return
+}
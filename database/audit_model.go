@@ -0,0 +1,230 @@
+package database
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// auditChangeModel structures a single field change embedded in an audit event BSON document
+type auditChangeModel struct {
+	Field  string `bson:"field"`
+	Before string `bson:"before,omitempty"`
+	After  string `bson:"after,omitempty"`
+}
+
+// auditModel structures an audit event BSON document to save in the audit_events collection
+type auditModel struct {
+	Id           primitive.ObjectID  `bson:"_id,omitempty"`
+	ActorId      primitive.ObjectID  `bson:"actor_id,omitempty"`
+	ActorGroupId primitive.ObjectID  `bson:"actor_group_id,omitempty"`
+	Action       string              `bson:"action,omitempty"`
+	TargetType   string              `bson:"target_type,omitempty"`
+	TargetId     string              `bson:"target_id,omitempty"`
+	GroupId      primitive.ObjectID  `bson:"group_id,omitempty"`
+	Changes      []*auditChangeModel `bson:"changes,omitempty"`
+	Metadata     map[string]string   `bson:"metadata,omitempty"`
+	ClientIP     string              `bson:"client_ip,omitempty"`
+	RequestId    string              `bson:"request_id,omitempty"`
+	CreatedAt    time.Time           `bson:"created_at,omitempty"`
+	since        time.Time
+	until        time.Time
+}
+
+// newAuditModel initializes a new pointer to an auditModel struct from a pointer to a JSON AuditEvent struct
+func newAuditModel(u *models.AuditEvent) (um *auditModel, err error) {
+	um = &auditModel{
+		Action:     u.Action,
+		TargetType: u.TargetType,
+		TargetId:   u.TargetId,
+		Metadata:   u.Metadata,
+		ClientIP:   u.ClientIP,
+		RequestId:  u.RequestId,
+		CreatedAt:  u.CreatedAt,
+	}
+	for _, c := range u.Changes {
+		um.Changes = append(um.Changes, &auditChangeModel{Field: c.Field, Before: c.Before, After: c.After})
+	}
+	if u.Id != "" && u.Id != "000000000000000000000000" {
+		um.Id, err = primitive.ObjectIDFromHex(u.Id)
+	}
+	if u.ActorId != "" && u.ActorId != "000000000000000000000000" {
+		um.ActorId, err = primitive.ObjectIDFromHex(u.ActorId)
+	}
+	if u.ActorGroupId != "" && u.ActorGroupId != "000000000000000000000000" {
+		um.ActorGroupId, err = primitive.ObjectIDFromHex(u.ActorGroupId)
+	}
+	if u.GroupId != "" && u.GroupId != "000000000000000000000000" {
+		um.GroupId, err = primitive.ObjectIDFromHex(u.GroupId)
+	}
+	return
+}
+
+// newAuditFilterModel initializes a new pointer to an auditModel struct used to search the audit_events collection
+func newAuditFilterModel(f *models.AuditFilter) (um *auditModel, err error) {
+	um, err = newAuditModel(&models.AuditEvent{
+		ActorId:    f.ActorId,
+		GroupId:    f.GroupId,
+		Action:     f.Action,
+		TargetType: f.TargetType,
+		TargetId:   f.TargetId,
+	})
+	if err != nil {
+		return
+	}
+	um.since = f.Since
+	um.until = f.Until
+	return
+}
+
+// update is a no-op because audit events are append-only
+func (u *auditModel) update(doc interface{}) (err error) {
+	return
+}
+
+// bsonLoad loads a bson doc into the auditModel
+func (u *auditModel) bsonLoad(doc bson.D) (err error) {
+	bData, err := bsonMarshall(doc)
+	if err != nil {
+		return err
+	}
+	err = bson.Unmarshal(bData, u)
+	return err
+}
+
+// match compares an input bson doc and returns whether every field it sets matches the auditModel
+func (u *auditModel) match(doc interface{}) bool {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return false
+	}
+	um := auditModel{}
+	err = bson.Unmarshal(data, &um)
+	if um.Id.Hex() != "" && um.Id.Hex() != "000000000000000000000000" {
+		return u.Id == um.Id
+	}
+	if !um.ActorId.IsZero() && u.ActorId != um.ActorId {
+		return false
+	}
+	if !um.GroupId.IsZero() && u.GroupId != um.GroupId {
+		return false
+	}
+	if um.Action != "" && u.Action != um.Action {
+		return false
+	}
+	if um.TargetType != "" && u.TargetType != um.TargetType {
+		return false
+	}
+	if um.TargetId != "" && u.TargetId != um.TargetId {
+		return false
+	}
+	return true
+}
+
+// getID returns the unique identifier of the auditModel
+func (u *auditModel) getID() (id interface{}) {
+	return u.Id
+}
+
+// addTimeStamps sets the time an auditModel was recorded
+func (u *auditModel) addTimeStamps(newRecord bool) {
+	if newRecord {
+		u.CreatedAt = time.Now().UTC()
+	}
+}
+
+// addObjectID checks if an auditModel has a value assigned for Id if no value a new one is generated and assigned
+func (u *auditModel) addObjectID() {
+	if u.Id.Hex() == "" || u.Id.Hex() == "000000000000000000000000" {
+		u.Id = primitive.NewObjectID()
+	}
+}
+
+// postProcess updates an auditModel struct after it is read from or written to the db
+func (u *auditModel) postProcess() (err error) {
+	return
+}
+
+// toDoc converts the bson auditModel into a bson.D
+func (u *auditModel) toDoc() (doc bson.D, err error) {
+	data, err := bson.Marshal(u)
+	if err != nil {
+		return
+	}
+	err = bson.Unmarshal(data, &doc)
+	return
+}
+
+// bsonFilter generates a bson filter for MongoDB queries from the auditModel data
+func (u *auditModel) bsonFilter() (doc bson.D, err error) {
+	if u.Id.Hex() != "" && u.Id.Hex() != "000000000000000000000000" {
+		return bson.D{{"_id", u.Id}}, nil
+	}
+	doc = bson.D{}
+	if !u.ActorId.IsZero() {
+		doc = append(doc, bson.E{Key: "actor_id", Value: u.ActorId})
+	}
+	if !u.GroupId.IsZero() {
+		doc = append(doc, bson.E{Key: "group_id", Value: u.GroupId})
+	}
+	if u.Action != "" {
+		doc = append(doc, bson.E{Key: "action", Value: u.Action})
+	}
+	if u.TargetType != "" {
+		doc = append(doc, bson.E{Key: "target_type", Value: u.TargetType})
+	}
+	if u.TargetId != "" {
+		doc = append(doc, bson.E{Key: "target_id", Value: u.TargetId})
+	}
+	createdAt := bson.D{}
+	if !u.since.IsZero() {
+		createdAt = append(createdAt, bson.E{Key: "$gte", Value: u.since})
+	}
+	if !u.until.IsZero() {
+		createdAt = append(createdAt, bson.E{Key: "$lte", Value: u.until})
+	}
+	if len(createdAt) > 0 {
+		doc = append(doc, bson.E{Key: "created_at", Value: createdAt})
+	}
+	return
+}
+
+// bsonUpdate is unsupported because audit events are append-only
+func (u *auditModel) bsonUpdate() (doc bson.D, err error) {
+	return nil, errAuditAppendOnly
+}
+
+// toRoot creates and return a new pointer to an AuditEvent JSON struct from a pointer to a BSON auditModel
+func (u *auditModel) toRoot() *models.AuditEvent {
+	e := &models.AuditEvent{
+		Id:         u.Id.Hex(),
+		Action:     u.Action,
+		TargetType: u.TargetType,
+		TargetId:   u.TargetId,
+		Metadata:   u.Metadata,
+		ClientIP:   u.ClientIP,
+		RequestId:  u.RequestId,
+		CreatedAt:  u.CreatedAt,
+	}
+	if !u.ActorId.IsZero() {
+		e.ActorId = u.ActorId.Hex()
+	}
+	if !u.ActorGroupId.IsZero() {
+		e.ActorGroupId = u.ActorGroupId.Hex()
+	}
+	if !u.GroupId.IsZero() {
+		e.GroupId = u.GroupId.Hex()
+	}
+	for _, c := range u.Changes {
+		e.Changes = append(e.Changes, &models.AuditChange{Field: c.Field, Before: c.Before, After: c.After})
+	}
+	return e
+}
+
+func rootAudits(ms []*auditModel) (events []*models.AuditEvent) {
+	for _, m := range ms {
+		events = append(events, m.toRoot())
+	}
+	return
+}
@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"go.mongodb.org/mongo-driver/bson"
+	"time"
+)
+
+// errAuditAppendOnly is returned when an audit event modification is attempted
+var errAuditAppendOnly = utilities.FailedPrecondition("AUDIT_APPEND_ONLY", "audit events are append-only")
+
+// AuditService is used by the app to manage the append-only audit_events collection
+type AuditService struct {
+	collection   DBCollection
+	db           DBClient
+	auditHandler *DBHandler[*auditModel]
+}
+
+// NewAuditService is an exported function used to initialize a new AuditService struct
+func NewAuditService(db DBClient, aHandler *DBHandler[*auditModel]) *AuditService {
+	collection := db.GetCollection("audit_events")
+	return &AuditService{collection, db, aHandler}
+}
+
+// AuditCreate is used to append a new AuditEvent
+func (p *AuditService) AuditCreate(ctx context.Context, e *models.AuditEvent) (*models.AuditEvent, error) {
+	if e.Action == "" {
+		return nil, utilities.MissingFields("audit event", []string{"action"})
+	}
+	am, err := newAuditModel(e)
+	if err != nil {
+		return nil, err
+	}
+	am, err = p.auditHandler.InsertOne(ctx, am)
+	if err != nil {
+		return nil, err
+	}
+	return am.toRoot(), nil
+}
+
+// AuditsQuery is used for a paginated audit event search sorted newest first
+func (p *AuditService) AuditsQuery(ctx context.Context, f *models.AuditFilter, pagination *utilities.Pagination) (*models.AuditsRes, error) {
+	am, err := newAuditFilterModel(f)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := am.bsonFilter()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	count, err := p.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return &models.AuditsRes{
+			TotalCount: 0,
+			TotalPages: 0,
+			Page:       0,
+			Size:       0,
+			HasMore:    false,
+			Events:     make([]*models.AuditEvent, 0),
+		}, nil
+	}
+	ams, err := p.auditHandler.SortedFind(ctx, filter, bson.D{{Key: "created_at", Value: -1}},
+		int64(pagination.GetLimit()), int64(pagination.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	return &models.AuditsRes{
+		TotalCount: count,
+		TotalPages: int64(pagination.GetTotalPages(int(count))),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(int(count)),
+		Events:     rootAudits(ams),
+	}, nil
+}
+
+// AuditPurge deletes the audit events recorded before the retention cutoff and returns how many were removed
+func (p *AuditService) AuditPurge(ctx context.Context, before time.Time) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	res, err := p.collection.DeleteMany(ctx, bson.D{{Key: "created_at", Value: bson.D{{Key: "$lt", Value: before}}}})
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"testing"
+)
+
+func Test_AuditCreate(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string             // The name of the test
+		wantErr bool               // whether we want an error.
+		event   *models.AuditEvent // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"success",
+			false,
+			&models.AuditEvent{
+				ActorId:    "000000000000000000000011",
+				Action:     models.AuditPasswordUpdate,
+				TargetType: "user",
+				TargetId:   "000000000000000000000011",
+				GroupId:    "000000000000000000000001",
+				RequestId:  "test-request",
+			},
+		},
+		{
+			"missing action",
+			true,
+			&models.AuditEvent{
+				ActorId:  "000000000000000000000011",
+				TargetId: "000000000000000000000011",
+			},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := initTestAuditService()
+			got, err := testService.AuditCreate(context.Background(), tt.event)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AuditService.AuditCreate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && (got.Id == "" || got.CreatedAt.IsZero() || got.Action != tt.event.Action || got.RequestId != tt.event.RequestId) {
+				t.Errorf("AuditService.AuditCreate() = %v, want %v", got, tt.event)
+			}
+		})
+	}
+}
+
+func Test_AuditsQuery(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string              // The name of the test
+		want    int64               // What out instance we want our function to return.
+		wantErr bool                // whether we want an error.
+		filter  *models.AuditFilter // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"all events",
+			2,
+			false,
+			&models.AuditFilter{},
+		},
+		{
+			"group scoped",
+			1,
+			false,
+			&models.AuditFilter{GroupId: "000000000000000000000002"},
+		},
+		{
+			"actor and action",
+			1,
+			false,
+			&models.AuditFilter{ActorId: "000000000000000000000011", Action: models.AuditLogin},
+		},
+		{
+			"no match",
+			0,
+			false,
+			&models.AuditFilter{Action: models.AuditGroupDelete},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestAudits()
+			got, err := testService.AuditsQuery(context.Background(), tt.filter, utilities.NewPaginationQuery(10, 1))
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AuditService.AuditsQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got.TotalCount != tt.want || int64(len(got.Events)) != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("AuditService.AuditsQuery() = %v, want %v", got.TotalCount, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package database
 
+import "context"
+
 // BlacklistService is used by the app to manage all group related controllers and functionality
 type BlacklistService struct {
 	collection DBCollection
@@ -14,8 +16,8 @@ func NewBlacklistService(db DBClient, handler *DBHandler[*blacklistModel]) *Blac
 }
 
 // BlacklistAuthToken is used during sign-out to add the now invalid auth-token/api key to the blacklist collection
-func (a *BlacklistService) BlacklistAuthToken(authToken string) error {
-	_, err := a.handler.InsertOne(&blacklistModel{AuthToken: authToken})
+func (a *BlacklistService) BlacklistAuthToken(ctx context.Context, authToken string) error {
+	_, err := a.handler.InsertOne(ctx, &blacklistModel{AuthToken: authToken})
 	if err != nil {
 		return err
 	}
@@ -23,8 +25,8 @@ func (a *BlacklistService) BlacklistAuthToken(authToken string) error {
 }
 
 // CheckTokenBlacklist to determine if the submitted Auth-Token or API-Key with what's in the blacklist collection
-func (a *BlacklistService) CheckTokenBlacklist(authToken string) bool {
-	_, err := a.handler.FindOne(&blacklistModel{AuthToken: authToken})
+func (a *BlacklistService) CheckTokenBlacklist(ctx context.Context, authToken string) bool {
+	_, err := a.handler.FindOne(ctx, &blacklistModel{AuthToken: authToken})
 	if err != nil {
 		return false
 	}
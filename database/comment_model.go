@@ -0,0 +1,156 @@
+package database
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// commentModel structures a task comment BSON document to save in the comments collection
+type commentModel struct {
+	Id           primitive.ObjectID `bson:"_id,omitempty"`
+	TaskId       primitive.ObjectID `bson:"task_id,omitempty"`
+	UserId       primitive.ObjectID `bson:"user_id,omitempty"`
+	Body         string             `bson:"body,omitempty"`
+	LastModified time.Time          `bson:"last_modified,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at,omitempty"`
+}
+
+// newCommentModel initializes a new pointer to a commentModel struct from a pointer to a JSON Comment struct
+func newCommentModel(u *models.Comment) (um *commentModel, err error) {
+	um = &commentModel{
+		Body:         u.Body,
+		LastModified: u.LastModified,
+		CreatedAt:    u.CreatedAt,
+	}
+	if u.Id != "" && u.Id != "000000000000000000000000" {
+		um.Id, err = primitive.ObjectIDFromHex(u.Id)
+	}
+	if u.TaskId != "" && u.TaskId != "000000000000000000000000" {
+		um.TaskId, err = primitive.ObjectIDFromHex(u.TaskId)
+	}
+	if u.UserId != "" && u.UserId != "000000000000000000000000" {
+		um.UserId, err = primitive.ObjectIDFromHex(u.UserId)
+	}
+	return
+}
+
+// update the commentModel using an overwrite bson.D doc
+func (u *commentModel) update(doc interface{}) (err error) {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return
+	}
+	um := commentModel{}
+	err = bson.Unmarshal(data, &um)
+	if len(um.Body) > 0 {
+		u.Body = um.Body
+	}
+	if !um.LastModified.IsZero() {
+		u.LastModified = um.LastModified
+	}
+	return
+}
+
+// bsonLoad loads a bson doc into the commentModel
+func (u *commentModel) bsonLoad(doc bson.D) (err error) {
+	bData, err := bsonMarshall(doc)
+	if err != nil {
+		return err
+	}
+	err = bson.Unmarshal(bData, u)
+	return err
+}
+
+// match compares an input bson doc and returns whether there's a match with the commentModel
+func (u *commentModel) match(doc interface{}) bool {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return false
+	}
+	um := commentModel{}
+	err = bson.Unmarshal(data, &um)
+	if !um.Id.IsZero() {
+		return u.Id == um.Id
+	}
+	if !um.TaskId.IsZero() {
+		return u.TaskId == um.TaskId
+	}
+	return false
+}
+
+// getID returns the unique identifier of the commentModel
+func (u *commentModel) getID() (id interface{}) {
+	return u.Id
+}
+
+// addTimeStamps updates a commentModel struct with a timestamp
+func (u *commentModel) addTimeStamps(newRecord bool) {
+	currentTime := time.Now().UTC()
+	u.LastModified = currentTime
+	if newRecord {
+		u.CreatedAt = currentTime
+	}
+}
+
+// addObjectID checks if a commentModel has a value assigned for Id if no value a new one is generated and assigned
+func (u *commentModel) addObjectID() {
+	if u.Id.Hex() == "" || u.Id.Hex() == "000000000000000000000000" {
+		u.Id = primitive.NewObjectID()
+	}
+}
+
+// postProcess updates a commentModel struct after it is read from or written to the db
+func (u *commentModel) postProcess() (err error) {
+	return
+}
+
+// toDoc converts the bson commentModel into a bson.D
+func (u *commentModel) toDoc() (doc bson.D, err error) {
+	data, err := bson.Marshal(u)
+	if err != nil {
+		return
+	}
+	err = bson.Unmarshal(data, &doc)
+	return
+}
+
+// bsonFilter generates a bson filter for MongoDB queries from the commentModel data
+func (u *commentModel) bsonFilter() (doc bson.D, err error) {
+	if !u.Id.IsZero() {
+		doc = bson.D{{Key: "_id", Value: u.Id}}
+	} else if !u.TaskId.IsZero() {
+		doc = bson.D{{Key: "task_id", Value: u.TaskId}}
+	}
+	return
+}
+
+// bsonUpdate generates a bson update for MongoDB queries from the commentModel data
+func (u *commentModel) bsonUpdate() (doc bson.D, err error) {
+	inner, err := u.toDoc()
+	if err != nil {
+		return
+	}
+	doc = bson.D{{Key: "$set", Value: inner}}
+	return
+}
+
+// toRoot creates and return a new pointer to a Comment JSON struct from a pointer to a BSON commentModel
+func (u *commentModel) toRoot() *models.Comment {
+	return &models.Comment{
+		Id:           u.Id.Hex(),
+		TaskId:       u.TaskId.Hex(),
+		UserId:       u.UserId.Hex(),
+		Body:         u.Body,
+		LastModified: u.LastModified,
+		CreatedAt:    u.CreatedAt,
+	}
+}
+
+func rootComments(ms []*commentModel) (comments []*models.Comment) {
+	for _, m := range ms {
+		comments = append(comments, m.toRoot())
+	}
+	return
+}
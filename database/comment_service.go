@@ -0,0 +1,232 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"go.mongodb.org/mongo-driver/bson"
+	"time"
+)
+
+// CommentService is used by the app to manage the comments and the activity timeline of tasks
+type CommentService struct {
+	collection      DBCollection
+	db              DBClient
+	commentHandler  *DBHandler[*commentModel]
+	activityHandler *DBHandler[*activityModel]
+}
+
+// NewCommentService is an exported function used to initialize a new CommentService struct
+func NewCommentService(db DBClient, cHandler *DBHandler[*commentModel], aHandler *DBHandler[*activityModel]) *CommentService {
+	collection := db.GetCollection("comments")
+	return &CommentService{collection, db, cHandler, aHandler}
+}
+
+// CommentCreate is used to add a new Comment to a task
+func (p *CommentService) CommentCreate(ctx context.Context, g *models.Comment) (*models.Comment, error) {
+	err := g.Validate("create")
+	if err != nil {
+		return nil, err
+	}
+	g.Id = ""
+	cm, err := newCommentModel(g)
+	if err != nil {
+		return nil, err
+	}
+	cm, err = p.commentHandler.InsertOne(ctx, cm)
+	if err != nil {
+		return nil, err
+	}
+	return cm.toRoot(), nil
+}
+
+// CommentFind is used to find a specific Comment doc by its id
+func (p *CommentService) CommentFind(ctx context.Context, g *models.Comment) (*models.Comment, error) {
+	if !g.CheckID("id") {
+		return nil, utilities.MissingFields("comment", []string{"id"})
+	}
+	cm, err := newCommentModel(&models.Comment{Id: g.Id})
+	if err != nil {
+		return nil, err
+	}
+	cm, err = p.commentHandler.FindOne(ctx, cm)
+	if err != nil {
+		return nil, lookupErr(err, "comment", g.Id)
+	}
+	return cm.toRoot(), nil
+}
+
+// CommentUpdate is used to edit the Body of a Comment
+func (p *CommentService) CommentUpdate(ctx context.Context, g *models.Comment) (*models.Comment, error) {
+	err := g.Validate("update")
+	if err != nil {
+		return nil, err
+	}
+	f, err := newCommentModel(&models.Comment{Id: g.Id})
+	if err != nil {
+		return nil, err
+	}
+	cur, err := p.commentHandler.FindOne(ctx, f)
+	if err != nil {
+		return nil, lookupErr(err, "comment", g.Id)
+	}
+	cur.Body = g.Body
+	cm, err := p.commentHandler.UpdateOne(ctx, f, cur)
+	if err != nil {
+		return nil, err
+	}
+	return cm.toRoot(), nil
+}
+
+// CommentDelete is used to delete a Comment doc
+func (p *CommentService) CommentDelete(ctx context.Context, g *models.Comment) (*models.Comment, error) {
+	cm, err := newCommentModel(&models.Comment{Id: g.Id})
+	if err != nil {
+		return nil, err
+	}
+	if cm.Id.IsZero() {
+		return nil, utilities.MissingFields("comment", []string{"id"})
+	}
+	cm, err = p.commentHandler.DeleteOne(ctx, cm)
+	if err != nil {
+		return nil, lookupErr(err, "comment", g.Id)
+	}
+	return cm.toRoot(), nil
+}
+
+// CommentDeleteMany is used to delete every Comment of a task
+func (p *CommentService) CommentDeleteMany(ctx context.Context, g *models.Comment) (*models.Comment, error) {
+	if !g.CheckID("task_id") {
+		return nil, utilities.InvalidArgument("filter id cannot be empty for mass delete")
+	}
+	cm, err := newCommentModel(&models.Comment{TaskId: g.TaskId})
+	if err != nil {
+		return nil, err
+	}
+	cm, err = p.commentHandler.DeleteMany(ctx, cm)
+	if err != nil {
+		return nil, err
+	}
+	return cm.toRoot(), nil
+}
+
+// CommentsQuery is used for a paginated search of the Comments of a task sorted oldest first
+func (p *CommentService) CommentsQuery(ctx context.Context, g *models.Comment, pagination *utilities.Pagination) (*models.CommentsRes, error) {
+	if !g.CheckID("task_id") {
+		return nil, utilities.MissingFields("comment", []string{"task_id"})
+	}
+	cm, err := newCommentModel(&models.Comment{TaskId: g.TaskId})
+	if err != nil {
+		return nil, err
+	}
+	f, err := cm.bsonFilter()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	count, err := p.collection.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return &models.CommentsRes{
+			TotalCount: 0,
+			TotalPages: 0,
+			Page:       0,
+			Size:       0,
+			HasMore:    false,
+			Comments:   make([]*models.Comment, 0),
+		}, nil
+	}
+	cms, err := p.commentHandler.SortedFind(ctx, f, bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+		int64(pagination.GetLimit()), int64(pagination.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	return &models.CommentsRes{
+		TotalCount: count,
+		TotalPages: int64(pagination.GetTotalPages(int(count))),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(int(count)),
+		Comments:   rootComments(cms),
+	}, nil
+}
+
+// ActivityCreate is used to append an Activity to the timeline of a task
+func (p *CommentService) ActivityCreate(ctx context.Context, a *models.Activity) (*models.Activity, error) {
+	if !utilities.CheckObjectID(a.TaskId) || a.Type == "" {
+		return nil, utilities.MissingFields("activity", []string{"task_id", "type"})
+	}
+	a.Id = ""
+	am, err := newActivityModel(a)
+	if err != nil {
+		return nil, err
+	}
+	am, err = p.activityHandler.InsertOne(ctx, am)
+	if err != nil {
+		return nil, err
+	}
+	return am.toRoot(), nil
+}
+
+// ActivityDeleteMany is used to delete the timeline of a task
+func (p *CommentService) ActivityDeleteMany(ctx context.Context, a *models.Activity) (*models.Activity, error) {
+	if !utilities.CheckObjectID(a.TaskId) {
+		return nil, utilities.InvalidArgument("filter id cannot be empty for mass delete")
+	}
+	am, err := newActivityModel(&models.Activity{TaskId: a.TaskId})
+	if err != nil {
+		return nil, err
+	}
+	am, err = p.activityHandler.DeleteMany(ctx, am)
+	if err != nil {
+		return nil, err
+	}
+	return am.toRoot(), nil
+}
+
+// ActivitiesQuery is used for a paginated search of the timeline of a task sorted oldest first
+func (p *CommentService) ActivitiesQuery(ctx context.Context, a *models.Activity, pagination *utilities.Pagination) (*models.ActivitiesRes, error) {
+	if !utilities.CheckObjectID(a.TaskId) {
+		return nil, utilities.MissingFields("activity", []string{"task_id"})
+	}
+	am, err := newActivityModel(&models.Activity{TaskId: a.TaskId})
+	if err != nil {
+		return nil, err
+	}
+	f, err := am.bsonFilter()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	count, err := p.db.GetCollection("task_activities").CountDocuments(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return &models.ActivitiesRes{
+			TotalCount: 0,
+			TotalPages: 0,
+			Page:       0,
+			Size:       0,
+			HasMore:    false,
+			Activities: make([]*models.Activity, 0),
+		}, nil
+	}
+	ams, err := p.activityHandler.SortedFind(ctx, f, bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}},
+		int64(pagination.GetLimit()), int64(pagination.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	return &models.ActivitiesRes{
+		TotalCount: count,
+		TotalPages: int64(pagination.GetTotalPages(int(count))),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(int(count)),
+		Activities: rootActivities(ams),
+	}, nil
+}
@@ -0,0 +1,152 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc/codes"
+	"strings"
+	"testing"
+)
+
+func Test_CommentCreate(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string          // The name of the test
+		code    codes.Code      // The status code of the error we want
+		comment *models.Comment // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"success",
+			codes.OK,
+			&models.Comment{TaskId: "000000000000000000000021", UserId: "000000000000000000000014", Body: "third comment"},
+		},
+		{
+			"missing body",
+			codes.InvalidArgument,
+			&models.Comment{TaskId: "000000000000000000000021", UserId: "000000000000000000000014"},
+		},
+		{
+			"body too long",
+			codes.InvalidArgument,
+			&models.Comment{TaskId: "000000000000000000000021", UserId: "000000000000000000000014", Body: strings.Repeat("a", models.MaxCommentLength+1)},
+		},
+		{
+			"missing task",
+			codes.InvalidArgument,
+			&models.Comment{UserId: "000000000000000000000014", Body: "third comment"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestComments()
+			got, err := testService.CommentCreate(context.Background(), tt.comment)
+			// Checking the error
+			if errCode(err) != tt.code {
+				t.Errorf("CommentService.CommentCreate() error = %v, want %v", err, tt.code)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.Id == "" || got.Body != tt.comment.Body || got.CreatedAt.IsZero() {
+				t.Errorf("CommentService.CommentCreate() = %v, want a created comment", got)
+			}
+		})
+	}
+}
+
+func Test_CommentUpdate(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string          // The name of the test
+		code    codes.Code      // The status code of the error we want
+		comment *models.Comment // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"success", codes.OK, &models.Comment{Id: "000000000000000000000071", Body: "edited comment"}},
+		{"missing body", codes.InvalidArgument, &models.Comment{Id: "000000000000000000000071"}},
+		{"not found", codes.NotFound, &models.Comment{Id: "000000000000000000000079", Body: "edited comment"}},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestComments()
+			got, err := testService.CommentUpdate(context.Background(), tt.comment)
+			// Checking the error
+			if errCode(err) != tt.code {
+				t.Errorf("CommentService.CommentUpdate() error = %v, want %v", err, tt.code)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.Body != tt.comment.Body || got.TaskId != "000000000000000000000021" || got.UserId != "000000000000000000000014" {
+				t.Errorf("CommentService.CommentUpdate() = %v, want only the body edited", got)
+			}
+		})
+	}
+}
+
+func Test_CommentDeleteMany(t *testing.T) {
+	testService := setupTestComments()
+	_, err := testService.CommentDeleteMany(context.Background(), &models.Comment{})
+	if errCode(err) != codes.InvalidArgument {
+		t.Errorf("CommentService.CommentDeleteMany() error = %v, want %v", err, codes.InvalidArgument)
+	}
+	_, err = testService.ActivityDeleteMany(context.Background(), &models.Activity{})
+	if errCode(err) != codes.InvalidArgument {
+		t.Errorf("CommentService.ActivityDeleteMany() error = %v, want %v", err, codes.InvalidArgument)
+	}
+}
+
+func Test_CommentsQuery(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string   // The name of the test
+		task string   // The id of the task whose comments are listed
+		want []string // The ids of the comments we want, in order
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"oldest first", "000000000000000000000021", []string{"000000000000000000000071", "000000000000000000000072"}},
+		{"no comments", "000000000000000000000022", []string{}},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestComments()
+			got, err := testService.CommentsQuery(context.Background(), &models.Comment{TaskId: tt.task}, utilities.NewPaginationQuery(10, 1))
+			if err != nil {
+				t.Fatalf("CommentService.CommentsQuery() error = %v", err)
+			}
+			if got.TotalCount != int64(len(tt.want)) || len(got.Comments) != len(tt.want) {
+				t.Fatalf("CommentService.CommentsQuery() = %v, want %v", got.Comments, tt.want)
+			}
+			for i, c := range got.Comments {
+				if c.Id != tt.want[i] {
+					t.Errorf("CommentService.CommentsQuery()[%d] = %v, want %v", i, c.Id, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_ActivitiesQuery(t *testing.T) {
+	testService := setupTestComments()
+	before := &models.Task{Id: "000000000000000000000021", Status: models.NOT_STARTED, UserId: "000000000000000000000014"}
+	after := &models.Task{Id: before.Id, Status: models.IN_PROGRESS, UserId: "000000000000000000000012"}
+	for _, a := range models.NewTaskActivities(before, after, "000000000000000000000014") {
+		if _, err := testService.ActivityCreate(context.Background(), a); err != nil {
+			t.Fatalf("CommentService.ActivityCreate() error = %v", err)
+		}
+	}
+	got, err := testService.ActivitiesQuery(context.Background(), &models.Activity{TaskId: before.Id}, utilities.NewPaginationQuery(10, 1))
+	if err != nil {
+		t.Fatalf("CommentService.ActivitiesQuery() error = %v", err)
+	}
+	if got.TotalCount != 2 || got.Activities[0].Type != models.ActivityStatusChanged || got.Activities[1].Type != models.ActivityReassigned {
+		t.Fatalf("CommentService.ActivitiesQuery() = %v, want a status change then a reassignment", got.Activities)
+	}
+}
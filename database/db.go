@@ -2,12 +2,15 @@ package database
 
 import (
 	"context"
+	"errors"
+	"github.com/JECSand/go-grpc-server-boilerplate/config"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/gridfs"
 	"go.mongodb.org/mongo-driver/mongo/options"
-	"os"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
 	"sync"
 	"time"
 )
@@ -38,6 +41,52 @@ type DBClient interface {
 	NewBlacklistHandler() *DBHandler[*blacklistModel]
 	NewTaskHandler() *DBHandler[*taskModel]
 	NewFileHandler() *DBHandler[*fileModel]
+	NewAuditHandler() *DBHandler[*auditModel]
+	NewOutboxHandler() *DBHandler[*outboxModel]
+	NewWebhookHandler() *DBHandler[*webhookModel]
+	NewDeliveryHandler() *DBHandler[*deliveryModel]
+	NewMembershipHandler() *DBHandler[*membershipModel]
+	NewInviteHandler() *DBHandler[*inviteModel]
+	NewCommentHandler() *DBHandler[*commentModel]
+	NewActivityHandler() *DBHandler[*activityModel]
+	NewMigrationHandler() *DBHandler[*migrationModel]
+	CreateIndexes(ctx context.Context, collectionName string, indexes []mongo.IndexModel) error
+	DropIndexes(ctx context.Context, collectionName string, names []string) error
+	SetChangeStreamPreImages(ctx context.Context, collectionName string, enabled bool) error
+	Watch(ctx context.Context, collectionName string, pipeline interface{}, resumeToken bson.Raw) (DBChangeStream, error)
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// txnContextKey marks a context that belongs to an open unit of work
+type txnContextKey struct{}
+
+// unitOfWork holds the steps to run once an open unit of work commits
+type unitOfWork struct {
+	afterCommit []func(ctx context.Context) error
+}
+
+// committed runs the afterCommit steps of a unit of work that committed; a step that fails does not stop the others,
+// as the unit of work can no longer be rolled back
+func (u *unitOfWork) committed(ctx context.Context) {
+	for _, fn := range u.afterCommit {
+		_ = fn(ctx)
+	}
+}
+
+// inTransaction returns whether ctx belongs to an open unit of work, whose session must not be shared across goroutines
+func inTransaction(ctx context.Context) bool {
+	_, ok := ctx.Value(txnContextKey{}).(*unitOfWork)
+	return ok
+}
+
+// afterCommit runs fn once the unit of work of ctx commits, or right away when ctx does not belong to one; it is used
+// for changes outside the transaction, such as GridFS blob deletes, which an aborted unit of work must not apply
+func afterCommit(ctx context.Context, fn func(ctx context.Context) error) error {
+	if u, ok := ctx.Value(txnContextKey{}).(*unitOfWork); ok {
+		u.afterCommit = append(u.afterCommit, fn)
+		return nil
+	}
+	return fn(ctx)
 }
 
 // DBCursor is an abstraction of the dbClient and testDBClient types
@@ -48,14 +97,23 @@ type DBCursor interface {
 	Err() error
 }
 
-// checkCursorENV returns a DBCursor based on the ENV
-func checkCursorENV(cur *mongo.Cursor) DBCursor {
-	if os.Getenv("ENV") == "test" {
+// checkCursor returns a DBCursor based on the type of the collection the cursor was opened on
+func checkCursor(coll DBCollection, cur *mongo.Cursor) DBCursor {
+	if _, ok := coll.(*testMongoCollection); ok {
 		return newTestMongoCursor(cur)
 	}
 	return cur
 }
 
+// DBChangeStream is an abstraction of the mongo.ChangeStream and testChangeStream types
+type DBChangeStream interface {
+	Next(ctx context.Context) bool
+	Decode(val interface{}) error
+	ResumeToken() bson.Raw
+	Close(ctx context.Context) error
+	Err() error
+}
+
 // DBCollection is an abstraction of the dbClient and testDBClient types
 type DBCollection interface {
 	InsertOne(ctx context.Context, document interface{}, opts ...*options.InsertOneOptions) (*mongo.InsertOneResult, error)
@@ -73,20 +131,22 @@ type DBCollection interface {
 // DBClient manages a database connection
 type dbClient struct {
 	connectionURI string
+	database      string
 	client        *mongo.Client
 }
 
-// InitializeNewClient returns an initialized DBClient based on the ENV
-func InitializeNewClient() (DBClient, error) {
-	if os.Getenv("ENV") == "test" {
-		return initializeNewTestClient()
+// InitializeNewClient returns an initialized DBClient for the MongoDB settings of the Configuration, or an in-memory
+// test client when its ENV is test
+func InitializeNewClient(cfg *config.Configuration) (DBClient, error) {
+	if cfg.ENV == "test" {
+		return initializeNewTestClient(cfg.MongoDB.URI)
 	}
-	return initializeNewClient()
+	return initializeNewClient(cfg.MongoDB)
 }
 
-// InitializeNewClient is a function that takes a mongoUri string and outputs a connected mongo client for the app to use
-func initializeNewClient() (*dbClient, error) {
-	newDBClient := dbClient{connectionURI: os.Getenv("MONGO_URI")}
+// InitializeNewClient is a function that takes the MongoDB settings and outputs a mongo client for the app to use
+func initializeNewClient(cfg config.MongoDBConfig) (*dbClient, error) {
+	newDBClient := dbClient{connectionURI: cfg.URI, database: cfg.DB}
 	var err error
 	newDBClient.client, err = mongo.NewClient(options.Client().ApplyURI(newDBClient.connectionURI))
 	return &newDBClient, err
@@ -110,7 +170,7 @@ func (db *dbClient) Close() error {
 func (db *dbClient) GetBucket(bucketName string) (*gridfs.Bucket, error) {
 	bucketOpts := options.GridFSBucket()
 	bucketOpts.SetName(bucketName)
-	bucket, err := gridfs.NewBucket(db.client.Database(os.Getenv("DATABASE")), bucketOpts)
+	bucket, err := gridfs.NewBucket(db.client.Database(db.database), bucketOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -119,15 +179,91 @@ func (db *dbClient) GetBucket(bucketName string) (*gridfs.Bucket, error) {
 
 // GetCollection returns a mongo collection based on the input collection name
 func (db *dbClient) GetCollection(collectionName string) DBCollection {
-	return db.client.Database(os.Getenv("DATABASE")).Collection(collectionName)
+	return db.client.Database(db.database).Collection(collectionName)
+}
+
+// Watch opens a change stream on a collection that resumes after resumeToken when one is given
+func (db *dbClient) Watch(ctx context.Context, collectionName string, pipeline interface{}, resumeToken bson.Raw) (DBChangeStream, error) {
+	opts := options.ChangeStream().
+		SetFullDocument(options.UpdateLookup).
+		SetFullDocumentBeforeChange(options.WhenAvailable)
+	if len(resumeToken) > 0 {
+		opts.SetResumeAfter(resumeToken)
+	}
+	return db.client.Database(db.database).Collection(collectionName).Watch(ctx, pipeline, opts)
+}
+
+// CreateIndexes creates the input indexes on a collection, leaving indexes that already exist unchanged
+func (db *dbClient) CreateIndexes(ctx context.Context, collectionName string, indexes []mongo.IndexModel) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	_, err := db.client.Database(db.database).Collection(collectionName).Indexes().CreateMany(ctx, indexes)
+	return err
+}
+
+// DropIndexes drops the named indexes from a collection, ignoring indexes or collections that do not exist
+func (db *dbClient) DropIndexes(ctx context.Context, collectionName string, names []string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	view := db.client.Database(db.database).Collection(collectionName).Indexes()
+	for _, name := range names {
+		_, err := view.DropOne(ctx, name)
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && (cmdErr.Name == "IndexNotFound" || cmdErr.Name == "NamespaceNotFound") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetChangeStreamPreImages turns the change stream pre- and post-images of a collection on or off; change streams only
+// carry the fullDocumentBeforeChange of updates and deletes while they are on
+func (db *dbClient) SetChangeStreamPreImages(ctx context.Context, collectionName string, enabled bool) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	cmd := bson.D{
+		{Key: "collMod", Value: collectionName},
+		{Key: "changeStreamPreAndPostImages", Value: bson.D{{Key: "enabled", Value: enabled}}},
+	}
+	return db.client.Database(db.database).RunCommand(ctx, cmd).Err()
+}
+
+// WithTransaction runs fn as a unit of work in a MongoDB transaction that commits when fn returns nil and aborts otherwise;
+// fn may be retried on transient transaction errors and joins the outer unit of work when ctx already belongs to one.
+// The afterCommit steps of the last attempt run once the transaction commits
+func (db *dbClient) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if inTransaction(ctx) {
+		return fn(ctx)
+	}
+	ctx, span := utilities.StartSpan(ctx, "DBClient.WithTransaction")
+	defer func() { utilities.EndSpan(span, err) }()
+	session, err := db.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+	var uow *unitOfWork
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		uow = &unitOfWork{}
+		return nil, fn(context.WithValue(sc, txnContextKey{}, uow))
+	})
+	if err != nil {
+		return err
+	}
+	uow.committed(ctx)
+	return nil
 }
 
 // NewDBHandler returns a new DBHandler generic interface
 func (db *dbClient) NewDBHandler(collectionName string) *DBHandler[dbModel] {
 	col := db.GetCollection(collectionName)
 	return &DBHandler[dbModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: collectionName,
 	}
 }
 
@@ -135,8 +271,9 @@ func (db *dbClient) NewDBHandler(collectionName string) *DBHandler[dbModel] {
 func (db *dbClient) NewUserHandler() *DBHandler[*userModel] {
 	col := db.GetCollection("users")
 	return &DBHandler[*userModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "users",
 	}
 }
 
@@ -144,8 +281,9 @@ func (db *dbClient) NewUserHandler() *DBHandler[*userModel] {
 func (db *dbClient) NewGroupHandler() *DBHandler[*groupModel] {
 	col := db.GetCollection("groups")
 	return &DBHandler[*groupModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "groups",
 	}
 }
 
@@ -153,8 +291,9 @@ func (db *dbClient) NewGroupHandler() *DBHandler[*groupModel] {
 func (db *dbClient) NewBlacklistHandler() *DBHandler[*blacklistModel] {
 	col := db.GetCollection("blacklists")
 	return &DBHandler[*blacklistModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "blacklists",
 	}
 }
 
@@ -162,8 +301,9 @@ func (db *dbClient) NewBlacklistHandler() *DBHandler[*blacklistModel] {
 func (db *dbClient) NewTaskHandler() *DBHandler[*taskModel] {
 	col := db.GetCollection("tasks")
 	return &DBHandler[*taskModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "tasks",
 	}
 }
 
@@ -171,25 +311,127 @@ func (db *dbClient) NewTaskHandler() *DBHandler[*taskModel] {
 func (db *dbClient) NewFileHandler() *DBHandler[*fileModel] {
 	col := db.GetCollection("files")
 	return &DBHandler[*fileModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "files",
+	}
+}
+
+// NewAuditHandler returns a new DBHandler audit_events interface
+func (db *dbClient) NewAuditHandler() *DBHandler[*auditModel] {
+	col := db.GetCollection("audit_events")
+	return &DBHandler[*auditModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "audit_events",
+	}
+}
+
+// NewOutboxHandler returns a new DBHandler outbox_events interface
+func (db *dbClient) NewOutboxHandler() *DBHandler[*outboxModel] {
+	col := db.GetCollection("outbox_events")
+	return &DBHandler[*outboxModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "outbox_events",
+	}
+}
+
+// NewWebhookHandler returns a new DBHandler webhooks interface
+func (db *dbClient) NewWebhookHandler() *DBHandler[*webhookModel] {
+	col := db.GetCollection("webhooks")
+	return &DBHandler[*webhookModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "webhooks",
+	}
+}
+
+// NewDeliveryHandler returns a new DBHandler webhook_deliveries interface
+func (db *dbClient) NewDeliveryHandler() *DBHandler[*deliveryModel] {
+	col := db.GetCollection("webhook_deliveries")
+	return &DBHandler[*deliveryModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "webhook_deliveries",
+	}
+}
+
+// NewMembershipHandler returns a new DBHandler memberships interface
+func (db *dbClient) NewMembershipHandler() *DBHandler[*membershipModel] {
+	col := db.GetCollection("memberships")
+	return &DBHandler[*membershipModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "memberships",
+	}
+}
+
+// NewInviteHandler returns a new DBHandler invites interface
+func (db *dbClient) NewInviteHandler() *DBHandler[*inviteModel] {
+	col := db.GetCollection("invites")
+	return &DBHandler[*inviteModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "invites",
+	}
+}
+
+// NewCommentHandler returns a new DBHandler comments interface
+func (db *dbClient) NewCommentHandler() *DBHandler[*commentModel] {
+	col := db.GetCollection("comments")
+	return &DBHandler[*commentModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "comments",
+	}
+}
+
+// NewActivityHandler returns a new DBHandler task_activities interface
+func (db *dbClient) NewActivityHandler() *DBHandler[*activityModel] {
+	col := db.GetCollection("task_activities")
+	return &DBHandler[*activityModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "task_activities",
+	}
+}
+
+// NewMigrationHandler returns a new DBHandler migrations interface
+func (db *dbClient) NewMigrationHandler() *DBHandler[*migrationModel] {
+	col := db.GetCollection("migrations")
+	return &DBHandler[*migrationModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "migrations",
 	}
 }
 
 // DBHandler is a Generic type struct for organizing dbModel methods
 type DBHandler[T dbModel] struct {
-	db         DBClient
-	collection DBCollection
+	db             DBClient
+	collection     DBCollection
+	collectionName string
+}
+
+// startSpan starts a tracing span for a DBHandler operation against the handler's collection
+func (h *DBHandler[T]) startSpan(ctx context.Context, operation string) (context.Context, trace.Span) {
+	return utilities.StartSpan(ctx, "DBHandler."+operation,
+		semconv.DBSystemMongoDB,
+		semconv.DBMongoDBCollectionKey.String(h.collectionName),
+		semconv.DBOperationKey.String(operation),
+	)
 }
 
 // FindOne is used to get a dbModel from the db with custom filter
-func (h *DBHandler[T]) FindOne(filter T) (T, error) {
-	var m T
+func (h *DBHandler[T]) FindOne(ctx context.Context, filter T) (m T, err error) {
+	ctx, span := h.startSpan(ctx, "FindOne")
+	defer func() { utilities.EndSpan(span, err) }()
 	f, err := filter.bsonFilter()
 	if err != nil {
 		return filter, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	err = h.collection.FindOne(ctx, f).Decode(&m)
 	if err != nil {
@@ -199,21 +441,22 @@ func (h *DBHandler[T]) FindOne(filter T) (T, error) {
 }
 
 // FindOneAsync is used to get a dbModel from the db with custom filter
-func (h *DBHandler[T]) FindOneAsync(tCh chan T, eCh chan error, filter T, wg *sync.WaitGroup) {
+func (h *DBHandler[T]) FindOneAsync(ctx context.Context, tCh chan T, eCh chan error, filter T, wg *sync.WaitGroup) {
 	defer wg.Done()
-	t, err := h.FindOne(filter)
+	t, err := h.FindOne(ctx, filter)
 	tCh <- t
 	eCh <- err
 }
 
 // FindMany is used to get a slice of dbModels from the db with custom filter
-func (h *DBHandler[T]) FindMany(filter T) ([]T, error) {
-	var m []T
+func (h *DBHandler[T]) FindMany(ctx context.Context, filter T) (m []T, err error) {
+	ctx, span := h.startSpan(ctx, "FindMany")
+	defer func() { utilities.EndSpan(span, err) }()
 	f, err := filter.bsonFilter()
 	if err != nil {
 		return m, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	var cur *mongo.Cursor
 	if len(f) > 0 {
@@ -224,7 +467,7 @@ func (h *DBHandler[T]) FindMany(filter T) ([]T, error) {
 	if err != nil {
 		return m, err
 	}
-	cursor := checkCursorENV(cur)
+	cursor := checkCursor(h.collection, cur)
 	defer cursor.Close(ctx)
 	for cursor.Next(ctx) {
 		var md T
@@ -239,8 +482,9 @@ func (h *DBHandler[T]) FindMany(filter T) ([]T, error) {
 }
 
 // PaginatedFind is used to get a slice of dbModels from the db with custom filter
-func (h *DBHandler[T]) PaginatedFind(ctx context.Context, filter T, pagination *utilities.Pagination) ([]T, error) {
-	var m []T
+func (h *DBHandler[T]) PaginatedFind(ctx context.Context, filter T, pagination *utilities.Pagination) (m []T, err error) {
+	ctx, span := h.startSpan(ctx, "PaginatedFind")
+	defer func() { utilities.EndSpan(span, err) }()
 	f, err := filter.bsonFilter()
 	if err != nil {
 		return m, err
@@ -262,7 +506,7 @@ func (h *DBHandler[T]) PaginatedFind(ctx context.Context, filter T, pagination *
 	if err != nil {
 		return m, err
 	}
-	cursor := checkCursorENV(cur)
+	cursor := checkCursor(h.collection, cur)
 	defer cursor.Close(ctx)
 	m = make([]T, 0, pagination.GetSize())
 	for cursor.Next(ctx) {
@@ -282,8 +526,43 @@ func (h *DBHandler[T]) PaginatedFind(ctx context.Context, filter T, pagination *
 	return m, nil
 }
 
+// SortedFind is used to get a sorted slice of dbModels from the db with a raw bson filter
+func (h *DBHandler[T]) SortedFind(ctx context.Context, f bson.D, sort bson.D, limit int64, skip int64) (m []T, err error) {
+	ctx, span := h.startSpan(ctx, "SortedFind")
+	defer func() { utilities.EndSpan(span, err) }()
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	opts := options.Find().SetSort(sort).SetSkip(skip)
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	cur, err := h.collection.Find(ctx, f, opts)
+	if err != nil {
+		return m, err
+	}
+	cursor := checkCursor(h.collection, cur)
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		var md T
+		if err = cursor.Decode(&md); err != nil {
+			return nil, err
+		}
+		err = md.postProcess()
+		if err != nil {
+			return m, err
+		}
+		m = append(m, md)
+	}
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // UpdateOne Function to update a dbModel from datasource with custom filter and update model
-func (h *DBHandler[T]) UpdateOne(filter T, m T) (T, error) {
+func (h *DBHandler[T]) UpdateOne(ctx context.Context, filter T, m T) (_ T, err error) {
+	ctx, span := h.startSpan(ctx, "UpdateOne")
+	defer func() { utilities.EndSpan(span, err) }()
 	f, err := filter.bsonFilter()
 	if err != nil {
 		return m, err
@@ -293,7 +572,7 @@ func (h *DBHandler[T]) UpdateOne(filter T, m T) (T, error) {
 	if err != nil {
 		return m, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	_, err = h.collection.UpdateOne(ctx, f, update)
 	if err != nil {
@@ -304,12 +583,14 @@ func (h *DBHandler[T]) UpdateOne(filter T, m T) (T, error) {
 }
 
 // InsertOne adds a new dbModel record to a collection
-func (h *DBHandler[T]) InsertOne(m T) (T, error) {
+func (h *DBHandler[T]) InsertOne(ctx context.Context, m T) (_ T, err error) {
+	ctx, span := h.startSpan(ctx, "InsertOne")
+	defer func() { utilities.EndSpan(span, err) }()
 	m.addTimeStamps(true)
 	m.addObjectID()
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
-	_, err := h.collection.InsertOne(ctx, m)
+	_, err = h.collection.InsertOne(ctx, m)
 	if err != nil {
 		return m, err
 	}
@@ -318,26 +599,28 @@ func (h *DBHandler[T]) InsertOne(m T) (T, error) {
 }
 
 // DeleteOne adds a new dbModel record to a collection
-func (h *DBHandler[T]) DeleteOne(filter T) (T, error) { //TODO: to be replaced with "soft delete"
-	var m T
+func (h *DBHandler[T]) DeleteOne(ctx context.Context, filter T) (m T, err error) { //TODO: to be replaced with "soft delete"
+	ctx, span := h.startSpan(ctx, "DeleteOne")
+	defer func() { utilities.EndSpan(span, err) }()
 	f, err := filter.bsonFilter()
 	if err != nil {
 		return m, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	err = h.collection.FindOneAndDelete(ctx, f).Decode(&m)
 	return m, err
 }
 
 // DeleteMany adds a new dbModel record to a collection
-func (h *DBHandler[T]) DeleteMany(filter T) (T, error) { //TODO: to be replaced with "soft delete"
-	var m T
+func (h *DBHandler[T]) DeleteMany(ctx context.Context, filter T) (m T, err error) { //TODO: to be replaced with "soft delete"
+	ctx, span := h.startSpan(ctx, "DeleteMany")
+	defer func() { utilities.EndSpan(span, err) }()
 	f, err := filter.bsonFilter()
 	if err != nil {
 		return m, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 	_, err = h.collection.DeleteMany(ctx, f)
 	return filter, err
@@ -347,3 +630,11 @@ func (h *DBHandler[T]) DeleteMany(filter T) (T, error) { //TODO: to be replaced
 func (h *DBHandler[T]) newRoutine() *dbRoutine[T] {
 	return &dbRoutine[T]{handler: h}
 }
+
+// lookupErr converts the error of a lookup that matched no document to a utilities.NotFound error
+func lookupErr(err error, resourceType string, name string) error {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return utilities.NotFound(resourceType, name)
+	}
+	return err
+}
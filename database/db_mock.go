@@ -12,7 +12,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"go.mongodb.org/mongo-driver/x/bsonx"
-	"os"
+	"reflect"
+	"strconv"
+	"sync"
 	"time"
 )
 
@@ -20,6 +22,9 @@ import (
 ================ testDBUtils ==================
 */
 
+// testConnectionURI is the connection uri of the in-memory test database
+const testConnectionURI = "mongodb+srv://in_mem"
+
 // cleanUpdateBSON inputs a bson type and attempts to marshall it into a slice of bytes
 func cleanUpdateBSON(bsonData interface{}) (data interface{}, err error) {
 	switch t := bsonData.(type) {
@@ -115,6 +120,86 @@ func bsonUnmarshall(colName string, bsonData interface{}) (data dbModel, err err
 		tm := taskModel{}
 		err = bson.Unmarshal(bData, &tm)
 		return &tm, nil
+	case "audit_events":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		am := auditModel{}
+		err = bson.Unmarshal(bData, &am)
+		return &am, nil
+	case "outbox_events":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		m := outboxModel{}
+		err = bson.Unmarshal(bData, &m)
+		return &m, nil
+	case "webhooks":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		m := webhookModel{}
+		err = bson.Unmarshal(bData, &m)
+		return &m, nil
+	case "webhook_deliveries":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		m := deliveryModel{}
+		err = bson.Unmarshal(bData, &m)
+		return &m, nil
+	case "memberships":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		m := membershipModel{}
+		err = bson.Unmarshal(bData, &m)
+		return &m, nil
+	case "invites":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		m := inviteModel{}
+		err = bson.Unmarshal(bData, &m)
+		return &m, nil
+	case "files":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		m := fileModel{}
+		err = bson.Unmarshal(bData, &m)
+		return &m, nil
+	case "comments":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		m := commentModel{}
+		err = bson.Unmarshal(bData, &m)
+		return &m, nil
+	case "task_activities":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		m := activityModel{}
+		err = bson.Unmarshal(bData, &m)
+		return &m, nil
+	case "migrations":
+		bData, err := bsonMarshall(bsonData)
+		if err != nil {
+			return nil, err
+		}
+		m := migrationModel{}
+		err = bson.Unmarshal(bData, &m)
+		return &m, nil
 	}
 	return nil, errors.New("invalid test collection type")
 }
@@ -206,6 +291,114 @@ func getTestTasksModels() []*taskModel {
 	return gms
 }
 
+func getTestAuditModels() []*auditModel {
+	var ams []*auditModel
+	var am *auditModel
+	am, _ = newAuditModel(&models.AuditEvent{
+		Id:         "000000000000000000000031",
+		ActorId:    "000000000000000000000011",
+		Action:     models.AuditLogin,
+		TargetType: "user",
+		TargetId:   "000000000000000000000011",
+		GroupId:    "000000000000000000000001",
+	})
+	ams = append(ams, am)
+	am, _ = newAuditModel(&models.AuditEvent{
+		Id:         "000000000000000000000032",
+		ActorId:    "000000000000000000000011",
+		Action:     models.AuditUserDelete,
+		TargetType: "user",
+		TargetId:   "000000000000000000000012",
+		GroupId:    "000000000000000000000002",
+	})
+	ams = append(ams, am)
+	return ams
+}
+
+func getTestWebhookModels() []*webhookModel {
+	var wms []*webhookModel
+	var wm *webhookModel
+	wm, _ = newWebhookModel(&models.Webhook{
+		Id:         "000000000000000000000041",
+		GroupId:    "000000000000000000000002",
+		URL:        "https://example.com/hooks/tasks",
+		Secret:     "test-secret",
+		EventTypes: []string{models.EventTaskCreated},
+	})
+	wms = append(wms, wm)
+	wm, _ = newWebhookModel(&models.Webhook{
+		Id:      "000000000000000000000042",
+		GroupId: "000000000000000000000003",
+		URL:     "https://example.com/hooks/all",
+		Secret:  "test-secret",
+	})
+	wms = append(wms, wm)
+	return wms
+}
+
+func getTestMembershipModels() []*membershipModel {
+	var mms []*membershipModel
+	var mm *membershipModel
+	mm, _ = newMembershipModel(&models.Membership{
+		Id:      "000000000000000000000051",
+		UserId:  "000000000000000000000013",
+		GroupId: "000000000000000000000002",
+		Role:    "member",
+	})
+	mms = append(mms, mm)
+	mm, _ = newMembershipModel(&models.Membership{
+		Id:      "000000000000000000000052",
+		UserId:  "000000000000000000000012",
+		GroupId: "000000000000000000000003",
+		Role:    "admin",
+	})
+	mms = append(mms, mm)
+	return mms
+}
+
+func getTestInviteModels() []*inviteModel {
+	var ims []*inviteModel
+	var im *inviteModel
+	im, _ = newInviteModel(&models.Invite{
+		Id:        "000000000000000000000061",
+		GroupId:   "000000000000000000000002",
+		Code:      "TESTINVITECODEMEMBER0001",
+		Role:      "member",
+		MaxUses:   2,
+		ExpiresAt: time.Now().UTC().Add(24 * time.Hour),
+	})
+	ims = append(ims, im)
+	im, _ = newInviteModel(&models.Invite{
+		Id:        "000000000000000000000062",
+		GroupId:   "000000000000000000000003",
+		Code:      "TESTINVITECODEEXPIRED001",
+		Role:      "admin",
+		ExpiresAt: time.Now().UTC().Add(-time.Hour),
+	})
+	ims = append(ims, im)
+	return ims
+}
+
+func getTestCommentModels() []*commentModel {
+	var cms []*commentModel
+	var cm *commentModel
+	cm, _ = newCommentModel(&models.Comment{
+		Id:     "000000000000000000000071",
+		TaskId: "000000000000000000000021",
+		UserId: "000000000000000000000014",
+		Body:   "first comment",
+	})
+	cms = append(cms, cm)
+	cm, _ = newCommentModel(&models.Comment{
+		Id:     "000000000000000000000072",
+		TaskId: "000000000000000000000021",
+		UserId: "000000000000000000000012",
+		Body:   "second comment",
+	})
+	cms = append(cms, cm)
+	return cms
+}
+
 func getTestTokens() []string {
 	return []string{
 		"123445608654321",
@@ -218,10 +411,7 @@ func getTestTokens() []string {
 */
 
 func initTestTaskService() *TaskService {
-	os.Setenv("ENV", "test")
-	os.Setenv("MONGO_URI", "mongodb+srv://in_mem")
-	os.Setenv("DATABASE", "test")
-	db, _ := initializeNewTestClient()
+	db, _ := initializeNewTestClient(testConnectionURI)
 	gCollection := db.GetCollection("groups")
 	gHandler := db.NewGroupHandler()
 	gs := &GroupService{
@@ -231,7 +421,7 @@ func initTestTaskService() *TaskService {
 	}
 	tg := getTestGroupModels(true)
 	for _, d := range tg {
-		_, err := gs.GroupCreate(d.toRoot())
+		_, err := gs.GroupCreate(context.Background(), d.toRoot())
 		if err != nil {
 			panic(err)
 		}
@@ -246,7 +436,19 @@ func initTestTaskService() *TaskService {
 	}
 	tu := getTestUsersModels(true)
 	for _, d := range tu {
-		_, err := us.UserCreate(d.toRoot())
+		_, err := us.UserCreate(context.Background(), d.toRoot())
+		if err != nil {
+			panic(err)
+		}
+	}
+	mHandler := db.NewMembershipHandler()
+	ms := &MembershipService{
+		db.GetCollection("memberships"),
+		db,
+		mHandler,
+	}
+	for _, m := range getTestMembershipModels() {
+		_, err := ms.MembershipCreate(context.Background(), m.toRoot())
 		if err != nil {
 			panic(err)
 		}
@@ -259,14 +461,12 @@ func initTestTaskService() *TaskService {
 		tHandler,
 		uHandler,
 		gHandler,
+		mHandler,
 	}
 }
 
 func setupTestTasks() *TaskService {
-	os.Setenv("ENV", "test")
-	os.Setenv("MONGO_URI", "mongodb+srv://in_mem")
-	os.Setenv("DATABASE", "test")
-	db, _ := initializeNewTestClient()
+	db, _ := initializeNewTestClient(testConnectionURI)
 	gCollection := db.GetCollection("groups")
 	gHandler := db.NewGroupHandler()
 	gs := &GroupService{
@@ -276,7 +476,7 @@ func setupTestTasks() *TaskService {
 	}
 	tg := getTestGroupModels(true)
 	for _, d := range tg {
-		_, err := gs.GroupCreate(d.toRoot())
+		_, err := gs.GroupCreate(context.Background(), d.toRoot())
 		if err != nil {
 			panic(err)
 		}
@@ -291,7 +491,7 @@ func setupTestTasks() *TaskService {
 	}
 	tu := getTestUsersModels(true)
 	for _, d := range tu {
-		_, err := us.UserCreate(d.toRoot())
+		_, err := us.UserCreate(context.Background(), d.toRoot())
 		if err != nil {
 			panic(err)
 		}
@@ -304,10 +504,11 @@ func setupTestTasks() *TaskService {
 		tHandler,
 		uHandler,
 		gHandler,
+		db.NewMembershipHandler(),
 	}
 	td := getTestTasksModels()
 	for _, d := range td {
-		_, err := ts.TaskCreate(d.toRoot())
+		_, err := ts.TaskCreate(context.Background(), d.toRoot())
 		if err != nil {
 			panic(err)
 		}
@@ -315,15 +516,174 @@ func setupTestTasks() *TaskService {
 	return ts
 }
 
+/*
+================ testAuditsUtils ==================
+*/
+
+func initTestAuditService() *AuditService {
+	db, _ := initializeNewTestClient(testConnectionURI)
+	collection := db.GetCollection("audit_events")
+	aHandler := db.NewAuditHandler()
+	return &AuditService{
+		collection,
+		db,
+		aHandler,
+	}
+}
+
+func setupTestAudits() *AuditService {
+	as := initTestAuditService()
+	ta := getTestAuditModels()
+	for _, d := range ta {
+		_, err := as.AuditCreate(context.Background(), d.toRoot())
+		if err != nil {
+			panic(err)
+		}
+	}
+	return as
+}
+
+/*
+================ testOutboxUtils ==================
+*/
+
+func initTestOutboxService() *OutboxService {
+	db, _ := initializeNewTestClient(testConnectionURI)
+	collection := db.GetCollection("outbox_events")
+	oHandler := db.NewOutboxHandler()
+	return &OutboxService{
+		collection,
+		db,
+		oHandler,
+	}
+}
+
+/*
+================ testWebhooksUtils ==================
+*/
+
+func initTestWebhookService() *WebhookService {
+	db, _ := initializeNewTestClient(testConnectionURI)
+	collection := db.GetCollection("webhooks")
+	wHandler := db.NewWebhookHandler()
+	dHandler := db.NewDeliveryHandler()
+	return &WebhookService{
+		collection,
+		db,
+		wHandler,
+		dHandler,
+	}
+}
+
+func setupTestWebhooks() *WebhookService {
+	ws := initTestWebhookService()
+	tw := getTestWebhookModels()
+	for _, d := range tw {
+		_, err := ws.WebhookCreate(context.Background(), d.toRoot())
+		if err != nil {
+			panic(err)
+		}
+	}
+	return ws
+}
+
+/*
+================ testMembershipsUtils ==================
+*/
+
+func initTestMembershipService() *MembershipService {
+	db, _ := initializeNewTestClient(testConnectionURI)
+	collection := db.GetCollection("memberships")
+	handler := db.NewMembershipHandler()
+	return &MembershipService{
+		collection,
+		db,
+		handler,
+	}
+}
+
+func setupTestMemberships() *MembershipService {
+	ms := initTestMembershipService()
+	for _, m := range getTestMembershipModels() {
+		_, err := ms.MembershipCreate(context.Background(), m.toRoot())
+		if err != nil {
+			panic(err)
+		}
+	}
+	return ms
+}
+
+/*
+================ testInvitesUtils ==================
+*/
+
+func initTestInviteService() *InviteService {
+	db, _ := initializeNewTestClient(testConnectionURI)
+	collection := db.GetCollection("invites")
+	handler := db.NewInviteHandler()
+	return &InviteService{
+		collection,
+		db,
+		handler,
+	}
+}
+
+// setupTestInvites inserts the test invites directly, as InviteCreate generates their codes and rejects expired ones
+func setupTestInvites() *InviteService {
+	is := initTestInviteService()
+	for _, i := range getTestInviteModels() {
+		_, err := is.handler.InsertOne(context.Background(), i)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return is
+}
+
+/*
+================ testCommentsUtils ==================
+*/
+
+func initTestCommentService() *CommentService {
+	db, _ := initializeNewTestClient(testConnectionURI)
+	return NewCommentService(db, db.NewCommentHandler(), db.NewActivityHandler())
+}
+
+func setupTestComments() *CommentService {
+	cs := initTestCommentService()
+	for _, c := range getTestCommentModels() {
+		_, err := cs.commentHandler.InsertOne(context.Background(), c)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return cs
+}
+
+/*
+================ testFilesUtils ==================
+*/
+
+func setupTestFiles() *FileService {
+	ts := setupTestTasks()
+	return NewFileService(ts.db, ts.db.NewFileHandler(), ts.userHandler, ts.groupHandler, ts.taskHandler)
+}
+
+/*
+================ testMigrationUtils ==================
+*/
+
+func initTestMigrator() *Migrator {
+	db, _ := initializeNewTestClient(testConnectionURI)
+	return NewMigrator(db, db.NewMigrationHandler())
+}
+
 /*
 ================ testBlacklistUtils ==================
 */
 
 func initTestBlacklistService() *BlacklistService {
-	os.Setenv("ENV", "test")
-	os.Setenv("MONGO_URI", "mongodb+srv://in_mem")
-	os.Setenv("DATABASE", "test")
-	db, _ := initializeNewTestClient()
+	db, _ := initializeNewTestClient(testConnectionURI)
 	collection := db.GetCollection("blacklists")
 	gHandler := db.NewBlacklistHandler()
 	return &BlacklistService{
@@ -334,10 +694,7 @@ func initTestBlacklistService() *BlacklistService {
 }
 
 func setupTestBlacklists() *BlacklistService {
-	os.Setenv("ENV", "test")
-	os.Setenv("MONGO_URI", "mongodb+srv://in_mem")
-	os.Setenv("DATABASE", "test")
-	db, _ := initializeNewTestClient()
+	db, _ := initializeNewTestClient(testConnectionURI)
 	collection := db.GetCollection("blacklists")
 	gHandler := db.NewBlacklistHandler()
 	gs := &BlacklistService{
@@ -347,7 +704,7 @@ func setupTestBlacklists() *BlacklistService {
 	}
 	td := getTestTokens()
 	for _, d := range td {
-		err := gs.BlacklistAuthToken(d)
+		err := gs.BlacklistAuthToken(context.Background(), d)
 		if err != nil {
 			panic(err)
 		}
@@ -360,10 +717,7 @@ func setupTestBlacklists() *BlacklistService {
 */
 
 func initTestGroupService() *GroupService {
-	os.Setenv("ENV", "test")
-	os.Setenv("MONGO_URI", "mongodb+srv://in_mem")
-	os.Setenv("DATABASE", "test")
-	db, _ := initializeNewTestClient()
+	db, _ := initializeNewTestClient(testConnectionURI)
 	collection := db.GetCollection("groups")
 	gHandler := db.NewGroupHandler()
 	return &GroupService{
@@ -374,10 +728,7 @@ func initTestGroupService() *GroupService {
 }
 
 func setupTestGroups() *GroupService {
-	os.Setenv("ENV", "test")
-	os.Setenv("MONGO_URI", "mongodb+srv://in_mem")
-	os.Setenv("DATABASE", "test")
-	db, _ := initializeNewTestClient()
+	db, _ := initializeNewTestClient(testConnectionURI)
 	collection := db.GetCollection("groups")
 	gHandler := db.NewGroupHandler()
 	gs := &GroupService{
@@ -387,7 +738,22 @@ func setupTestGroups() *GroupService {
 	}
 	td := getTestGroupModels(false)
 	for _, d := range td {
-		_, err := gs.GroupCreate(d.toRoot())
+		_, err := gs.GroupCreate(context.Background(), d.toRoot())
+		if err != nil {
+			panic(err)
+		}
+	}
+	return gs
+}
+
+// setupTestGroupHierarchy nests group 4 within group 2, and group 5 within group 4
+func setupTestGroupHierarchy() *GroupService {
+	gs := setupTestGroups()
+	for _, d := range []*models.Group{
+		{Id: "000000000000000000000004", Name: "test4", ParentId: "000000000000000000000002"},
+		{Id: "000000000000000000000005", Name: "test5", ParentId: "000000000000000000000004"},
+	} {
+		_, err := gs.GroupCreate(context.Background(), d)
 		if err != nil {
 			panic(err)
 		}
@@ -400,11 +766,7 @@ func setupTestGroups() *GroupService {
 */
 
 func initTestUserService() *UserService {
-	os.Setenv("ENV", "test")
-	os.Setenv("MONGO_URI", "mongodb+srv://in_mem")
-	os.Setenv("DATABASE", "test")
-	os.Setenv("TOKEN_SECRET", "SECRET")
-	db, _ := initializeNewTestClient()
+	db, _ := initializeNewTestClient(testConnectionURI)
 	gCollection := db.GetCollection("groups")
 	gHandler := db.NewGroupHandler()
 	gs := &GroupService{
@@ -414,7 +776,7 @@ func initTestUserService() *UserService {
 	}
 	td := getTestGroupModels(true)
 	for _, d := range td {
-		_, err := gs.GroupCreate(d.toRoot())
+		_, err := gs.GroupCreate(context.Background(), d.toRoot())
 		if err != nil {
 			panic(err)
 		}
@@ -430,11 +792,7 @@ func initTestUserService() *UserService {
 }
 
 func setupTestUsers() *UserService {
-	os.Setenv("ENV", "test")
-	os.Setenv("MONGO_URI", "mongodb+srv://in_mem")
-	os.Setenv("DATABASE", "test")
-	os.Setenv("TOKEN_SECRET", "SECRET")
-	db, _ := initializeNewTestClient()
+	db, _ := initializeNewTestClient(testConnectionURI)
 	gCollection := db.GetCollection("groups")
 	gHandler := db.NewGroupHandler()
 	gs := &GroupService{
@@ -444,7 +802,7 @@ func setupTestUsers() *UserService {
 	}
 	tg := getTestGroupModels(true)
 	for _, d := range tg {
-		_, err := gs.GroupCreate(d.toRoot())
+		_, err := gs.GroupCreate(context.Background(), d.toRoot())
 		if err != nil {
 			panic(err)
 		}
@@ -459,7 +817,7 @@ func setupTestUsers() *UserService {
 	}
 	tu := getTestUsersModels(true)
 	for _, d := range tu {
-		_, err := us.UserCreate(d.toRoot())
+		_, err := us.UserCreate(context.Background(), d.toRoot())
 		if err != nil {
 			panic(err)
 		}
@@ -564,6 +922,119 @@ func (c *testMongoCursor) Err() error {
 	return c.err
 }
 
+/*
+================ testChangeStream ==================
+*/
+
+// testChangeLog is an in-process broadcaster of the change events recorded by a testMongoCollection
+type testChangeLog struct {
+	mu     sync.Mutex
+	events []bson.Raw
+	notify chan struct{}
+}
+
+// newTestChangeLog initiates and returns an empty testChangeLog
+func newTestChangeLog() *testChangeLog {
+	return &testChangeLog{notify: make(chan struct{})}
+}
+
+// record appends a change event shaped like a MongoDB change stream document and wakes any waiting streams
+func (l *testChangeLog) record(operationType string, key dbModel, fullDoc dbModel, before interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	event := bson.D{
+		{Key: "_id", Value: bson.D{{Key: "_data", Value: strconv.Itoa(len(l.events) + 1)}}},
+		{Key: "operationType", Value: operationType},
+		{Key: "wallTime", Value: time.Now().UTC()},
+		{Key: "documentKey", Value: bson.D{{Key: "_id", Value: key.getID()}}},
+	}
+	if fullDoc != nil {
+		doc, err := fullDoc.toDoc()
+		if err != nil {
+			return
+		}
+		event = append(event, bson.E{Key: "fullDocument", Value: doc})
+	}
+	if before != nil {
+		event = append(event, bson.E{Key: "fullDocumentBeforeChange", Value: before})
+	}
+	raw, err := bson.Marshal(event)
+	if err != nil {
+		return
+	}
+	l.events = append(l.events, raw)
+	close(l.notify)
+	l.notify = make(chan struct{})
+}
+
+// testChangeStream reads change events from a testChangeLog, implementing DBChangeStream
+type testChangeStream struct {
+	log     *testChangeLog
+	pos     int
+	Current bson.Raw
+	err     error
+}
+
+// newTestChangeStream opens a testChangeStream after the resume token, or at the end of the log without one
+func newTestChangeStream(l *testChangeLog, resumeToken bson.Raw) (*testChangeStream, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cs := &testChangeStream{log: l, pos: len(l.events)}
+	if len(resumeToken) > 0 {
+		data, ok := resumeToken.Lookup("_data").StringValueOK()
+		pos, err := strconv.Atoi(data)
+		if !ok || err != nil || pos < 0 || pos > len(l.events) {
+			return nil, errors.New("invalid test change stream resume token")
+		}
+		cs.pos = pos
+	}
+	return cs, nil
+}
+
+// Next blocks until another change event is available or ctx is done
+func (c *testChangeStream) Next(ctx context.Context) bool {
+	for {
+		c.log.mu.Lock()
+		if c.pos < len(c.log.events) {
+			c.Current = c.log.events[c.pos]
+			c.pos++
+			c.log.mu.Unlock()
+			return true
+		}
+		wait := c.log.notify
+		c.log.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			c.err = ctx.Err()
+			return false
+		case <-wait:
+		}
+	}
+}
+
+// Decode the current change event into the input val
+func (c *testChangeStream) Decode(val interface{}) error {
+	return bson.Unmarshal(c.Current, val)
+}
+
+// ResumeToken returns the token of the last change event read
+func (c *testChangeStream) ResumeToken() bson.Raw {
+	if c.Current == nil {
+		return nil
+	}
+	return c.Current.Lookup("_id").Document()
+}
+
+// Close the test change stream
+func (c *testChangeStream) Close(ctx context.Context) error {
+	return nil
+}
+
+// Err returns whether the change stream had any errors
+func (c *testChangeStream) Err() error {
+	return c.err
+}
+
 /*
 ================ testMongoCollection ==================
 Extra methods can be added to the DBCollection interface from:
@@ -576,9 +1047,20 @@ To expand bson.Doc functionality
 
 // testMongoCollection
 type testMongoCollection struct {
-	name string
-	ctx  context.Context
-	docs []dbModel
+	name      string
+	ctx       context.Context
+	docs      []dbModel
+	changes   *testChangeLog
+	indexes   []mongo.IndexModel
+	preImages bool
+}
+
+// preImage returns the pre-image of a change when the test collection records pre-images, or nil otherwise
+func (coll *testMongoCollection) preImage(before interface{}) interface{} {
+	if !coll.preImages {
+		return nil
+	}
+	return before
 }
 
 // newTestMongoCollection
@@ -586,7 +1068,7 @@ func newTestMongoCollection(name string) (*testMongoCollection, error) {
 	if name == "" {
 		return nil, errors.New("invalid test collection name")
 	}
-	testUserCollection := &testMongoCollection{name: name, docs: []dbModel{}}
+	testUserCollection := &testMongoCollection{name: name, docs: []dbModel{}, changes: newTestChangeLog()}
 	return testUserCollection, nil
 }
 
@@ -608,7 +1090,7 @@ func (coll *testMongoCollection) findById(findId string) (reDoc dbModel, err err
 			return
 		}
 	}
-	return reDoc, errors.New("document not found in test collection: " + findId)
+	return reDoc, fmt.Errorf("document not found in test collection: %s: %w", findId, mongo.ErrNoDocuments)
 }
 
 // deleteById in the test collection a document by ID
@@ -629,15 +1111,17 @@ func (coll *testMongoCollection) deleteById(findId string) (reDoc dbModel, err e
 		}
 	}
 	if !del {
-		return reDoc, errors.New("document not found in test collection: " + findId)
+		return reDoc, fmt.Errorf("document not found in test collection: %s: %w", findId, mongo.ErrNoDocuments)
 	}
 	coll.docs = dbDocs
+	coll.changes.record("delete", reDoc, nil, coll.preImage(reDoc))
 	return reDoc, nil
 }
 
 // updateById a document in the test collection
 func (coll *testMongoCollection) updateById(findId string, upDoc dbModel) (reDoc dbModel, err error) {
 	var dbDocs []dbModel
+	var before bson.D
 	up := false
 	for _, doc := range coll.docs {
 		var docId string
@@ -653,6 +1137,20 @@ func (coll *testMongoCollection) updateById(findId string, upDoc dbModel) (reDoc
 			if bErr != nil {
 				return reDoc, bErr
 			}
+			before, bErr = reDoc.toDoc()
+			if bErr != nil {
+				return reDoc, bErr
+			}
+			candidate, bErr := coll.unmarshallBSON(before)
+			if bErr != nil {
+				return reDoc, bErr
+			}
+			if bErr = candidate.update(bsonData); bErr != nil {
+				return reDoc, bErr
+			}
+			if bErr = coll.checkUnique(candidate, findId); bErr != nil {
+				return reDoc, bErr
+			}
 			err = reDoc.update(bsonData)
 			if err != nil {
 				return reDoc, err
@@ -662,12 +1160,59 @@ func (coll *testMongoCollection) updateById(findId string, upDoc dbModel) (reDoc
 		}
 	}
 	if !up {
-		return reDoc, errors.New("document not found in test collection: " + findId)
+		return reDoc, fmt.Errorf("document not found in test collection: %s: %w", findId, mongo.ErrNoDocuments)
 	}
 	coll.docs = dbDocs
+	coll.changes.record("update", reDoc, reDoc, coll.preImage(before))
 	return reDoc, nil
 }
 
+// unsetKeys returns the keys an update removes with the $unset operator
+func unsetKeys(bsonData interface{}) map[string]bool {
+	keys := make(map[string]bool)
+	if t, ok := bsonData.(bson.D); ok {
+		for _, e := range t {
+			if u, isD := e.Value.(bson.D); isD && e.Key == "$unset" {
+				for _, k := range u {
+					keys[k.Key] = true
+				}
+			}
+		}
+	}
+	return keys
+}
+
+// unsetById removes fields from a document in the test collection
+func (coll *testMongoCollection) unsetById(findId string, keys map[string]bool) (reDoc dbModel, err error) {
+	for i, doc := range coll.docs {
+		var docId string
+		docId, err = standardizeID(doc)
+		if err != nil {
+			return
+		}
+		if docId != findId {
+			continue
+		}
+		before, bErr := doc.toDoc()
+		if bErr != nil {
+			return doc, bErr
+		}
+		var after bson.D
+		for _, e := range before {
+			if !keys[e.Key] {
+				after = append(after, e)
+			}
+		}
+		reDoc, err = coll.unmarshallBSON(after)
+		if err != nil {
+			return doc, err
+		}
+		coll.docs[i] = reDoc
+		return reDoc, nil
+	}
+	return reDoc, fmt.Errorf("document not found in test collection: %s: %w", findId, mongo.ErrNoDocuments)
+}
+
 // find documents in the test collection
 func (coll *testMongoCollection) find(dbDoc dbModel) (reDocs []dbModel, err error) {
 	for _, doc := range coll.docs {
@@ -692,6 +1237,76 @@ func (coll *testMongoCollection) find(dbDoc dbModel) (reDocs []dbModel, err erro
 	return reDocs, nil
 }
 
+// indexValues returns the values of the index keys in a document, or false when a key is missing
+func indexValues(doc bson.D, keys bson.D) ([]interface{}, bool) {
+	vals := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		found := false
+		for _, e := range doc {
+			if e.Key == k.Key {
+				vals = append(vals, e.Value)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return vals, true
+}
+
+// checkUnique returns a duplicate key write exception when a document violates a unique index of the test collection;
+// documents missing an indexed key are not checked
+func (coll *testMongoCollection) checkUnique(dbDoc dbModel, docId string) error {
+	newDoc, err := dbDoc.toDoc()
+	if err != nil {
+		return err
+	}
+	for _, idx := range coll.indexes {
+		if idx.Options == nil || idx.Options.Unique == nil || !*idx.Options.Unique {
+			continue
+		}
+		keys, _ := idx.Keys.(bson.D)
+		vals, ok := indexValues(newDoc, keys)
+		if !ok {
+			continue
+		}
+		for _, doc := range coll.docs {
+			if id, _ := standardizeID(doc); id == docId {
+				continue
+			}
+			existing, dErr := doc.toDoc()
+			if dErr != nil {
+				return dErr
+			}
+			if eVals, eOk := indexValues(existing, keys); eOk && reflect.DeepEqual(vals, eVals) {
+				return mongo.WriteException{WriteErrors: mongo.WriteErrors{{
+					Code:    11000,
+					Message: fmt.Sprintf("E11000 duplicate key error collection: test.%s index: %s", coll.name, *idx.Options.Name),
+				}}}
+			}
+		}
+	}
+	return nil
+}
+
+// snapshot returns a deep copy of the documents in the test collection
+func (coll *testMongoCollection) snapshot() (docs []dbModel, err error) {
+	for _, doc := range coll.docs {
+		bsonData, bErr := doc.toDoc()
+		if bErr != nil {
+			return nil, bErr
+		}
+		cp, uErr := coll.unmarshallBSON(bsonData)
+		if uErr != nil {
+			return nil, uErr
+		}
+		docs = append(docs, cp)
+	}
+	return docs, nil
+}
+
 // insert documents into test collection
 func (coll *testMongoCollection) insert(dbDocs []dbModel) (err error) {
 	var valDocs []dbModel
@@ -704,10 +1319,16 @@ func (coll *testMongoCollection) insert(dbDocs []dbModel) (err error) {
 		_, fErr := coll.findById(docId)
 		if fErr != nil {
 			// fmt.Println("----------------> CHECK THIS ERROR: ", fErr.Error())
+			if err = coll.checkUnique(dbDoc, docId); err != nil {
+				return err
+			}
 			valDocs = append(valDocs, dbDoc)
 		}
 	}
 	coll.docs = append(coll.docs, valDocs...)
+	for _, valDoc := range valDocs {
+		coll.changes.record("insert", valDoc, valDoc, nil)
+	}
 	return nil
 }
 
@@ -759,7 +1380,7 @@ func (coll *testMongoCollection) InsertMany(ctx context.Context, documents []int
 	return imResult, err
 }
 
-// DeleteMany from test collection
+// DeleteMany deletes every document that matches the filter from the test collection
 func (coll *testMongoCollection) DeleteMany(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
 	var delCount int64
 	coll.ctx = ctx
@@ -768,7 +1389,11 @@ func (coll *testMongoCollection) DeleteMany(ctx context.Context, filter interfac
 	if err != nil {
 		return nil, err
 	}
-	delDocs, err := coll.delete([]dbModel{filterDoc})
+	matches, err := coll.find(filterDoc)
+	if err != nil {
+		return nil, err
+	}
+	delDocs, err := coll.delete(matches)
 	delCount = int64(len(delDocs))
 	return &mongo.DeleteResult{DeletedCount: delCount}, nil
 }
@@ -819,6 +1444,7 @@ func (coll *testMongoCollection) UpdateOne(ctx context.Context, filter interface
 	if err != nil {
 		return nil, err
 	}
+	unset := unsetKeys(update)
 	update, err = cleanUpdateBSON(update)
 	if err != nil {
 		panic(err)
@@ -829,6 +1455,9 @@ func (coll *testMongoCollection) UpdateOne(ctx context.Context, filter interface
 		return nil, err
 	}
 	reDoc, err := coll.updateById(docId, updateDoc)
+	if err == nil && len(unset) > 0 {
+		reDoc, err = coll.unsetById(docId, unset)
+	}
 	return &mongo.UpdateResult{UpsertedID: reDoc.getID()}, err
 }
 
@@ -889,8 +1518,7 @@ func (coll *testMongoCollection) FindOne(ctx context.Context, filter interface{}
 		}
 	}
 	if len(rawResult) == 0 {
-		err = errors.New("document not found")
-		return mongo.NewSingleResultFromDocument(nil, err, nil)
+		return mongo.NewSingleResultFromDocument(bson.D{}, mongo.ErrNoDocuments, nil)
 	}
 	doc, _ := bsonx.ReadDoc(rawResult)
 	return mongo.NewSingleResultFromDocument(doc, err, nil)
@@ -954,12 +1582,92 @@ func newTestMongoDatabase(databaseName string) (*testMongoDatabase, error) {
 		return &testMongoDatabase{}, err
 	}
 	testsColls = append(testsColls, testTasksCollection)
+	testAuditCollection, err := newTestMongoCollection("audit_events")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT AUDIT ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testAuditCollection)
+	testOutboxCollection, err := newTestMongoCollection("outbox_events")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT OUTBOX ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testOutboxCollection)
+	testWebhookCollection, err := newTestMongoCollection("webhooks")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT WEBHOOK ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testWebhookCollection)
+	testDeliveryCollection, err := newTestMongoCollection("webhook_deliveries")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT DELIVERY ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testDeliveryCollection)
+	testMembershipCollection, err := newTestMongoCollection("memberships")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT MEMBERSHIP ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testMembershipCollection)
+	testInviteCollection, err := newTestMongoCollection("invites")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT INVITE ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testInviteCollection)
+	testFileCollection, err := newTestMongoCollection("files")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT FILE ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testFileCollection)
+	testCommentCollection, err := newTestMongoCollection("comments")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT COMMENT ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testCommentCollection)
+	testActivityCollection, err := newTestMongoCollection("task_activities")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT ACTIVITY ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testActivityCollection)
+	testMigrationCollection, err := newTestMongoCollection("migrations")
+	if err != nil {
+		fmt.Println("\nCOLLECTION INIT MIGRATION ERROR: ", err.Error())
+		return &testMongoDatabase{}, err
+	}
+	testsColls = append(testsColls, testMigrationCollection)
 	return &testMongoDatabase{
 		name:            databaseName,
 		testCollections: testsColls,
 	}, nil
 }
 
+// snapshot returns a deep copy of the documents in every test collection
+func (c *testMongoDatabase) snapshot() (map[string][]dbModel, error) {
+	snap := make(map[string][]dbModel)
+	for _, tColl := range c.testCollections {
+		docs, err := tColl.snapshot()
+		if err != nil {
+			return nil, err
+		}
+		snap[tColl.name] = docs
+	}
+	return snap, nil
+}
+
+// restore resets every test collection to the documents of a snapshot
+func (c *testMongoDatabase) restore(snap map[string][]dbModel) {
+	for _, tColl := range c.testCollections {
+		tColl.docs = snap[tColl.name]
+	}
+}
+
 // Collection returns a test collection from the test client
 func (c *testMongoDatabase) Collection(colName string) *testMongoCollection {
 	for _, tColl := range c.testCollections {
@@ -1042,11 +1750,12 @@ func (c *testMongoClient) Ping(ctx context.Context, rp *readpref.ReadPref) error
 type testDBClient struct {
 	connectionURI string
 	client        *testMongoClient
+	txnMu         sync.Mutex
 }
 
 // InitializeNewTestClient is a function that takes a mongoUri string and outputs a connected mongo client for the app to use
-func initializeNewTestClient() (*testDBClient, error) {
-	newDBClient := testDBClient{connectionURI: os.Getenv("MONGO_URI")}
+func initializeNewTestClient(connectionURI string) (*testDBClient, error) {
+	newDBClient := testDBClient{connectionURI: connectionURI}
 	var err error
 	newDBClient.client, err = newTestMongoClient(newDBClient.connectionURI)
 	if err != nil {
@@ -1084,12 +1793,93 @@ func (db *testDBClient) GetCollection(collectionName string) DBCollection {
 	return db.client.Database("test").Collection(collectionName)
 }
 
+// Watch opens an in-process change stream on a test collection, ignoring the pipeline
+func (db *testDBClient) Watch(ctx context.Context, collectionName string, pipeline interface{}, resumeToken bson.Raw) (DBChangeStream, error) {
+	coll := db.client.Database("test").Collection(collectionName)
+	if coll == nil {
+		return nil, errors.New("invalid test collection: " + collectionName)
+	}
+	return newTestChangeStream(coll.changes, resumeToken)
+}
+
+// CreateIndexes records the input indexes on a test collection so that its unique indexes are enforced;
+// collections the test database does not model are skipped
+func (db *testDBClient) CreateIndexes(ctx context.Context, collectionName string, indexes []mongo.IndexModel) error {
+	coll := db.client.Database("test").Collection(collectionName)
+	if coll == nil {
+		return nil
+	}
+	for _, idx := range indexes {
+		if idx.Options == nil || idx.Options.Name == nil {
+			return errors.New("test indexes must be named")
+		}
+		if err := db.DropIndexes(ctx, collectionName, []string{*idx.Options.Name}); err != nil {
+			return err
+		}
+		coll.indexes = append(coll.indexes, idx)
+	}
+	return nil
+}
+
+// DropIndexes removes the named indexes from a test collection
+func (db *testDBClient) DropIndexes(ctx context.Context, collectionName string, names []string) error {
+	coll := db.client.Database("test").Collection(collectionName)
+	if coll == nil {
+		return nil
+	}
+	drop := make(map[string]bool, len(names))
+	for _, name := range names {
+		drop[name] = true
+	}
+	var kept []mongo.IndexModel
+	for _, idx := range coll.indexes {
+		if !drop[*idx.Options.Name] {
+			kept = append(kept, idx)
+		}
+	}
+	coll.indexes = kept
+	return nil
+}
+
+// SetChangeStreamPreImages turns the pre-images of the change events recorded by a test collection on or off
+func (db *testDBClient) SetChangeStreamPreImages(ctx context.Context, collectionName string, enabled bool) error {
+	coll := db.client.Database("test").Collection(collectionName)
+	if coll == nil {
+		return errors.New("invalid test collection: " + collectionName)
+	}
+	coll.preImages = enabled
+	return nil
+}
+
+// WithTransaction runs fn as a unit of work against a snapshot of the test database that is restored when fn fails;
+// changes already recorded for open test change streams are not retracted
+func (db *testDBClient) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if inTransaction(ctx) {
+		return fn(ctx)
+	}
+	db.txnMu.Lock()
+	defer db.txnMu.Unlock()
+	testDB := db.client.Database("test")
+	snap, err := testDB.snapshot()
+	if err != nil {
+		return err
+	}
+	uow := &unitOfWork{}
+	if err = fn(context.WithValue(ctx, txnContextKey{}, uow)); err != nil {
+		testDB.restore(snap)
+		return err
+	}
+	uow.committed(ctx)
+	return nil
+}
+
 // NewDBHandler returns a new DBHandler generic interface
 func (db *testDBClient) NewDBHandler(collectionName string) *DBHandler[dbModel] {
 	col := db.GetCollection(collectionName)
 	return &DBHandler[dbModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: collectionName,
 	}
 }
 
@@ -1097,8 +1887,9 @@ func (db *testDBClient) NewDBHandler(collectionName string) *DBHandler[dbModel]
 func (db *testDBClient) NewUserHandler() *DBHandler[*userModel] {
 	col := db.GetCollection("users")
 	return &DBHandler[*userModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "users",
 	}
 }
 
@@ -1106,8 +1897,9 @@ func (db *testDBClient) NewUserHandler() *DBHandler[*userModel] {
 func (db *testDBClient) NewGroupHandler() *DBHandler[*groupModel] {
 	col := db.GetCollection("groups")
 	return &DBHandler[*groupModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "groups",
 	}
 }
 
@@ -1115,8 +1907,9 @@ func (db *testDBClient) NewGroupHandler() *DBHandler[*groupModel] {
 func (db *testDBClient) NewBlacklistHandler() *DBHandler[*blacklistModel] {
 	col := db.GetCollection("blacklists")
 	return &DBHandler[*blacklistModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "blacklists",
 	}
 }
 
@@ -1124,8 +1917,9 @@ func (db *testDBClient) NewBlacklistHandler() *DBHandler[*blacklistModel] {
 func (db *testDBClient) NewTaskHandler() *DBHandler[*taskModel] {
 	col := db.GetCollection("tasks")
 	return &DBHandler[*taskModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "tasks",
 	}
 }
 
@@ -1133,7 +1927,98 @@ func (db *testDBClient) NewTaskHandler() *DBHandler[*taskModel] {
 func (db *testDBClient) NewFileHandler() *DBHandler[*fileModel] {
 	col := db.GetCollection("files")
 	return &DBHandler[*fileModel]{
-		db:         db,
-		collection: col,
+		db:             db,
+		collection:     col,
+		collectionName: "files",
+	}
+}
+
+// NewAuditHandler returns a new DBHandler audit_events interface
+func (db *testDBClient) NewAuditHandler() *DBHandler[*auditModel] {
+	col := db.GetCollection("audit_events")
+	return &DBHandler[*auditModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "audit_events",
+	}
+}
+
+// NewOutboxHandler returns a new DBHandler outbox_events interface
+func (db *testDBClient) NewOutboxHandler() *DBHandler[*outboxModel] {
+	col := db.GetCollection("outbox_events")
+	return &DBHandler[*outboxModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "outbox_events",
+	}
+}
+
+// NewWebhookHandler returns a new DBHandler webhooks interface
+func (db *testDBClient) NewWebhookHandler() *DBHandler[*webhookModel] {
+	col := db.GetCollection("webhooks")
+	return &DBHandler[*webhookModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "webhooks",
+	}
+}
+
+// NewDeliveryHandler returns a new DBHandler webhook_deliveries interface
+func (db *testDBClient) NewDeliveryHandler() *DBHandler[*deliveryModel] {
+	col := db.GetCollection("webhook_deliveries")
+	return &DBHandler[*deliveryModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "webhook_deliveries",
+	}
+}
+
+// NewMembershipHandler returns a new DBHandler memberships interface
+func (db *testDBClient) NewMembershipHandler() *DBHandler[*membershipModel] {
+	col := db.GetCollection("memberships")
+	return &DBHandler[*membershipModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "memberships",
+	}
+}
+
+// NewInviteHandler returns a new DBHandler invites interface
+func (db *testDBClient) NewInviteHandler() *DBHandler[*inviteModel] {
+	col := db.GetCollection("invites")
+	return &DBHandler[*inviteModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "invites",
+	}
+}
+
+// NewCommentHandler returns a new DBHandler comments interface
+func (db *testDBClient) NewCommentHandler() *DBHandler[*commentModel] {
+	col := db.GetCollection("comments")
+	return &DBHandler[*commentModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "comments",
+	}
+}
+
+// NewActivityHandler returns a new DBHandler task_activities interface
+func (db *testDBClient) NewActivityHandler() *DBHandler[*activityModel] {
+	col := db.GetCollection("task_activities")
+	return &DBHandler[*activityModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "task_activities",
+	}
+}
+
+// NewMigrationHandler returns a new DBHandler migrations interface
+func (db *testDBClient) NewMigrationHandler() *DBHandler[*migrationModel] {
+	col := db.GetCollection("migrations")
+	return &DBHandler[*migrationModel]{
+		db:             db,
+		collection:     col,
+		collectionName: "migrations",
 	}
 }
@@ -1,6 +1,9 @@
 package database
 
 import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"go.opentelemetry.io/otel/attribute"
 	"sync"
 )
 
@@ -13,6 +16,21 @@ const (
 	DeleteOne
 )
 
+// String returns the name of a routineType
+func (r routineType) String() string {
+	switch r {
+	case FindOne:
+		return "FindOne"
+	case UpdateOne:
+		return "UpdateOne"
+	case InsertOne:
+		return "InsertOne"
+	case DeleteOne:
+		return "DeleteOne"
+	}
+	return "Unknown"
+}
+
 // dbRoutine struct to store executing thread ...
 type dbRoutine[T dbModel] struct {
 	out     T
@@ -24,22 +42,24 @@ type dbRoutine[T dbModel] struct {
 }
 
 // execute a DB Routine by inputting a RoutineType, filter, and data
-func (p *dbRoutine[T]) execute(rt routineType, tCh chan T, eCh chan error, f T, d T) {
+func (p *dbRoutine[T]) execute(ctx context.Context, rt routineType, tCh chan T, eCh chan error, f T, d T) {
 	p.rType = rt
 	p.filter = f
 	p.data = d
+	ctx, span := utilities.StartSpan(ctx, "dbRoutine.execute", attribute.String("routine.type", rt.String()))
 	var resp T
 	var err error
 	switch p.rType {
 	case FindOne:
-		resp, err = p.handler.FindOne(p.filter)
+		resp, err = p.handler.FindOne(ctx, p.filter)
 	case UpdateOne:
-		resp, err = p.handler.UpdateOne(p.filter, p.data)
+		resp, err = p.handler.UpdateOne(ctx, p.filter, p.data)
 	case InsertOne:
-		resp, err = p.handler.InsertOne(p.data)
+		resp, err = p.handler.InsertOne(ctx, p.data)
 	case DeleteOne:
-		resp, err = p.handler.DeleteOne(p.filter)
+		resp, err = p.handler.DeleteOne(ctx, p.filter)
 	}
+	utilities.EndSpan(span, err)
 	eCh <- err
 	tCh <- resp
 }
@@ -0,0 +1,69 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"testing"
+)
+
+func Test_WithTransaction(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name     string // The name of the test
+		wantName string // The name we want the updated group to have afterwards
+		wantErr  bool   // whether we want an error.
+		txnErr   error  // The error returned by the unit of work
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"commit",
+			"renamed",
+			false,
+			nil,
+		},
+		{
+			"rollback",
+			"test2",
+			true,
+			errors.New("unit of work failed"),
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestGroups()
+			ctx := context.Background()
+			committed := false
+			err := testService.db.WithTransaction(ctx, func(ctx context.Context) error {
+				_ = afterCommit(ctx, func(ctx context.Context) error {
+					committed = true
+					return nil
+				})
+				if _, err := testService.GroupCreate(ctx, &models.Group{Id: "000000000000000000000004", Name: "test4"}); err != nil {
+					return err
+				}
+				if _, err := testService.GroupUpdate(ctx, &models.Group{Id: "000000000000000000000002", Name: "renamed"}); err != nil {
+					return err
+				}
+				return tt.txnErr
+			})
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DBClient.WithTransaction() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if committed == tt.wantErr {
+				t.Errorf("DBClient.WithTransaction() ran the after commit step = %v, want %v", committed, !tt.wantErr)
+			}
+			_, err = testService.GroupFind(ctx, &models.Group{Id: "000000000000000000000004"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GroupService.GroupFind() inserted group error = %v, wantErr %v", err, tt.wantErr)
+			}
+			got, err := testService.GroupFind(ctx, &models.Group{Id: "000000000000000000000002"})
+			if err != nil || got.Name != tt.wantName { // Asserting whether we get the correct wanted value
+				t.Errorf("GroupService.GroupFind() = %v, %v, want %v", got, err, tt.wantName)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package database
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// deliveryModel structures a webhook delivery attempt BSON document to save in the webhook_deliveries collection
+type deliveryModel struct {
+	Id            primitive.ObjectID `bson:"_id,omitempty"`
+	WebhookId     primitive.ObjectID `bson:"webhook_id,omitempty"`
+	GroupId       primitive.ObjectID `bson:"group_id,omitempty"`
+	EventId       string             `bson:"event_id,omitempty"`
+	EventType     string             `bson:"event_type,omitempty"`
+	Payload       string             `bson:"payload,omitempty"`
+	Status        string             `bson:"status,omitempty"`
+	Attempts      int                `bson:"attempts"`
+	ResponseCode  int                `bson:"response_code"`
+	LastError     string             `bson:"last_error"`
+	NextAttemptAt time.Time          `bson:"next_attempt_at,omitempty"`
+	LastModified  time.Time          `bson:"last_modified,omitempty"`
+	CreatedAt     time.Time          `bson:"created_at,omitempty"`
+}
+
+// newDeliveryModel initializes a new pointer to a deliveryModel struct from a pointer to a JSON WebhookDelivery struct
+func newDeliveryModel(u *models.WebhookDelivery) (um *deliveryModel, err error) {
+	um = &deliveryModel{
+		EventId:       u.EventId,
+		EventType:     u.EventType,
+		Payload:       u.Payload,
+		Status:        u.Status,
+		Attempts:      u.Attempts,
+		ResponseCode:  u.ResponseCode,
+		LastError:     u.LastError,
+		NextAttemptAt: u.NextAttemptAt,
+		LastModified:  u.LastModified,
+		CreatedAt:     u.CreatedAt,
+	}
+	if u.Id != "" && u.Id != "000000000000000000000000" {
+		um.Id, err = primitive.ObjectIDFromHex(u.Id)
+	}
+	if u.WebhookId != "" && u.WebhookId != "000000000000000000000000" {
+		um.WebhookId, err = primitive.ObjectIDFromHex(u.WebhookId)
+	}
+	if u.GroupId != "" && u.GroupId != "000000000000000000000000" {
+		um.GroupId, err = primitive.ObjectIDFromHex(u.GroupId)
+	}
+	return
+}
+
+// update the deliveryModel using an overwrite bson.D doc
+func (u *deliveryModel) update(doc interface{}) (err error) {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return
+	}
+	um := deliveryModel{}
+	err = bson.Unmarshal(data, &um)
+	if len(um.Status) > 0 {
+		u.Status = um.Status
+	}
+	u.Attempts = um.Attempts
+	u.ResponseCode = um.ResponseCode
+	u.LastError = um.LastError
+	if !um.NextAttemptAt.IsZero() {
+		u.NextAttemptAt = um.NextAttemptAt
+	}
+	if !um.LastModified.IsZero() {
+		u.LastModified = um.LastModified
+	}
+	return
+}
+
+// bsonLoad loads a bson doc into the deliveryModel
+func (u *deliveryModel) bsonLoad(doc bson.D) (err error) {
+	bData, err := bsonMarshall(doc)
+	if err != nil {
+		return err
+	}
+	err = bson.Unmarshal(bData, u)
+	return err
+}
+
+// match compares an input bson doc and returns whether every field it sets matches the deliveryModel
+func (u *deliveryModel) match(doc interface{}) bool {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return false
+	}
+	um := deliveryModel{}
+	err = bson.Unmarshal(data, &um)
+	if um.Id.Hex() != "" && um.Id.Hex() != "000000000000000000000000" {
+		return u.Id == um.Id
+	}
+	if !um.WebhookId.IsZero() && u.WebhookId != um.WebhookId {
+		return false
+	}
+	if !um.GroupId.IsZero() && u.GroupId != um.GroupId {
+		return false
+	}
+	if um.Status != "" && u.Status != um.Status {
+		return false
+	}
+	return true
+}
+
+// getID returns the unique identifier of the deliveryModel
+func (u *deliveryModel) getID() (id interface{}) {
+	return u.Id
+}
+
+// addTimeStamps updates a deliveryModel struct with a timestamp
+func (u *deliveryModel) addTimeStamps(newRecord bool) {
+	currentTime := time.Now().UTC()
+	u.LastModified = currentTime
+	if newRecord {
+		u.CreatedAt = currentTime
+	}
+}
+
+// addObjectID checks if a deliveryModel has a value assigned for Id if no value a new one is generated and assigned
+func (u *deliveryModel) addObjectID() {
+	if u.Id.Hex() == "" || u.Id.Hex() == "000000000000000000000000" {
+		u.Id = primitive.NewObjectID()
+	}
+}
+
+// postProcess updates a deliveryModel struct after it is read from or written to the db
+func (u *deliveryModel) postProcess() (err error) {
+	return
+}
+
+// toDoc converts the bson deliveryModel into a bson.D
+func (u *deliveryModel) toDoc() (doc bson.D, err error) {
+	data, err := bson.Marshal(u)
+	if err != nil {
+		return
+	}
+	err = bson.Unmarshal(data, &doc)
+	return
+}
+
+// bsonFilter generates a bson filter for MongoDB queries from the deliveryModel data
+func (u *deliveryModel) bsonFilter() (doc bson.D, err error) {
+	if u.Id.Hex() != "" && u.Id.Hex() != "000000000000000000000000" {
+		return bson.D{{Key: "_id", Value: u.Id}}, nil
+	}
+	doc = bson.D{}
+	if !u.WebhookId.IsZero() {
+		doc = append(doc, bson.E{Key: "webhook_id", Value: u.WebhookId})
+	}
+	if !u.GroupId.IsZero() {
+		doc = append(doc, bson.E{Key: "group_id", Value: u.GroupId})
+	}
+	if u.Status != "" {
+		doc = append(doc, bson.E{Key: "status", Value: u.Status})
+	}
+	return
+}
+
+// bsonUpdate generates a bson update for MongoDB queries from the deliveryModel data
+func (u *deliveryModel) bsonUpdate() (doc bson.D, err error) {
+	inner, err := u.toDoc()
+	if err != nil {
+		return
+	}
+	doc = bson.D{{Key: "$set", Value: inner}}
+	return
+}
+
+// toRoot creates and return a new pointer to a WebhookDelivery JSON struct from a pointer to a BSON deliveryModel
+func (u *deliveryModel) toRoot() *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		Id:            u.Id.Hex(),
+		WebhookId:     u.WebhookId.Hex(),
+		GroupId:       u.GroupId.Hex(),
+		EventId:       u.EventId,
+		EventType:     u.EventType,
+		Payload:       u.Payload,
+		Status:        u.Status,
+		Attempts:      u.Attempts,
+		ResponseCode:  u.ResponseCode,
+		LastError:     u.LastError,
+		NextAttemptAt: u.NextAttemptAt,
+		LastModified:  u.LastModified,
+		CreatedAt:     u.CreatedAt,
+	}
+}
+
+func rootDeliveries(ms []*deliveryModel) (deliveries []*models.WebhookDelivery) {
+	for _, m := range ms {
+		deliveries = append(deliveries, m.toRoot())
+	}
+	return
+}
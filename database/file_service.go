@@ -3,7 +3,6 @@ package database
 import (
 	"bytes"
 	"context"
-	"errors"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -17,10 +16,11 @@ type FileService struct {
 	fileHandler  *DBHandler[*fileModel]
 	userHandler  *DBHandler[*userModel]
 	groupHandler *DBHandler[*groupModel]
+	taskHandler  *DBHandler[*taskModel]
 }
 
 // NewFileService is an exported function used to initialize a new FileService struct
-func NewFileService(db DBClient, fHandler *DBHandler[*fileModel], uHandler *DBHandler[*userModel], gHandler *DBHandler[*groupModel]) *FileService {
+func NewFileService(db DBClient, fHandler *DBHandler[*fileModel], uHandler *DBHandler[*userModel], gHandler *DBHandler[*groupModel], tHandler *DBHandler[*taskModel]) *FileService {
 	collection := db.GetCollection("files")
 	return &FileService{
 		collection,
@@ -28,6 +28,7 @@ func NewFileService(db DBClient, fHandler *DBHandler[*fileModel], uHandler *DBHa
 		fHandler,
 		uHandler,
 		gHandler,
+		tHandler,
 	}
 }
 
@@ -64,19 +65,29 @@ func (p *FileService) downloadFileFromBucket(g *fileModel) (*bytes.Buffer, error
 	return w, nil
 }
 
-// deleteFileFromBucket deletes a file from a bucket
-func (p *FileService) deleteFileFromBucket(g *fileModel) error {
-	bucket, err := p.db.GetBucket(g.BucketName)
-	if err != nil {
-		return err
-	}
-	return bucket.Delete(g.GridFSId)
+// deleteFileFromBucket deletes a file from a bucket once the unit of work of ctx commits, so that an aborted unit of
+// work keeps the content of the file records it restores; a file whose delete fails after the commit stays orphaned
+// in its bucket
+func (p *FileService) deleteFileFromBucket(ctx context.Context, g *fileModel) error {
+	bucketName, gridFSId := g.BucketName, g.GridFSId
+	return afterCommit(ctx, func(ctx context.Context) error {
+		bucket, err := p.db.GetBucket(bucketName)
+		if err != nil {
+			return err
+		}
+		if deadline, ok := ctx.Deadline(); ok {
+			if err = bucket.SetWriteDeadline(deadline); err != nil {
+				return err
+			}
+		}
+		return bucket.Delete(gridFSId)
+	})
 }
 
 // checkFileOwner queries an OwnerId to verify the record is legit
-func (p *FileService) checkFileOwner(g *fileModel) error {
+func (p *FileService) checkFileOwner(ctx context.Context, g *fileModel) error {
 	if g.OwnerType == "group" {
-		gm, err := p.groupHandler.FindOne(&groupModel{Id: g.OwnerId})
+		gm, err := p.groupHandler.FindOne(ctx, &groupModel{Id: g.OwnerId})
 		if err != nil {
 			return err
 		}
@@ -84,7 +95,15 @@ func (p *FileService) checkFileOwner(g *fileModel) error {
 			return nil
 		}
 	} else if g.OwnerType == "user" {
-		gm, err := p.userHandler.FindOne(&userModel{Id: g.OwnerId})
+		gm, err := p.userHandler.FindOne(ctx, &userModel{Id: g.OwnerId})
+		if err != nil {
+			return err
+		}
+		if gm.toRoot().CheckID("id") {
+			return nil
+		}
+	} else if g.OwnerType == "task" {
+		gm, err := p.taskHandler.FindOne(ctx, &taskModel{Id: g.OwnerId})
 		if err != nil {
 			return err
 		}
@@ -92,17 +111,17 @@ func (p *FileService) checkFileOwner(g *fileModel) error {
 			return nil
 		}
 	}
-	return errors.New("invalid file owner")
+	return utilities.FailedPrecondition("FILE_OWNER_NOT_FOUND", "invalid file owner")
 }
 
 // FilesFind is used to find many files
-func (p *FileService) FilesFind(g *models.File) ([]*models.File, error) {
+func (p *FileService) FilesFind(ctx context.Context, g *models.File) ([]*models.File, error) {
 	var files []*models.File
 	tm, err := newFileModel(g)
 	if err != nil {
 		return files, err
 	}
-	gms, err := p.fileHandler.FindMany(tm)
+	gms, err := p.fileHandler.FindMany(ctx, tm)
 	if err != nil {
 		return files, err
 	}
@@ -113,20 +132,20 @@ func (p *FileService) FilesFind(g *models.File) ([]*models.File, error) {
 }
 
 // FileFind is used to find a specific file
-func (p *FileService) FileFind(g *models.File) (*models.File, error) {
+func (p *FileService) FileFind(ctx context.Context, g *models.File) (*models.File, error) {
 	gm, err := newFileModel(g)
 	if err != nil {
 		return nil, err
 	}
-	gm, err = p.fileHandler.FindOne(gm)
+	gm, err = p.fileHandler.FindOne(ctx, gm)
 	if err != nil {
-		return nil, err
+		return nil, lookupErr(err, "file", g.Id)
 	}
 	return gm.toRoot(), nil
 }
 
 // FileCreate creates a new GridFS File
-func (p *FileService) FileCreate(g *models.File, content []byte) (*models.File, error) {
+func (p *FileService) FileCreate(ctx context.Context, g *models.File, content []byte) (*models.File, error) {
 	err := g.Validate("create")
 	if err != nil {
 		return nil, err
@@ -140,7 +159,7 @@ func (p *FileService) FileCreate(g *models.File, content []byte) (*models.File,
 	if err != nil {
 		return nil, err
 	}
-	err = p.checkFileOwner(gm) // verify that the owner of the new file is a valid db record
+	err = p.checkFileOwner(ctx, gm) // verify that the owner of the new file is a valid db record
 	if err != nil {
 		return nil, err
 	}
@@ -149,19 +168,18 @@ func (p *FileService) FileCreate(g *models.File, content []byte) (*models.File,
 		return nil, err
 	}
 	gm.GridFSId = gridFSId
-	gm, err = p.fileHandler.InsertOne(gm)
+	inserted, err := p.fileHandler.InsertOne(ctx, gm)
 	if err != nil {
-		err = p.deleteFileFromBucket(gm)
-		if err != nil {
-			panic("unable to delete orphaned file: " + gm.GridFSId.Hex() + " from GridFS bucket! msg: " + err.Error())
+		if dErr := p.deleteFileFromBucket(context.Background(), gm); dErr != nil {
+			panic("unable to delete orphaned file: " + gm.GridFSId.Hex() + " from GridFS bucket! msg: " + dErr.Error())
 		}
 		return nil, err
 	}
-	return gm.toRoot(), nil
+	return inserted.toRoot(), nil
 }
 
 // FileUpdate is used to update an existing File
-func (p *FileService) FileUpdate(g *models.File, content []byte) (*models.File, error) {
+func (p *FileService) FileUpdate(ctx context.Context, g *models.File, content []byte) (*models.File, error) {
 	var filter models.File
 	err := g.Validate("update")
 	if err != nil {
@@ -172,9 +190,9 @@ func (p *FileService) FileUpdate(g *models.File, content []byte) (*models.File,
 	if err != nil {
 		return nil, err
 	}
-	cur, err := p.fileHandler.FindOne(f)
+	cur, err := p.fileHandler.FindOne(ctx, f)
 	if err != nil {
-		return nil, errors.New("file not found")
+		return nil, lookupErr(err, "file", g.Id)
 	}
 	g.BuildUpdate(cur.toRoot())
 	gm, err := newFileModel(g)
@@ -182,16 +200,13 @@ func (p *FileService) FileUpdate(g *models.File, content []byte) (*models.File,
 		return nil, err
 	}
 	if gm.BucketName != cur.BucketName { // if new file owner and type in update, then verify the new owner
-		err = p.checkFileOwner(gm)
+		err = p.checkFileOwner(ctx, gm)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if len(content) > 0 && cur.Size != len(content) {
-		err = p.deleteFileFromBucket(cur)
-		if err != nil {
-			return nil, err
-		}
+	replaced := len(content) > 0 && cur.Size != len(content)
+	if replaced {
 		gridFSId, err := p.uploadFileToBucket(gm, content)
 		if err != nil {
 			return nil, err
@@ -199,24 +214,32 @@ func (p *FileService) FileUpdate(g *models.File, content []byte) (*models.File,
 		gm.GridFSId = gridFSId
 		gm.Size = len(content)
 	}
-	gm, err = p.fileHandler.UpdateOne(f, gm)
+	updated, err := p.fileHandler.UpdateOne(ctx, f, gm)
 	if err != nil {
+		if replaced {
+			_ = p.deleteFileFromBucket(context.Background(), gm)
+		}
 		return nil, err
 	}
-	return gm.toRoot(), err
+	if replaced {
+		if err = p.deleteFileFromBucket(ctx, cur); err != nil {
+			return nil, err
+		}
+	}
+	return updated.toRoot(), nil
 }
 
 // FileDelete is used to delete a GridFS File
-func (p *FileService) FileDelete(g *models.File) (*models.File, error) {
+func (p *FileService) FileDelete(ctx context.Context, g *models.File) (*models.File, error) {
 	gm, err := newFileModel(g)
 	if err != nil {
 		return nil, err
 	}
-	gm, err = p.fileHandler.DeleteOne(gm)
+	gm, err = p.fileHandler.DeleteOne(ctx, gm)
 	if err != nil {
 		return nil, err
 	}
-	err = p.deleteFileFromBucket(gm)
+	err = p.deleteFileFromBucket(ctx, gm)
 	if err != nil {
 		return nil, err
 	}
@@ -224,13 +247,22 @@ func (p *FileService) FileDelete(g *models.File) (*models.File, error) {
 }
 
 // FileDeleteMany is used to delete a GridFS File
-func (p *FileService) FileDeleteMany(g []*models.File) error {
+func (p *FileService) FileDeleteMany(ctx context.Context, g []*models.File) error {
+	if inTransaction(ctx) {
+		// a unit of work session cannot be shared by concurrent deletes
+		for _, f := range g {
+			if _, err := p.FileDelete(ctx, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	outErrors := make([]error, len(g))
 	var wg sync.WaitGroup
 	wg.Add(len(g))
 	for c, f := range g {
 		go func(c int, f *models.File) {
-			_, outErrors[c] = p.FileDelete(f)
+			_, outErrors[c] = p.FileDelete(ctx, f)
 			wg.Done()
 		}(c, f)
 	}
@@ -244,7 +276,7 @@ func (p *FileService) FileDeleteMany(g []*models.File) error {
 }
 
 // RetrieveFile returns the content bytes for a GridFS File
-func (p *FileService) RetrieveFile(g *models.File) (*bytes.Buffer, error) {
+func (p *FileService) RetrieveFile(ctx context.Context, g *models.File) (*bytes.Buffer, error) {
 	err := g.Validate("retrieve")
 	if err != nil {
 		return nil, err
@@ -257,13 +289,13 @@ func (p *FileService) RetrieveFile(g *models.File) (*bytes.Buffer, error) {
 		return p.downloadFileFromBucket(gm)
 	}
 	if g.CheckID("id") {
-		gm, err = p.fileHandler.FindOne(gm)
+		gm, err = p.fileHandler.FindOne(ctx, gm)
 		if err != nil {
-			return nil, errors.New("file not found")
+			return nil, lookupErr(err, "file", g.Id)
 		}
 		return p.downloadFileFromBucket(gm)
 	}
-	return nil, errors.New("file not found")
+	return nil, utilities.NotFound("file", g.OwnerId)
 }
 
 // FilesQuery is used for a paginated files search
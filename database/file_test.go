@@ -0,0 +1,37 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+func Test_FileOwner(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name string       // The name of the test
+		code codes.Code   // The status code of the error we want
+		file *models.File // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"task", codes.OK, &models.File{OwnerId: "000000000000000000000022", OwnerType: "task"}},
+		{"missing task", codes.NotFound, &models.File{OwnerId: "000000000000000000000029", OwnerType: "task"}},
+		{"group", codes.OK, &models.File{OwnerId: "000000000000000000000002", OwnerType: "group"}},
+		{"unknown owner type", codes.FailedPrecondition, &models.File{OwnerId: "000000000000000000000022", OwnerType: "comment"}},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestFiles()
+			fm, err := newFileModel(tt.file)
+			if err != nil {
+				t.Fatalf("newFileModel() error = %v", err)
+			}
+			err = testService.checkFileOwner(context.Background(), fm)
+			if errCode(err) != tt.code {
+				t.Errorf("FileService.checkFileOwner() error = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
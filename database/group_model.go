@@ -10,12 +10,51 @@ import (
 
 // groupModel structures a group BSON document to save in a groups collection
 type groupModel struct {
-	Id           primitive.ObjectID `bson:"_id,omitempty"`
-	Name         string             `bson:"name,omitempty"`
-	RootAdmin    bool               `bson:"root_admin,omitempty"`
-	LastModified time.Time          `bson:"last_modified,omitempty"`
-	CreatedAt    time.Time          `bson:"created_at,omitempty"`
-	DeletedAt    time.Time          `bson:"deleted_at,omitempty"`
+	Id           primitive.ObjectID  `bson:"_id,omitempty"`
+	Name         string              `bson:"name,omitempty"`
+	RootAdmin    bool                `bson:"root_admin,omitempty"`
+	ParentId     primitive.ObjectID  `bson:"parent_id,omitempty"`
+	Settings     *groupSettingsModel `bson:"settings,omitempty"`
+	LastModified time.Time           `bson:"last_modified,omitempty"`
+	CreatedAt    time.Time           `bson:"created_at,omitempty"`
+	DeletedAt    time.Time           `bson:"deleted_at,omitempty"`
+}
+
+// groupSettingsModel structures the settings sub-document of a groupModel
+type groupSettingsModel struct {
+	Timezone        string   `bson:"timezone,omitempty"`
+	DefaultDueDays  int      `bson:"default_due_days,omitempty"`
+	AllowedStatuses []string `bson:"allowed_statuses,omitempty"`
+	MemberLimit     int      `bson:"member_limit,omitempty"`
+	AdminOnlyTasks  bool     `bson:"admin_only_tasks,omitempty"`
+}
+
+// newGroupSettingsModel returns a groupSettingsModel for the GroupSettings of a Group, or nil when they are all unset
+func newGroupSettingsModel(s models.GroupSettings) *groupSettingsModel {
+	if s.Timezone == "" && s.DefaultDueDays == 0 && len(s.AllowedStatuses) == 0 && s.MemberLimit == 0 && !s.AdminOnlyTasks {
+		return nil
+	}
+	return &groupSettingsModel{
+		Timezone:        s.Timezone,
+		DefaultDueDays:  s.DefaultDueDays,
+		AllowedStatuses: s.AllowedStatuses,
+		MemberLimit:     s.MemberLimit,
+		AdminOnlyTasks:  s.AdminOnlyTasks,
+	}
+}
+
+// toRoot creates and returns the GroupSettings of a groupSettingsModel, which are unset for a nil groupSettingsModel
+func (s *groupSettingsModel) toRoot() models.GroupSettings {
+	if s == nil {
+		return models.GroupSettings{}
+	}
+	return models.GroupSettings{
+		Timezone:        s.Timezone,
+		DefaultDueDays:  s.DefaultDueDays,
+		AllowedStatuses: s.AllowedStatuses,
+		MemberLimit:     s.MemberLimit,
+		AdminOnlyTasks:  s.AdminOnlyTasks,
+	}
 }
 
 // newGroupModel initializes a new pointer to a groupModel struct from a pointer to a JSON Group struct
@@ -23,6 +62,7 @@ func newGroupModel(g *models.Group) (gm *groupModel, err error) {
 	gm = &groupModel{
 		Name:         g.Name,
 		RootAdmin:    g.RootAdmin,
+		Settings:     newGroupSettingsModel(g.Settings),
 		LastModified: g.LastModified,
 		CreatedAt:    g.CreatedAt,
 		DeletedAt:    g.DeletedAt,
@@ -30,6 +70,9 @@ func newGroupModel(g *models.Group) (gm *groupModel, err error) {
 	if g.Id != "" && g.Id != "000000000000000000000000" {
 		gm.Id, err = primitive.ObjectIDFromHex(g.Id)
 	}
+	if g.ParentId != "" && g.ParentId != "000000000000000000000000" {
+		gm.ParentId, err = primitive.ObjectIDFromHex(g.ParentId)
+	}
 	return
 }
 
@@ -44,6 +87,12 @@ func (g *groupModel) update(doc interface{}) (err error) {
 	if len(gm.Name) > 0 {
 		g.Name = gm.Name
 	}
+	if !gm.ParentId.IsZero() {
+		g.ParentId = gm.ParentId
+	}
+	if gm.Settings != nil {
+		g.Settings = gm.Settings
+	}
 	if !gm.LastModified.IsZero() {
 		g.LastModified = gm.LastModified
 	}
@@ -74,6 +123,9 @@ func (g *groupModel) match(doc interface{}) bool {
 	if g.Name == gm.Name {
 		return true
 	}
+	if !gm.ParentId.IsZero() && g.ParentId == gm.ParentId {
+		return true
+	}
 	return false
 }
 
@@ -125,6 +177,9 @@ func (g *groupModel) bsonFilter() (doc bson.D, err error) {
 	if g.Name != "" {
 		doc = bson.D{{"name", g.Name}}
 	}
+	if len(doc) == 0 && !g.ParentId.IsZero() {
+		doc = bson.D{{"parent_id", g.ParentId}}
+	}
 	return
 }
 
@@ -144,12 +199,22 @@ func (g *groupModel) toRoot() *models.Group {
 		Id:           g.Id.Hex(),
 		Name:         g.Name,
 		RootAdmin:    g.RootAdmin,
+		ParentId:     parentHex(g.ParentId),
+		Settings:     g.Settings.toRoot(),
 		LastModified: g.LastModified,
 		CreatedAt:    g.CreatedAt,
 		DeletedAt:    g.DeletedAt,
 	}
 }
 
+// parentHex returns the hex id of a parent group, or an empty string for a top level group
+func parentHex(id primitive.ObjectID) string {
+	if id.IsZero() {
+		return ""
+	}
+	return id.Hex()
+}
+
 func rootGroups(ms []*groupModel) (users []*models.Group) {
 	for _, m := range ms {
 		users = append(users, m.toRoot())
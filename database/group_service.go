@@ -2,9 +2,10 @@ package database
 
 import (
 	"context"
-	"errors"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"time"
 )
 
@@ -21,8 +22,23 @@ func NewGroupService(db DBClient, handler *DBHandler[*groupModel]) *GroupService
 	return &GroupService{collection, db, handler}
 }
 
+// checkName returns a Conflict error when a group other than id has the name among the groups nested directly within
+// parentId, or among the top level groups when parentId is zero
+func (p *GroupService) checkName(ctx context.Context, name string, parentId primitive.ObjectID, id primitive.ObjectID) error {
+	gms, err := p.handler.FindMany(ctx, &groupModel{Name: name})
+	if err != nil {
+		return err
+	}
+	for _, gm := range gms {
+		if gm.ParentId == parentId && gm.Id != id {
+			return utilities.Conflict("group", name, "group name exists")
+		}
+	}
+	return nil
+}
+
 // GroupCreate is used to create a new user group
-func (p *GroupService) GroupCreate(g *models.Group) (*models.Group, error) {
+func (p *GroupService) GroupCreate(ctx context.Context, g *models.Group) (*models.Group, error) {
 	err := g.Validate("create")
 	if err != nil {
 		return nil, err
@@ -31,11 +47,10 @@ func (p *GroupService) GroupCreate(g *models.Group) (*models.Group, error) {
 	if err != nil {
 		return nil, err
 	}
-	_, err = p.handler.FindOne(&groupModel{Name: gm.Name})
-	if err == nil {
-		return nil, errors.New("group name exists")
+	if err = p.checkName(ctx, gm.Name, gm.ParentId, gm.Id); err != nil {
+		return nil, err
 	}
-	gm, err = p.handler.InsertOne(gm)
+	gm, err = p.handler.InsertOne(ctx, gm)
 	if err != nil {
 		return nil, err
 	}
@@ -43,13 +58,13 @@ func (p *GroupService) GroupCreate(g *models.Group) (*models.Group, error) {
 }
 
 // GroupsFind is used to find all group docs in a MongoDB Collection
-func (p *GroupService) GroupsFind(g *models.Group) ([]*models.Group, error) {
+func (p *GroupService) GroupsFind(ctx context.Context, g *models.Group) ([]*models.Group, error) {
 	var groups []*models.Group
 	m, err := newGroupModel(g)
 	if err != nil {
 		return groups, err
 	}
-	gms, err := p.handler.FindMany(m)
+	gms, err := p.handler.FindMany(ctx, m)
 	if err != nil {
 		return groups, err
 	}
@@ -60,25 +75,25 @@ func (p *GroupService) GroupsFind(g *models.Group) ([]*models.Group, error) {
 }
 
 // GroupFind is used to find a specific group doc
-func (p *GroupService) GroupFind(g *models.Group) (*models.Group, error) {
+func (p *GroupService) GroupFind(ctx context.Context, g *models.Group) (*models.Group, error) {
 	gm, err := newGroupModel(g)
 	if err != nil {
 		return nil, err
 	}
-	gm, err = p.handler.FindOne(gm)
+	gm, err = p.handler.FindOne(ctx, gm)
 	if err != nil {
-		return nil, err
+		return nil, lookupErr(err, "group", g.Id)
 	}
 	return gm.toRoot(), err
 }
 
 // GroupDelete is used to delete a group doc
-func (p *GroupService) GroupDelete(g *models.Group) (*models.Group, error) {
+func (p *GroupService) GroupDelete(ctx context.Context, g *models.Group) (*models.Group, error) {
 	gm, err := newGroupModel(g)
 	if err != nil {
 		return nil, err
 	}
-	gm, err = p.handler.DeleteOne(gm)
+	gm, err = p.handler.DeleteOne(ctx, gm)
 	if err != nil {
 		return nil, err
 	}
@@ -86,12 +101,12 @@ func (p *GroupService) GroupDelete(g *models.Group) (*models.Group, error) {
 }
 
 // GroupDeleteMany is used to delete many Groups
-func (p *GroupService) GroupDeleteMany(g *models.Group) (*models.Group, error) {
+func (p *GroupService) GroupDeleteMany(ctx context.Context, g *models.Group) (*models.Group, error) {
 	gm, err := newGroupModel(g)
 	if err != nil {
 		return nil, err
 	}
-	gm, err = p.handler.DeleteMany(gm)
+	gm, err = p.handler.DeleteMany(ctx, gm)
 	if err != nil {
 		return nil, err
 	}
@@ -99,19 +114,13 @@ func (p *GroupService) GroupDeleteMany(g *models.Group) (*models.Group, error) {
 }
 
 // GroupUpdate is used to update an existing group
-func (p *GroupService) GroupUpdate(g *models.Group) (*models.Group, error) {
+func (p *GroupService) GroupUpdate(ctx context.Context, g *models.Group) (*models.Group, error) {
 	var filter models.Group
 	err := g.Validate("create")
 	if err != nil {
-		return nil, errors.New("missing valid query filter")
+		return nil, utilities.InvalidArgument("missing valid query filter").Wrap(err)
 	}
 	filter.Id = g.Id
-	if g.Name != "" {
-		reDoc, err := p.handler.FindOne(&groupModel{Name: g.Name})
-		if err == nil && reDoc.toRoot().Id != filter.Id {
-			return nil, errors.New("group name exists")
-		}
-	}
 	f, err := newGroupModel(&filter)
 	if err != nil {
 		return nil, err
@@ -120,21 +129,127 @@ func (p *GroupService) GroupUpdate(g *models.Group) (*models.Group, error) {
 	if err != nil {
 		return nil, err
 	}
-	_, groupErr := p.handler.FindOne(f)
+	cur, groupErr := p.handler.FindOne(ctx, f)
 	if groupErr != nil {
-		return nil, errors.New("group not found")
+		return nil, lookupErr(groupErr, "group", g.Id)
 	}
-	gm, err = p.handler.UpdateOne(f, gm)
+	if g.Name != "" {
+		if err = p.checkName(ctx, g.Name, cur.ParentId, cur.Id); err != nil {
+			return nil, err
+		}
+	}
+	gm, err = p.handler.UpdateOne(ctx, f, gm)
 	return gm.toRoot(), err
 }
 
+// GroupUpdateSettings is used to replace the settings of a group, which are removed when they are all unset
+func (p *GroupService) GroupUpdateSettings(ctx context.Context, g *models.Group) (*models.Group, error) {
+	gm, err := newGroupModel(&models.Group{Id: g.Id, Settings: g.Settings})
+	if err != nil {
+		return nil, err
+	}
+	if gm.Id.IsZero() {
+		return nil, utilities.MissingFields("group", []string{"id"})
+	}
+	if _, err = p.GroupFind(ctx, &models.Group{Id: g.Id}); err != nil {
+		return nil, err
+	}
+	set := bson.D{{"last_modified", time.Now().UTC()}}
+	update := bson.D{{"$set", set}}
+	if gm.Settings == nil {
+		update = append(update, bson.E{Key: "$unset", Value: bson.D{{"settings", ""}}})
+	} else {
+		update[0].Value = append(set, bson.E{Key: "settings", Value: gm.Settings})
+	}
+	_, err = p.collection.UpdateOne(ctx, bson.D{{"_id", gm.Id}}, update)
+	if err != nil {
+		return nil, lookupErr(err, "group", g.Id)
+	}
+	return p.GroupFind(ctx, &models.Group{Id: g.Id})
+}
+
+// GroupMove is used to move a group under a new parent group, or to the top level when its ParentId is empty
+func (p *GroupService) GroupMove(ctx context.Context, g *models.Group) (*models.Group, error) {
+	gm, err := newGroupModel(&models.Group{Id: g.Id, ParentId: g.ParentId})
+	if err != nil {
+		return nil, err
+	}
+	if gm.Id.IsZero() {
+		return nil, utilities.MissingFields("group", []string{"id"})
+	}
+	cur, err := p.GroupFind(ctx, &models.Group{Id: g.Id})
+	if err != nil {
+		return nil, err
+	}
+	if err = p.checkName(ctx, cur.Name, gm.ParentId, gm.Id); err != nil {
+		return nil, err
+	}
+	set := bson.D{{"last_modified", time.Now().UTC()}}
+	update := bson.D{{"$set", set}}
+	if gm.ParentId.IsZero() {
+		update = append(update, bson.E{Key: "$unset", Value: bson.D{{"parent_id", ""}}})
+	} else {
+		update[0].Value = append(set, bson.E{Key: "parent_id", Value: gm.ParentId})
+	}
+	_, err = p.collection.UpdateOne(ctx, bson.D{{"_id", gm.Id}}, update)
+	if err != nil {
+		return nil, lookupErr(err, "group", g.Id)
+	}
+	return p.GroupFind(ctx, &models.Group{Id: g.Id})
+}
+
+// GroupAncestors is used to find the groups a group is nested within, nearest first
+func (p *GroupService) GroupAncestors(ctx context.Context, g *models.Group) ([]*models.Group, error) {
+	var ancestors []*models.Group
+	seen := map[string]bool{g.Id: true}
+	for parentId := g.ParentId; parentId != "" && !seen[parentId]; {
+		parent, err := p.GroupFind(ctx, &models.Group{Id: parentId})
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, parent)
+		seen[parentId] = true
+		parentId = parent.ParentId
+	}
+	return ancestors, nil
+}
+
+// GroupDescendants is used to find the groups nested within a group, level by level
+func (p *GroupService) GroupDescendants(ctx context.Context, g *models.Group) ([]*models.Group, error) {
+	var descendants []*models.Group
+	seen := map[string]bool{g.Id: true}
+	for level := []string{g.Id}; len(level) > 0; {
+		var next []string
+		for _, id := range level {
+			gm, err := newGroupModel(&models.Group{ParentId: id})
+			if err != nil {
+				return nil, err
+			}
+			children, err := p.handler.FindMany(ctx, gm)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range children {
+				child := c.toRoot()
+				if !seen[child.Id] {
+					seen[child.Id] = true
+					descendants = append(descendants, child)
+					next = append(next, child.Id)
+				}
+			}
+		}
+		level = next
+	}
+	return descendants, nil
+}
+
 // GroupDocInsert is used to insert a group doc directly into mongodb for testing purposes
-func (p *GroupService) GroupDocInsert(g *models.Group) (*models.Group, error) {
+func (p *GroupService) GroupDocInsert(ctx context.Context, g *models.Group) (*models.Group, error) {
 	insertGroup, err := newGroupModel(g)
 	if err != nil {
 		return nil, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	_, err = p.collection.InsertOne(ctx, insertGroup)
 	if err != nil {
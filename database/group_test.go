@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	"reflect"
@@ -34,7 +35,7 @@ func Test_GroupCreate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := initTestGroupService()
 			//fmt.Println("\n\nPRE CREATE: ", tt.group)
-			got, err := testService.GroupCreate(tt.group)
+			got, err := testService.GroupCreate(context.Background(), tt.group)
 			//fmt.Println("\nPOST CREATE: ", got)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
@@ -85,7 +86,7 @@ func Test_GroupsFind(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestGroups()
-			got, err := testService.GroupsFind(tt.group)
+			got, err := testService.GroupsFind(context.Background(), tt.group)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GroupService.GroupsFind() error = %v, wantErr %v", err, tt.wantErr)
@@ -118,7 +119,7 @@ func Test_GroupFind(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestGroups()
-			got, err := testService.GroupFind(tt.group)
+			got, err := testService.GroupFind(context.Background(), tt.group)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GroupService.GroupFind() error = %v, wantErr %v", err, tt.wantErr)
@@ -164,7 +165,7 @@ func Test_GroupUpdate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestGroups()
-			got, err := testService.GroupUpdate(tt.group)
+			got, err := testService.GroupUpdate(context.Background(), tt.group)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GroupService.GroupUpdate() error = %v, wantErr %v", err, tt.wantErr)
@@ -214,7 +215,7 @@ func Test_GroupDelete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestGroups()
-			got, err := testService.GroupDelete(tt.group)
+			got, err := testService.GroupDelete(context.Background(), tt.group)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GroupService.GroupDelete() error = %v, wantErr %v", err, tt.wantErr)
@@ -237,3 +238,169 @@ func Test_GroupDelete(t *testing.T) {
 		})
 	}
 }
+
+func Test_GroupHierarchy(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string   // The name of the test
+		want    []string // The ids of the groups we want our function to return
+		wantErr bool     // whether we want an error.
+		call    func(gs *GroupService) ([]*models.Group, error)
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"ancestors nearest first",
+			[]string{"000000000000000000000004", "000000000000000000000002"},
+			false,
+			func(gs *GroupService) ([]*models.Group, error) {
+				return gs.GroupAncestors(context.Background(), &models.Group{Id: "000000000000000000000005", ParentId: "000000000000000000000004"})
+			},
+		},
+		{
+			"descendants level by level",
+			[]string{"000000000000000000000004", "000000000000000000000005"},
+			false,
+			func(gs *GroupService) ([]*models.Group, error) {
+				return gs.GroupDescendants(context.Background(), &models.Group{Id: "000000000000000000000002"})
+			},
+		},
+		{
+			"move to another parent",
+			[]string{"000000000000000000000005"},
+			false,
+			func(gs *GroupService) ([]*models.Group, error) {
+				_, err := gs.GroupMove(context.Background(), &models.Group{Id: "000000000000000000000005", ParentId: "000000000000000000000003"})
+				if err != nil {
+					return nil, err
+				}
+				return gs.GroupDescendants(context.Background(), &models.Group{Id: "000000000000000000000003"})
+			},
+		},
+		{
+			"move to top level",
+			[]string{},
+			false,
+			func(gs *GroupService) ([]*models.Group, error) {
+				g, err := gs.GroupMove(context.Background(), &models.Group{Id: "000000000000000000000004"})
+				if err != nil {
+					return nil, err
+				}
+				if g.ParentId != "" {
+					return nil, fmt.Errorf("parent_id = %q, want none", g.ParentId)
+				}
+				return gs.GroupAncestors(context.Background(), g)
+			},
+		},
+		{
+			"same name in another parent",
+			[]string{"000000000000000000000006"},
+			false,
+			func(gs *GroupService) ([]*models.Group, error) {
+				_, err := gs.GroupCreate(context.Background(), &models.Group{Id: "000000000000000000000006", Name: "test5", ParentId: "000000000000000000000003"})
+				if err != nil {
+					return nil, err
+				}
+				return gs.GroupDescendants(context.Background(), &models.Group{Id: "000000000000000000000003"})
+			},
+		},
+		{
+			"same name in the same parent",
+			nil,
+			true,
+			func(gs *GroupService) ([]*models.Group, error) {
+				_, err := gs.GroupCreate(context.Background(), &models.Group{Id: "000000000000000000000006", Name: "test4", ParentId: "000000000000000000000002"})
+				return nil, err
+			},
+		},
+		{
+			"move next to a group of the same name",
+			nil,
+			true,
+			func(gs *GroupService) ([]*models.Group, error) {
+				_, err := gs.GroupCreate(context.Background(), &models.Group{Id: "000000000000000000000006", Name: "test5", ParentId: "000000000000000000000003"})
+				if err != nil {
+					return nil, err
+				}
+				_, err = gs.GroupMove(context.Background(), &models.Group{Id: "000000000000000000000005", ParentId: "000000000000000000000003"})
+				return nil, err
+			},
+		},
+		{
+			"move not found",
+			nil,
+			true,
+			func(gs *GroupService) ([]*models.Group, error) {
+				_, err := gs.GroupMove(context.Background(), &models.Group{Id: "000000000000000000000092"})
+				return nil, err
+			},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestGroupHierarchy()
+			got, err := tt.call(testService)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GroupService %s error = %v, wantErr %v", tt.name, err, tt.wantErr)
+				return
+			}
+			gotIds := make([]string, 0, len(got))
+			for _, g := range got {
+				gotIds = append(gotIds, g.Id)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(gotIds, tt.want) { // Asserting whether we get the correct wanted value
+				t.Errorf("GroupService %s = %v, want %v", tt.name, gotIds, tt.want)
+			}
+		})
+	}
+}
+
+func Test_GroupUpdateSettings(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string        // The name of the test
+		want    *models.Group // What out instance we want our function to return.
+		wantErr bool          // whether we want an error.
+		group   *models.Group // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"set settings",
+			&models.Group{Id: "000000000000000000000002", Settings: models.GroupSettings{Timezone: "Europe/Paris", MemberLimit: 5, AllowedStatuses: []string{"NOT_STARTED"}}},
+			false,
+			&models.Group{Id: "000000000000000000000002", Settings: models.GroupSettings{Timezone: "Europe/Paris", MemberLimit: 5, AllowedStatuses: []string{"NOT_STARTED"}}},
+		},
+		{
+			"clear settings",
+			&models.Group{Id: "000000000000000000000002"},
+			false,
+			&models.Group{Id: "000000000000000000000002"},
+		},
+		{
+			"not found",
+			nil,
+			true,
+			&models.Group{Id: "000000000000000000000092", Settings: models.GroupSettings{MemberLimit: 5}},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestGroups()
+			_, err := testService.GroupUpdateSettings(context.Background(), &models.Group{Id: "000000000000000000000002", Settings: models.GroupSettings{AdminOnlyTasks: true}})
+			if err != nil {
+				t.Fatalf("GroupService.GroupUpdateSettings() setup error = %v", err)
+			}
+			got, err := testService.GroupUpdateSettings(context.Background(), tt.group)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GroupService.GroupUpdateSettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !reflect.DeepEqual(got.Settings, tt.want.Settings) { // Asserting whether we get the correct wanted value
+				t.Errorf("GroupService.GroupUpdateSettings() = %v, want %v", got.Settings, tt.want.Settings)
+			}
+		})
+	}
+}
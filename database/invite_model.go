@@ -0,0 +1,182 @@
+package database
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"strings"
+	"time"
+)
+
+// inviteModel structures a group invitation BSON document to save in the invites collection
+type inviteModel struct {
+	Id           primitive.ObjectID `bson:"_id,omitempty"`
+	GroupId      primitive.ObjectID `bson:"group_id,omitempty"`
+	CodeHash     string             `bson:"code_hash,omitempty"`
+	Email        string             `bson:"email,omitempty"`
+	Role         string             `bson:"role,omitempty"`
+	MaxUses      int                `bson:"max_uses,omitempty"`
+	Uses         int                `bson:"uses,omitempty"`
+	CreatedBy    primitive.ObjectID `bson:"created_by,omitempty"`
+	ExpiresAt    time.Time          `bson:"expires_at,omitempty"`
+	LastModified time.Time          `bson:"last_modified,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at,omitempty"`
+}
+
+// newInviteModel initializes a new pointer to an inviteModel struct from a pointer to a JSON Invite struct; a plain
+// Code is stored as its hash
+func newInviteModel(u *models.Invite) (um *inviteModel, err error) {
+	um = &inviteModel{
+		CodeHash:     u.CodeHash,
+		Email:        u.Email,
+		Role:         u.Role,
+		MaxUses:      u.MaxUses,
+		Uses:         u.Uses,
+		ExpiresAt:    u.ExpiresAt,
+		LastModified: u.LastModified,
+		CreatedAt:    u.CreatedAt,
+	}
+	if u.Code != "" {
+		um.CodeHash = utilities.HashSecret(strings.ToUpper(u.Code))
+	}
+	if u.Id != "" && u.Id != "000000000000000000000000" {
+		um.Id, err = primitive.ObjectIDFromHex(u.Id)
+	}
+	if u.GroupId != "" && u.GroupId != "000000000000000000000000" {
+		um.GroupId, err = primitive.ObjectIDFromHex(u.GroupId)
+	}
+	if u.CreatedBy != "" && u.CreatedBy != "000000000000000000000000" {
+		um.CreatedBy, err = primitive.ObjectIDFromHex(u.CreatedBy)
+	}
+	return
+}
+
+// update the inviteModel using an overwrite bson.D doc
+func (u *inviteModel) update(doc interface{}) (err error) {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return
+	}
+	um := inviteModel{}
+	err = bson.Unmarshal(data, &um)
+	if um.Uses > 0 {
+		u.Uses = um.Uses
+	}
+	if !um.LastModified.IsZero() {
+		u.LastModified = um.LastModified
+	}
+	return
+}
+
+// bsonLoad loads a bson doc into the inviteModel
+func (u *inviteModel) bsonLoad(doc bson.D) (err error) {
+	bData, err := bsonMarshall(doc)
+	if err != nil {
+		return err
+	}
+	err = bson.Unmarshal(bData, u)
+	return err
+}
+
+// match compares an input bson doc and returns whether there's a match with the inviteModel
+func (u *inviteModel) match(doc interface{}) bool {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return false
+	}
+	um := inviteModel{}
+	err = bson.Unmarshal(data, &um)
+	if !um.Id.IsZero() {
+		return u.Id == um.Id
+	}
+	if um.CodeHash != "" {
+		return u.CodeHash == um.CodeHash
+	}
+	if !um.GroupId.IsZero() {
+		return u.GroupId == um.GroupId
+	}
+	return false
+}
+
+// getID returns the unique identifier of the inviteModel
+func (u *inviteModel) getID() (id interface{}) {
+	return u.Id
+}
+
+// addTimeStamps updates an inviteModel struct with a timestamp
+func (u *inviteModel) addTimeStamps(newRecord bool) {
+	currentTime := time.Now().UTC()
+	u.LastModified = currentTime
+	if newRecord {
+		u.CreatedAt = currentTime
+	}
+}
+
+// addObjectID checks if an inviteModel has a value assigned for Id if no value a new one is generated and assigned
+func (u *inviteModel) addObjectID() {
+	if u.Id.Hex() == "" || u.Id.Hex() == "000000000000000000000000" {
+		u.Id = primitive.NewObjectID()
+	}
+}
+
+// postProcess updates an inviteModel struct after it is read from or written to the db
+func (u *inviteModel) postProcess() (err error) {
+	return
+}
+
+// toDoc converts the bson inviteModel into a bson.D
+func (u *inviteModel) toDoc() (doc bson.D, err error) {
+	data, err := bson.Marshal(u)
+	if err != nil {
+		return
+	}
+	err = bson.Unmarshal(data, &doc)
+	return
+}
+
+// bsonFilter generates a bson filter for MongoDB queries from the inviteModel data
+func (u *inviteModel) bsonFilter() (doc bson.D, err error) {
+	if !u.Id.IsZero() {
+		doc = bson.D{{Key: "_id", Value: u.Id}}
+	} else if u.CodeHash != "" {
+		doc = bson.D{{Key: "code_hash", Value: u.CodeHash}}
+	} else if !u.GroupId.IsZero() {
+		doc = bson.D{{Key: "group_id", Value: u.GroupId}}
+	}
+	return
+}
+
+// bsonUpdate generates a bson update for MongoDB queries from the inviteModel data
+func (u *inviteModel) bsonUpdate() (doc bson.D, err error) {
+	inner, err := u.toDoc()
+	if err != nil {
+		return
+	}
+	doc = bson.D{{Key: "$set", Value: inner}}
+	return
+}
+
+// toRoot creates and return a new pointer to an Invite JSON struct from a pointer to a BSON inviteModel
+func (u *inviteModel) toRoot() *models.Invite {
+	return &models.Invite{
+		Id:           u.Id.Hex(),
+		GroupId:      u.GroupId.Hex(),
+		CodeHash:     u.CodeHash,
+		Email:        u.Email,
+		Role:         u.Role,
+		MaxUses:      u.MaxUses,
+		Uses:         u.Uses,
+		CreatedBy:    u.CreatedBy.Hex(),
+		ExpiresAt:    u.ExpiresAt,
+		LastModified: u.LastModified,
+		CreatedAt:    u.CreatedAt,
+	}
+}
+
+func rootInvites(ms []*inviteModel) (invites []*models.Invite) {
+	for _, m := range ms {
+		invites = append(invites, m.toRoot())
+	}
+	return
+}
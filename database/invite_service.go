@@ -0,0 +1,154 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+)
+
+// InviteService is used by the app to manage the invitations to join a group
+type InviteService struct {
+	collection DBCollection
+	db         DBClient
+	handler    *DBHandler[*inviteModel]
+}
+
+// NewInviteService is an exported function used to initialize a new InviteService struct
+func NewInviteService(db DBClient, handler *DBHandler[*inviteModel]) *InviteService {
+	collection := db.GetCollection("invites")
+	return &InviteService{collection, db, handler}
+}
+
+// InviteCreate is used to create a new Invite with a generated code, which is only returned by this call
+func (p *InviteService) InviteCreate(ctx context.Context, g *models.Invite) (*models.Invite, error) {
+	err := g.Validate("create")
+	if err != nil {
+		return nil, err
+	}
+	g.Id, g.Uses = "", 0
+	g.Code, err = utilities.GenerateCode()
+	if err != nil {
+		return nil, err
+	}
+	im, err := newInviteModel(g)
+	if err != nil {
+		return nil, err
+	}
+	im, err = p.handler.InsertOne(ctx, im)
+	if err != nil {
+		return nil, err
+	}
+	invite := im.toRoot()
+	invite.Code = g.Code
+	return invite, nil
+}
+
+// InviteFind is used to find a specific Invite doc by its id or by its code
+func (p *InviteService) InviteFind(ctx context.Context, g *models.Invite) (*models.Invite, error) {
+	if !g.CheckID("id") && g.Code == "" {
+		return nil, utilities.MissingFields("invite", []string{"id", "code"})
+	}
+	im, err := newInviteModel(&models.Invite{Id: g.Id, Code: g.Code})
+	if err != nil {
+		return nil, err
+	}
+	im, err = p.handler.FindOne(ctx, im)
+	if err != nil {
+		return nil, lookupErr(err, "invite", g.Id)
+	}
+	return im.toRoot(), nil
+}
+
+// InviteUse is used to count a use of an Invite
+func (p *InviteService) InviteUse(ctx context.Context, g *models.Invite) (*models.Invite, error) {
+	f, err := newInviteModel(&models.Invite{Id: g.Id})
+	if err != nil {
+		return nil, err
+	}
+	if f.Id.IsZero() {
+		return nil, utilities.MissingFields("invite", []string{"id"})
+	}
+	cur, err := p.handler.FindOne(ctx, f)
+	if err != nil {
+		return nil, lookupErr(err, "invite", g.Id)
+	}
+	cur.Uses++
+	im, err := p.handler.UpdateOne(ctx, f, cur)
+	if err != nil {
+		return nil, err
+	}
+	return im.toRoot(), nil
+}
+
+// InviteDelete is used to delete an Invite doc
+func (p *InviteService) InviteDelete(ctx context.Context, g *models.Invite) (*models.Invite, error) {
+	im, err := newInviteModel(&models.Invite{Id: g.Id})
+	if err != nil {
+		return nil, err
+	}
+	if im.Id.IsZero() {
+		return nil, utilities.MissingFields("invite", []string{"id"})
+	}
+	im, err = p.handler.DeleteOne(ctx, im)
+	if err != nil {
+		return nil, lookupErr(err, "invite", g.Id)
+	}
+	return im.toRoot(), nil
+}
+
+// InviteDeleteMany is used to delete every Invite of a group
+func (p *InviteService) InviteDeleteMany(ctx context.Context, g *models.Invite) (*models.Invite, error) {
+	if !g.CheckID("group_id") {
+		return nil, utilities.InvalidArgument("filter id cannot be empty for mass delete")
+	}
+	im, err := newInviteModel(&models.Invite{GroupId: g.GroupId})
+	if err != nil {
+		return nil, err
+	}
+	im, err = p.handler.DeleteMany(ctx, im)
+	if err != nil {
+		return nil, err
+	}
+	return im.toRoot(), nil
+}
+
+// InvitesQuery is used for a paginated search of the Invites of a group
+func (p *InviteService) InvitesQuery(ctx context.Context, g *models.Invite, pagination *utilities.Pagination) (*models.InvitesRes, error) {
+	if !g.CheckID("group_id") {
+		return nil, utilities.MissingFields("invite", []string{"group_id"})
+	}
+	im, err := newInviteModel(&models.Invite{GroupId: g.GroupId})
+	if err != nil {
+		return nil, err
+	}
+	f, err := im.bsonFilter()
+	if err != nil {
+		return nil, err
+	}
+	count, err := p.collection.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return &models.InvitesRes{
+			TotalCount: 0,
+			TotalPages: 0,
+			Page:       0,
+			Size:       0,
+			HasMore:    false,
+			Invites:    make([]*models.Invite, 0),
+		}, nil
+	}
+	ims, err := p.handler.PaginatedFind(ctx, im, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &models.InvitesRes{
+		TotalCount: count,
+		TotalPages: int64(pagination.GetTotalPages(int(count))),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(int(count)),
+		Invites:    rootInvites(ims),
+	}, nil
+}
@@ -0,0 +1,139 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"google.golang.org/grpc/codes"
+	"testing"
+	"time"
+)
+
+func Test_InviteCreate(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name   string         // The name of the test
+		want   string         // The role we want the invite created with
+		code   codes.Code     // The status code of the error we want
+		invite *models.Invite // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"default role and expiry",
+			"member",
+			codes.OK,
+			&models.Invite{GroupId: "000000000000000000000002"},
+		},
+		{
+			"admin email invite",
+			"admin",
+			codes.OK,
+			&models.Invite{GroupId: "000000000000000000000002", Email: "new@test.com", Role: "admin", MaxUses: 1},
+		},
+		{
+			"expired",
+			"",
+			codes.InvalidArgument,
+			&models.Invite{GroupId: "000000000000000000000002", ExpiresAt: time.Now().Add(-time.Minute)},
+		},
+		{
+			"missing group",
+			"",
+			codes.InvalidArgument,
+			&models.Invite{},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestInvites()
+			got, err := testService.InviteCreate(context.Background(), tt.invite)
+			// Checking the error
+			if errCode(err) != tt.code {
+				t.Errorf("InviteService.InviteCreate() error = %v, want %v", err, tt.code)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.Code == "" || got.Role != tt.want || got.ExpiresAt.IsZero() {
+				t.Errorf("InviteService.InviteCreate() = %v, want role %v with a code and an expiry", got, tt.want)
+			}
+			found, err := testService.InviteFind(context.Background(), &models.Invite{Code: got.Code})
+			if err != nil || found.Id != got.Id || found.Code != "" {
+				t.Errorf("InviteService.InviteFind() = %v, %v, want %v without its code", found, err, got.Id)
+			}
+		})
+	}
+}
+
+func Test_InviteFind(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name   string         // The name of the test
+		want   string         // The id of the invite we want found
+		code   codes.Code     // The status code of the error we want
+		invite *models.Invite // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"by code",
+			"000000000000000000000061",
+			codes.OK,
+			&models.Invite{Code: "TESTINVITECODEMEMBER0001"},
+		},
+		{
+			"by lower case code",
+			"000000000000000000000061",
+			codes.OK,
+			&models.Invite{Code: "testinvitecodemember0001"},
+		},
+		{
+			"by id",
+			"000000000000000000000062",
+			codes.OK,
+			&models.Invite{Id: "000000000000000000000062"},
+		},
+		{
+			"unknown code",
+			"",
+			codes.NotFound,
+			&models.Invite{Code: "UNKNOWNCODE"},
+		},
+		{
+			"missing filter",
+			"",
+			codes.InvalidArgument,
+			&models.Invite{GroupId: "000000000000000000000002"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestInvites()
+			got, err := testService.InviteFind(context.Background(), tt.invite)
+			// Checking the error
+			if errCode(err) != tt.code {
+				t.Errorf("InviteService.InviteFind() error = %v, want %v", err, tt.code)
+				return
+			}
+			if err == nil && got.Id != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("InviteService.InviteFind() = %v, want %v", got.Id, tt.want)
+			}
+		})
+	}
+}
+
+func Test_InviteUse(t *testing.T) {
+	testService := setupTestInvites()
+	invite := &models.Invite{Id: "000000000000000000000061"}
+	for want := 1; want <= 2; want++ {
+		got, err := testService.InviteUse(context.Background(), invite)
+		if err != nil || got.Uses != want {
+			t.Errorf("InviteService.InviteUse() = %v, %v, want %v uses", got, err, want)
+		}
+	}
+	got, _ := testService.InviteFind(context.Background(), invite)
+	if err := got.Redeemable(""); errCode(err) != codes.FailedPrecondition {
+		t.Errorf("Invite.Redeemable() error = %v, want %v", err, codes.FailedPrecondition)
+	}
+}
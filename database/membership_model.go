@@ -0,0 +1,168 @@
+package database
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// membershipModel structures a group membership BSON document to save in the memberships collection
+type membershipModel struct {
+	Id           primitive.ObjectID `bson:"_id,omitempty"`
+	UserId       primitive.ObjectID `bson:"user_id,omitempty"`
+	GroupId      primitive.ObjectID `bson:"group_id,omitempty"`
+	Role         string             `bson:"role,omitempty"`
+	LastModified time.Time          `bson:"last_modified,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at,omitempty"`
+}
+
+// newMembershipModel initializes a new pointer to a membershipModel struct from a pointer to a JSON Membership struct
+func newMembershipModel(u *models.Membership) (um *membershipModel, err error) {
+	um = &membershipModel{
+		Role:         u.Role,
+		LastModified: u.LastModified,
+		CreatedAt:    u.CreatedAt,
+	}
+	if u.Id != "" && u.Id != "000000000000000000000000" {
+		um.Id, err = primitive.ObjectIDFromHex(u.Id)
+	}
+	if u.UserId != "" && u.UserId != "000000000000000000000000" {
+		um.UserId, err = primitive.ObjectIDFromHex(u.UserId)
+	}
+	if u.GroupId != "" && u.GroupId != "000000000000000000000000" {
+		um.GroupId, err = primitive.ObjectIDFromHex(u.GroupId)
+	}
+	return
+}
+
+// update the membershipModel using an overwrite bson.D doc
+func (u *membershipModel) update(doc interface{}) (err error) {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return
+	}
+	um := membershipModel{}
+	err = bson.Unmarshal(data, &um)
+	if len(um.Role) > 0 {
+		u.Role = um.Role
+	}
+	if !um.LastModified.IsZero() {
+		u.LastModified = um.LastModified
+	}
+	return
+}
+
+// bsonLoad loads a bson doc into the membershipModel
+func (u *membershipModel) bsonLoad(doc bson.D) (err error) {
+	bData, err := bsonMarshall(doc)
+	if err != nil {
+		return err
+	}
+	err = bson.Unmarshal(bData, u)
+	return err
+}
+
+// match compares an input bson doc and returns whether there's a match with the membershipModel; the user_id and
+// group_id of the doc must both match when both are set
+func (u *membershipModel) match(doc interface{}) bool {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return false
+	}
+	um := membershipModel{}
+	err = bson.Unmarshal(data, &um)
+	if !um.Id.IsZero() {
+		return u.Id == um.Id
+	}
+	if um.UserId.IsZero() && um.GroupId.IsZero() {
+		return false
+	}
+	if !um.UserId.IsZero() && u.UserId != um.UserId {
+		return false
+	}
+	if !um.GroupId.IsZero() && u.GroupId != um.GroupId {
+		return false
+	}
+	return true
+}
+
+// getID returns the unique identifier of the membershipModel
+func (u *membershipModel) getID() (id interface{}) {
+	return u.Id
+}
+
+// addTimeStamps updates a membershipModel struct with a timestamp
+func (u *membershipModel) addTimeStamps(newRecord bool) {
+	currentTime := time.Now().UTC()
+	u.LastModified = currentTime
+	if newRecord {
+		u.CreatedAt = currentTime
+	}
+}
+
+// addObjectID checks if a membershipModel has a value assigned for Id if no value a new one is generated and assigned
+func (u *membershipModel) addObjectID() {
+	if u.Id.Hex() == "" || u.Id.Hex() == "000000000000000000000000" {
+		u.Id = primitive.NewObjectID()
+	}
+}
+
+// postProcess updates a membershipModel struct after it is read from or written to the db
+func (u *membershipModel) postProcess() (err error) {
+	return
+}
+
+// toDoc converts the bson membershipModel into a bson.D
+func (u *membershipModel) toDoc() (doc bson.D, err error) {
+	data, err := bson.Marshal(u)
+	if err != nil {
+		return
+	}
+	err = bson.Unmarshal(data, &doc)
+	return
+}
+
+// bsonFilter generates a bson filter for MongoDB queries from the membershipModel data
+func (u *membershipModel) bsonFilter() (doc bson.D, err error) {
+	if !u.Id.IsZero() {
+		return bson.D{{Key: "_id", Value: u.Id}}, nil
+	}
+	doc = bson.D{}
+	if !u.UserId.IsZero() {
+		doc = append(doc, bson.E{Key: "user_id", Value: u.UserId})
+	}
+	if !u.GroupId.IsZero() {
+		doc = append(doc, bson.E{Key: "group_id", Value: u.GroupId})
+	}
+	return
+}
+
+// bsonUpdate generates a bson update for MongoDB queries from the membershipModel data
+func (u *membershipModel) bsonUpdate() (doc bson.D, err error) {
+	inner, err := u.toDoc()
+	if err != nil {
+		return
+	}
+	doc = bson.D{{Key: "$set", Value: inner}}
+	return
+}
+
+// toRoot creates and return a new pointer to a Membership JSON struct from a pointer to a BSON membershipModel
+func (u *membershipModel) toRoot() *models.Membership {
+	return &models.Membership{
+		Id:           u.Id.Hex(),
+		UserId:       u.UserId.Hex(),
+		GroupId:      u.GroupId.Hex(),
+		Role:         u.Role,
+		LastModified: u.LastModified,
+		CreatedAt:    u.CreatedAt,
+	}
+}
+
+func rootMemberships(ms []*membershipModel) (memberships []*models.Membership) {
+	for _, m := range ms {
+		memberships = append(memberships, m.toRoot())
+	}
+	return
+}
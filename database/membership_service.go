@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+)
+
+// MembershipService is used by the app to manage the memberships of users in groups other than their primary group
+type MembershipService struct {
+	collection DBCollection
+	db         DBClient
+	handler    *DBHandler[*membershipModel]
+}
+
+// NewMembershipService is an exported function used to initialize a new MembershipService struct
+func NewMembershipService(db DBClient, handler *DBHandler[*membershipModel]) *MembershipService {
+	collection := db.GetCollection("memberships")
+	return &MembershipService{collection, db, handler}
+}
+
+// MembershipCreate is used to add a User to a group with a role
+func (p *MembershipService) MembershipCreate(ctx context.Context, g *models.Membership) (*models.Membership, error) {
+	err := g.Validate("create")
+	if err != nil {
+		return nil, err
+	}
+	mm, err := newMembershipModel(g)
+	if err != nil {
+		return nil, err
+	}
+	_, err = p.handler.FindOne(ctx, &membershipModel{UserId: mm.UserId, GroupId: mm.GroupId})
+	if err == nil {
+		return nil, utilities.Conflict("membership", g.UserId, "user is already a member of the group")
+	}
+	mm, err = p.handler.InsertOne(ctx, mm)
+	if err != nil {
+		return nil, err
+	}
+	return mm.toRoot(), nil
+}
+
+// MembershipFind is used to find a specific Membership doc
+func (p *MembershipService) MembershipFind(ctx context.Context, g *models.Membership) (*models.Membership, error) {
+	mm, err := newMembershipModel(g)
+	if err != nil {
+		return nil, err
+	}
+	mm, err = p.handler.FindOne(ctx, mm)
+	if err != nil {
+		return nil, lookupErr(err, "membership", g.UserId)
+	}
+	return mm.toRoot(), nil
+}
+
+// MembershipsFind is used to find the Memberships of a user or of a group
+func (p *MembershipService) MembershipsFind(ctx context.Context, g *models.Membership) ([]*models.Membership, error) {
+	if !g.CheckID("user_id") && !g.CheckID("group_id") {
+		return nil, utilities.MissingFields("membership", []string{"user_id", "group_id"})
+	}
+	mm, err := newMembershipModel(g)
+	if err != nil {
+		return nil, err
+	}
+	mms, err := p.handler.FindMany(ctx, mm)
+	if err != nil {
+		return nil, err
+	}
+	return rootMemberships(mms), nil
+}
+
+// MembershipUpdate is used to change the role of a User in a group
+func (p *MembershipService) MembershipUpdate(ctx context.Context, g *models.Membership) (*models.Membership, error) {
+	err := g.Validate("update")
+	if err != nil {
+		return nil, err
+	}
+	f, err := newMembershipModel(&models.Membership{UserId: g.UserId, GroupId: g.GroupId})
+	if err != nil {
+		return nil, err
+	}
+	cur, err := p.handler.FindOne(ctx, f)
+	if err != nil {
+		return nil, lookupErr(err, "membership", g.UserId)
+	}
+	cur.Role = g.Role
+	mm, err := p.handler.UpdateOne(ctx, &membershipModel{Id: cur.Id}, cur)
+	if err != nil {
+		return nil, err
+	}
+	return mm.toRoot(), nil
+}
+
+// MembershipDelete is used to remove a User from a group
+func (p *MembershipService) MembershipDelete(ctx context.Context, g *models.Membership) (*models.Membership, error) {
+	mm, err := newMembershipModel(g)
+	if err != nil {
+		return nil, err
+	}
+	mm, err = p.handler.FindOne(ctx, mm)
+	if err != nil {
+		return nil, lookupErr(err, "membership", g.UserId)
+	}
+	mm, err = p.handler.DeleteOne(ctx, &membershipModel{Id: mm.Id})
+	if err != nil {
+		return nil, err
+	}
+	return mm.toRoot(), nil
+}
+
+// MembershipDeleteMany is used to delete every Membership of a user or of a group
+func (p *MembershipService) MembershipDeleteMany(ctx context.Context, g *models.Membership) (*models.Membership, error) {
+	if !g.CheckID("user_id") && !g.CheckID("group_id") {
+		return nil, utilities.InvalidArgument("filter id cannot be empty for mass delete")
+	}
+	mm, err := newMembershipModel(g)
+	if err != nil {
+		return nil, err
+	}
+	mm, err = p.handler.DeleteMany(ctx, mm)
+	if err != nil {
+		return nil, err
+	}
+	return mm.toRoot(), nil
+}
@@ -0,0 +1,212 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+// errCode returns the gRPC status code an error maps to, or codes.OK for a nil error
+func errCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	return utilities.ParseGRPCErrStatusCode(err)
+}
+
+func Test_MembershipCreate(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name       string             // The name of the test
+		want       string             // The role we want the membership created with
+		code       codes.Code         // The status code of the error we want
+		membership *models.Membership // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"default role",
+			"member",
+			codes.OK,
+			&models.Membership{UserId: "000000000000000000000014", GroupId: "000000000000000000000003"},
+		},
+		{
+			"admin role",
+			"admin",
+			codes.OK,
+			&models.Membership{UserId: "000000000000000000000014", GroupId: "000000000000000000000003", Role: "admin"},
+		},
+		{
+			"already a member",
+			"",
+			codes.AlreadyExists,
+			&models.Membership{UserId: "000000000000000000000013", GroupId: "000000000000000000000002"},
+		},
+		{
+			"invalid role",
+			"",
+			codes.InvalidArgument,
+			&models.Membership{UserId: "000000000000000000000014", GroupId: "000000000000000000000003", Role: "owner"},
+		},
+		{
+			"missing group",
+			"",
+			codes.InvalidArgument,
+			&models.Membership{UserId: "000000000000000000000014"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestMemberships()
+			got, err := testService.MembershipCreate(context.Background(), tt.membership)
+			// Checking the error
+			if errCode(err) != tt.code {
+				t.Errorf("MembershipService.MembershipCreate() error = %v, want %v", err, tt.code)
+				return
+			}
+			if err == nil && (got.Id == "" || got.Role != tt.want) {
+				t.Errorf("MembershipService.MembershipCreate() = %v, want role %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_MembershipsFind(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name       string             // The name of the test
+		want       int                // What out instance we want our function to return.
+		wantErr    bool               // whether we want an error.
+		membership *models.Membership // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"by user",
+			1,
+			false,
+			&models.Membership{UserId: "000000000000000000000013"},
+		},
+		{
+			"by group",
+			1,
+			false,
+			&models.Membership{GroupId: "000000000000000000000003"},
+		},
+		{
+			"by user and group",
+			0,
+			false,
+			&models.Membership{UserId: "000000000000000000000013", GroupId: "000000000000000000000003"},
+		},
+		{
+			"missing filter",
+			0,
+			true,
+			&models.Membership{},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestMemberships()
+			got, err := testService.MembershipsFind(context.Background(), tt.membership)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MembershipService.MembershipsFind() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("MembershipService.MembershipsFind() = %v, want %v", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func Test_MembershipDelete(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name       string             // The name of the test
+		code       codes.Code         // The status code of the error we want
+		membership *models.Membership // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"success",
+			codes.OK,
+			&models.Membership{UserId: "000000000000000000000013", GroupId: "000000000000000000000002"},
+		},
+		{
+			"not a member",
+			codes.NotFound,
+			&models.Membership{UserId: "000000000000000000000013", GroupId: "000000000000000000000003"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestMemberships()
+			got, err := testService.MembershipDelete(context.Background(), tt.membership)
+			// Checking the error
+			if errCode(err) != tt.code {
+				t.Errorf("MembershipService.MembershipDelete() error = %v, want %v", err, tt.code)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.GroupId != tt.membership.GroupId {
+				t.Errorf("MembershipService.MembershipDelete() = %v, want %v", got, tt.membership)
+			}
+			left, _ := testService.MembershipsFind(context.Background(), &models.Membership{UserId: tt.membership.UserId})
+			if len(left) != 0 {
+				t.Errorf("MembershipService.MembershipDelete() left %v", left)
+			}
+		})
+	}
+}
+
+func Test_MembershipUpdate(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name       string             // The name of the test
+		code       codes.Code         // The status code of the error we want
+		membership *models.Membership // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"success",
+			codes.OK,
+			&models.Membership{UserId: "000000000000000000000013", GroupId: "000000000000000000000002", Role: "admin"},
+		},
+		{
+			"not a member",
+			codes.NotFound,
+			&models.Membership{UserId: "000000000000000000000013", GroupId: "000000000000000000000003", Role: "admin"},
+		},
+		{
+			"missing role",
+			codes.InvalidArgument,
+			&models.Membership{UserId: "000000000000000000000013", GroupId: "000000000000000000000002"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestMemberships()
+			got, err := testService.MembershipUpdate(context.Background(), tt.membership)
+			// Checking the error
+			if errCode(err) != tt.code {
+				t.Errorf("MembershipService.MembershipUpdate() error = %v, want %v", err, tt.code)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.Role != tt.membership.Role || got.GroupId != tt.membership.GroupId {
+				t.Errorf("MembershipService.MembershipUpdate() = %v, want %v", got, tt.membership)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// migrationModel structures an applied migration BSON document to save in the migrations collection
+type migrationModel struct {
+	Id          primitive.ObjectID `bson:"_id,omitempty"`
+	Version     int64              `bson:"version,omitempty"`
+	Description string             `bson:"description,omitempty"`
+	AppliedAt   time.Time          `bson:"applied_at,omitempty"`
+}
+
+// newMigrationModel initializes a new pointer to a migrationModel struct from a pointer to a JSON Migration struct
+func newMigrationModel(m *models.Migration) *migrationModel {
+	return &migrationModel{
+		Version:     m.Version,
+		Description: m.Description,
+		AppliedAt:   m.AppliedAt,
+	}
+}
+
+// update is a no-op because applied migrations are only inserted and deleted
+func (u *migrationModel) update(doc interface{}) (err error) {
+	return
+}
+
+// bsonLoad loads a bson doc into the migrationModel
+func (u *migrationModel) bsonLoad(doc bson.D) (err error) {
+	bData, err := bsonMarshall(doc)
+	if err != nil {
+		return err
+	}
+	err = bson.Unmarshal(bData, u)
+	return err
+}
+
+// match compares an input bson doc and returns whether there's a match with the migrationModel
+func (u *migrationModel) match(doc interface{}) bool {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return false
+	}
+	um := migrationModel{}
+	err = bson.Unmarshal(data, &um)
+	if um.Id.Hex() != "" && um.Id.Hex() != "000000000000000000000000" {
+		return u.Id == um.Id
+	}
+	if um.Version != 0 && u.Version != um.Version {
+		return false
+	}
+	return true
+}
+
+// getID returns the unique identifier of the migrationModel
+func (u *migrationModel) getID() (id interface{}) {
+	return u.Id
+}
+
+// addTimeStamps sets the time a migrationModel was applied
+func (u *migrationModel) addTimeStamps(newRecord bool) {
+	if newRecord {
+		u.AppliedAt = time.Now().UTC()
+	}
+}
+
+// addObjectID checks if a migrationModel has a value assigned for Id if no value a new one is generated and assigned
+func (u *migrationModel) addObjectID() {
+	if u.Id.Hex() == "" || u.Id.Hex() == "000000000000000000000000" {
+		u.Id = primitive.NewObjectID()
+	}
+}
+
+// postProcess updates a migrationModel struct after it is read from or written to the db
+func (u *migrationModel) postProcess() (err error) {
+	return
+}
+
+// toDoc converts the bson migrationModel into a bson.D
+func (u *migrationModel) toDoc() (doc bson.D, err error) {
+	data, err := bson.Marshal(u)
+	if err != nil {
+		return
+	}
+	err = bson.Unmarshal(data, &doc)
+	return
+}
+
+// bsonFilter generates a bson filter for MongoDB queries from the migrationModel data
+func (u *migrationModel) bsonFilter() (doc bson.D, err error) {
+	if u.Id.Hex() != "" && u.Id.Hex() != "000000000000000000000000" {
+		doc = bson.D{{Key: "_id", Value: u.Id}}
+	} else if u.Version != 0 {
+		doc = bson.D{{Key: "version", Value: u.Version}}
+	}
+	return
+}
+
+// bsonUpdate generates a bson update for MongoDB queries from the migrationModel data
+func (u *migrationModel) bsonUpdate() (doc bson.D, err error) {
+	inner, err := u.toDoc()
+	if err != nil {
+		return
+	}
+	doc = bson.D{{Key: "$set", Value: inner}}
+	return
+}
+
+// toRoot creates and return a new pointer to a Migration JSON struct from a pointer to a BSON migrationModel
+func (u *migrationModel) toRoot() *models.Migration {
+	return &models.Migration{
+		Version:     u.Version,
+		Description: u.Description,
+		Applied:     true,
+		AppliedAt:   u.AppliedAt,
+	}
+}
@@ -0,0 +1,140 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+func Test_MigratorUpDown(t *testing.T) {
+	migrator := initTestMigrator()
+	ctx := context.Background()
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name        string // The name of the test
+		want        int    // How many migrations we want the step to apply or revert
+		wantApplied int    // How many migrations we want applied afterwards
+		steps       int    // The number of migrations to revert, or 0 to apply every pending one
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"up", len(schemaMigrations), len(schemaMigrations), 0},
+		{"up again", 0, len(schemaMigrations), 0},
+		{"down one", 1, len(schemaMigrations) - 1, 1},
+		{"down past first", len(schemaMigrations) - 1, 0, len(schemaMigrations)},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			var got int
+			if tt.steps == 0 {
+				ms, uErr := migrator.Up(ctx)
+				got, err = len(ms), uErr
+			} else {
+				ms, dErr := migrator.Down(ctx, tt.steps)
+				got, err = len(ms), dErr
+			}
+			// Checking the error
+			if err != nil {
+				t.Errorf("Migrator step error = %v", err)
+				return
+			}
+			status, err := migrator.Status(ctx)
+			if err != nil {
+				t.Errorf("Migrator.Status() error = %v", err)
+				return
+			}
+			applied := 0
+			for _, m := range status {
+				if m.Applied {
+					applied++
+				}
+			}
+			if got != tt.want || applied != tt.wantApplied { // Asserting whether we get the correct wanted value
+				t.Errorf("Migrator step = %v with %v applied, want %v with %v applied", got, applied, tt.want, tt.wantApplied)
+			}
+		})
+	}
+}
+
+func Test_UniqueIndexes(t *testing.T) {
+	migrator := initTestMigrator()
+	ctx := context.Background()
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Migrator.Up() error = %v", err)
+	}
+	uHandler := migrator.db.NewUserHandler()
+	if _, err := uHandler.InsertOne(ctx, &userModel{Email: "unique@email.com"}); err != nil {
+		t.Fatalf("DBHandler.InsertOne() error = %v", err)
+	}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string     // The name of the test
+		wantErr bool       // whether we want a duplicate key error.
+		user    *userModel // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"unique email", false, &userModel{Email: "other@email.com"}},
+		{"duplicate email", true, &userModel{Email: "unique@email.com"}},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uHandler.InsertOne(ctx, tt.user)
+			// Checking the error
+			if (err != nil) != tt.wantErr || (tt.wantErr && !mongo.IsDuplicateKeyError(err)) {
+				t.Errorf("DBHandler.InsertOne() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && utilities.ParseGRPCErrStatusCode(err) != codes.AlreadyExists {
+				t.Errorf("utilities.ParseGRPCErrStatusCode() = %v, want %v", utilities.ParseGRPCErrStatusCode(err), codes.AlreadyExists)
+			}
+		})
+	}
+}
+
+func Test_TaskPreImages(t *testing.T) {
+	migrator := initTestMigrator()
+	ctx := context.Background()
+	tHandler := migrator.db.NewTaskHandler()
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string // The name of the test
+		want    bool   // whether we want the delete event to carry the pre-image
+		migrate bool   // whether the migrations are applied before the delete
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"before migration", false, false},
+		{"after migration", true, true},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.migrate {
+				if _, err := migrator.Up(ctx); err != nil {
+					t.Fatalf("Migrator.Up() error = %v", err)
+				}
+			}
+			task, err := tHandler.InsertOne(ctx, &taskModel{Name: "preImageTask"})
+			if err != nil {
+				t.Fatalf("DBHandler.InsertOne() error = %v", err)
+			}
+			stream, err := migrator.db.Watch(ctx, "tasks", nil, nil)
+			if err != nil {
+				t.Fatalf("DBClient.Watch() error = %v", err)
+			}
+			if _, err = tHandler.DeleteOne(ctx, &taskModel{Id: task.Id}); err != nil {
+				t.Fatalf("DBHandler.DeleteOne() error = %v", err)
+			}
+			var event taskChangeEvent
+			if !stream.Next(ctx) || stream.Decode(&event) != nil {
+				t.Fatalf("DBChangeStream.Next() error = %v", stream.Err())
+			}
+			if got := event.FullDocumentBeforeChange != nil; got != tt.want || event.OperationType != "delete" {
+				t.Errorf("delete event pre-image = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// schemaMigration is a versioned schema change with the steps to apply and revert it
+type schemaMigration struct {
+	version     int64
+	description string
+	up          func(ctx context.Context, db DBClient) error
+	down        func(ctx context.Context, db DBClient) error
+}
+
+// collectionIndexes declares the indexes of a single collection
+type collectionIndexes struct {
+	collection string
+	indexes    []mongo.IndexModel
+}
+
+// schemaMigrations lists every migration in version order; an applied migration must never be edited or reordered
+var schemaMigrations = []*schemaMigration{
+	newIndexMigration(1, "unique indexes for migrations, users, groups and blacklists", []collectionIndexes{
+		{"migrations", []mongo.IndexModel{uniqueIndex("migrations_version_unique", "version")}},
+		{"users", []mongo.IndexModel{uniqueIndex("users_email_unique", "email")}},
+		{"groups", []mongo.IndexModel{uniqueIndex("groups_name_unique", "name")}},
+		{"blacklists", []mongo.IndexModel{uniqueIndex("blacklists_auth_token_unique", "auth_token")}},
+	}),
+	newIndexMigration(2, "query indexes for users, tasks and files", []collectionIndexes{
+		{"users", []mongo.IndexModel{index("users_group_id", "group_id")}},
+		{"tasks", []mongo.IndexModel{
+			index("tasks_group_id_user_id", "group_id", "user_id"),
+			index("tasks_user_id", "user_id"),
+		}},
+		{"files", []mongo.IndexModel{index("files_owner_type_owner_id", "owner_type", "owner_id")}},
+	}),
+	newIndexMigration(3, "indexes for memberships", []collectionIndexes{
+		{"memberships", []mongo.IndexModel{
+			uniqueIndex("memberships_user_id_group_id_unique", "user_id", "group_id"),
+			index("memberships_group_id", "group_id"),
+		}},
+	}),
+	newIndexMigration(4, "indexes for invites", []collectionIndexes{
+		{"invites", []mongo.IndexModel{
+			uniqueIndex("invites_code_hash_unique", "code_hash"),
+			index("invites_group_id", "group_id"),
+		}},
+	}),
+	newIndexMigration(5, "index for group parents", []collectionIndexes{
+		{"groups", []mongo.IndexModel{
+			index("groups_parent_id", "parent_id"),
+		}},
+	}),
+	newIndexMigration(6, "indexes for task comments and activities", []collectionIndexes{
+		{"comments", []mongo.IndexModel{index("comments_task_id_created_at", "task_id", "created_at")}},
+		{"task_activities", []mongo.IndexModel{index("task_activities_task_id_created_at", "task_id", "created_at")}},
+	}),
+	newPreImageMigration(7, "change stream pre-images for tasks", "tasks"),
+	newReplaceIndexMigration(8, "group names unique per parent group", "groups",
+		uniqueIndex("groups_name_unique", "name"),
+		uniqueIndex("groups_parent_id_name_unique", "parent_id", "name"),
+	),
+}
+
+// index returns a named ascending index on the input keys
+func index(name string, keys ...string) mongo.IndexModel {
+	doc := bson.D{}
+	for _, k := range keys {
+		doc = append(doc, bson.E{Key: k, Value: 1})
+	}
+	return mongo.IndexModel{Keys: doc, Options: options.Index().SetName(name)}
+}
+
+// uniqueIndex returns a named ascending index on the input keys that rejects duplicate values
+func uniqueIndex(name string, keys ...string) mongo.IndexModel {
+	m := index(name, keys...)
+	m.Options.SetUnique(true)
+	return m
+}
+
+// newIndexMigration returns a schemaMigration that creates the declared indexes and drops them when reverted
+func newIndexMigration(version int64, description string, declared []collectionIndexes) *schemaMigration {
+	return &schemaMigration{
+		version:     version,
+		description: description,
+		up: func(ctx context.Context, db DBClient) error {
+			for _, ci := range declared {
+				if err := db.CreateIndexes(ctx, ci.collection, ci.indexes); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		down: func(ctx context.Context, db DBClient) error {
+			for _, ci := range declared {
+				names := make([]string, 0, len(ci.indexes))
+				for _, idx := range ci.indexes {
+					names = append(names, *idx.Options.Name)
+				}
+				if err := db.DropIndexes(ctx, ci.collection, names); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// newReplaceIndexMigration returns a schemaMigration that replaces an index of a collection with another, and restores
+// the former index when reverted
+func newReplaceIndexMigration(version int64, description string, collection string, former mongo.IndexModel, replacement mongo.IndexModel) *schemaMigration {
+	replace := func(ctx context.Context, db DBClient, drop mongo.IndexModel, create mongo.IndexModel) error {
+		if err := db.DropIndexes(ctx, collection, []string{*drop.Options.Name}); err != nil {
+			return err
+		}
+		return db.CreateIndexes(ctx, collection, []mongo.IndexModel{create})
+	}
+	return &schemaMigration{
+		version:     version,
+		description: description,
+		up: func(ctx context.Context, db DBClient) error {
+			return replace(ctx, db, former, replacement)
+		},
+		down: func(ctx context.Context, db DBClient) error {
+			return replace(ctx, db, replacement, former)
+		},
+	}
+}
+
+// newPreImageMigration returns a schemaMigration that turns on the change stream pre-images of a collection, so that
+// watchers receive the document of a delete, and turns them off when reverted
+func newPreImageMigration(version int64, description string, collection string) *schemaMigration {
+	return &schemaMigration{
+		version:     version,
+		description: description,
+		up: func(ctx context.Context, db DBClient) error {
+			return db.SetChangeStreamPreImages(ctx, collection, true)
+		},
+		down: func(ctx context.Context, db DBClient) error {
+			return db.SetChangeStreamPreImages(ctx, collection, false)
+		},
+	}
+}
+
+// Migrator applies and reverts the schema migrations, recording the applied versions in the migrations collection
+type Migrator struct {
+	db               DBClient
+	migrationHandler *DBHandler[*migrationModel]
+	migrations       []*schemaMigration
+}
+
+// NewMigrator is an exported function used to initialize a new Migrator struct
+func NewMigrator(db DBClient, mHandler *DBHandler[*migrationModel]) *Migrator {
+	return &Migrator{db, mHandler, schemaMigrations}
+}
+
+// applied returns the applied migration records keyed by version
+func (m *Migrator) applied(ctx context.Context) (map[int64]*migrationModel, error) {
+	ms, err := m.migrationHandler.FindMany(ctx, &migrationModel{})
+	if err != nil {
+		return nil, err
+	}
+	applied := make(map[int64]*migrationModel, len(ms))
+	for _, mm := range ms {
+		applied[mm.Version] = mm
+	}
+	return applied, nil
+}
+
+// Status lists every migration in version order and whether it has been applied
+func (m *Migrator) Status(ctx context.Context) ([]*models.Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := make([]*models.Migration, 0, len(m.migrations))
+	for _, sm := range m.migrations {
+		if mm, ok := applied[sm.version]; ok {
+			status = append(status, mm.toRoot())
+			continue
+		}
+		status = append(status, &models.Migration{Version: sm.version, Description: sm.description})
+	}
+	return status, nil
+}
+
+// Up applies every pending migration in version order and returns the ones it applied
+func (m *Migrator) Up(ctx context.Context) ([]*models.Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	done := make([]*models.Migration, 0)
+	for _, sm := range m.migrations {
+		if _, ok := applied[sm.version]; ok {
+			continue
+		}
+		if err = sm.up(ctx, m.db); err != nil {
+			return done, fmt.Errorf("migration %d up: %w", sm.version, err)
+		}
+		mm, err := m.migrationHandler.InsertOne(ctx, newMigrationModel(&models.Migration{Version: sm.version, Description: sm.description}))
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				continue // recorded by a concurrently starting instance
+			}
+			return done, err
+		}
+		done = append(done, mm.toRoot())
+	}
+	return done, nil
+}
+
+// Down reverts up to steps of the most recently applied migrations and returns the ones it reverted
+func (m *Migrator) Down(ctx context.Context, steps int) ([]*models.Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	done := make([]*models.Migration, 0)
+	for i := len(m.migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		sm := m.migrations[i]
+		mm, ok := applied[sm.version]
+		if !ok {
+			continue
+		}
+		if err = sm.down(ctx, m.db); err != nil {
+			return done, fmt.Errorf("migration %d down: %w", sm.version, err)
+		}
+		if _, err = m.migrationHandler.DeleteOne(ctx, &migrationModel{Id: mm.Id}); err != nil {
+			return done, err
+		}
+		reverted := mm.toRoot()
+		reverted.Applied = false
+		done = append(done, reverted)
+	}
+	return done, nil
+}
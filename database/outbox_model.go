@@ -0,0 +1,179 @@
+package database
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// outboxModel structures a domain event BSON document to save in the outbox_events collection
+type outboxModel struct {
+	Id            primitive.ObjectID `bson:"_id,omitempty"`
+	Type          string             `bson:"type,omitempty"`
+	AggregateType string             `bson:"aggregate_type,omitempty"`
+	AggregateId   string             `bson:"aggregate_id,omitempty"`
+	GroupId       string             `bson:"group_id,omitempty"`
+	ActorId       string             `bson:"actor_id,omitempty"`
+	Payload       string             `bson:"payload,omitempty"`
+	Status        string             `bson:"status,omitempty"`
+	Attempts      int                `bson:"attempts,omitempty"`
+	LastError     string             `bson:"last_error,omitempty"`
+	DispatchedAt  time.Time          `bson:"dispatched_at,omitempty"`
+	OccurredAt    time.Time          `bson:"occurred_at,omitempty"`
+	LastModified  time.Time          `bson:"last_modified,omitempty"`
+}
+
+// newOutboxModel initializes a new pointer to an outboxModel struct from a pointer to a JSON DomainEvent struct
+func newOutboxModel(u *models.DomainEvent) (um *outboxModel, err error) {
+	um = &outboxModel{
+		Type:          u.Type,
+		AggregateType: u.AggregateType,
+		AggregateId:   u.AggregateId,
+		GroupId:       u.GroupId,
+		ActorId:       u.ActorId,
+		Payload:       u.Payload,
+		Status:        u.Status,
+		Attempts:      u.Attempts,
+		LastError:     u.LastError,
+		DispatchedAt:  u.DispatchedAt,
+		OccurredAt:    u.OccurredAt,
+	}
+	if u.Id != "" && u.Id != "000000000000000000000000" {
+		um.Id, err = primitive.ObjectIDFromHex(u.Id)
+	}
+	return
+}
+
+// update the outboxModel using an overwrite bson.D doc
+func (u *outboxModel) update(doc interface{}) (err error) {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return
+	}
+	um := outboxModel{}
+	err = bson.Unmarshal(data, &um)
+	if len(um.Status) > 0 {
+		u.Status = um.Status
+	}
+	if um.Attempts > 0 {
+		u.Attempts = um.Attempts
+	}
+	if len(um.LastError) > 0 {
+		u.LastError = um.LastError
+	}
+	if !um.DispatchedAt.IsZero() {
+		u.DispatchedAt = um.DispatchedAt
+	}
+	if !um.LastModified.IsZero() {
+		u.LastModified = um.LastModified
+	}
+	return
+}
+
+// bsonLoad loads a bson doc into the outboxModel
+func (u *outboxModel) bsonLoad(doc bson.D) (err error) {
+	bData, err := bsonMarshall(doc)
+	if err != nil {
+		return err
+	}
+	err = bson.Unmarshal(bData, u)
+	return err
+}
+
+// match compares an input bson doc and returns whether there's a match with the outboxModel
+func (u *outboxModel) match(doc interface{}) bool {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return false
+	}
+	um := outboxModel{}
+	err = bson.Unmarshal(data, &um)
+	if um.Id.Hex() != "" && um.Id.Hex() != "000000000000000000000000" {
+		return u.Id == um.Id
+	}
+	if um.Status != "" && u.Status != um.Status {
+		return false
+	}
+	if um.GroupId != "" && u.GroupId != um.GroupId {
+		return false
+	}
+	if um.AggregateId != "" && u.AggregateId != um.AggregateId {
+		return false
+	}
+	return true
+}
+
+// getID returns the unique identifier of the outboxModel
+func (u *outboxModel) getID() (id interface{}) {
+	return u.Id
+}
+
+// addTimeStamps updates an outboxModel struct with a timestamp
+func (u *outboxModel) addTimeStamps(newRecord bool) {
+	currentTime := time.Now().UTC()
+	u.LastModified = currentTime
+	if newRecord {
+		u.OccurredAt = currentTime
+	}
+}
+
+// addObjectID checks if an outboxModel has a value assigned for Id if no value a new one is generated and assigned
+func (u *outboxModel) addObjectID() {
+	if u.Id.Hex() == "" || u.Id.Hex() == "000000000000000000000000" {
+		u.Id = primitive.NewObjectID()
+	}
+}
+
+// postProcess updates an outboxModel struct after it is read from or written to the db
+func (u *outboxModel) postProcess() (err error) {
+	return
+}
+
+// toDoc converts the bson outboxModel into a bson.D
+func (u *outboxModel) toDoc() (doc bson.D, err error) {
+	data, err := bson.Marshal(u)
+	if err != nil {
+		return
+	}
+	err = bson.Unmarshal(data, &doc)
+	return
+}
+
+// bsonFilter generates a bson filter for MongoDB queries from the outboxModel data
+func (u *outboxModel) bsonFilter() (doc bson.D, err error) {
+	if u.Id.Hex() != "" && u.Id.Hex() != "000000000000000000000000" {
+		doc = bson.D{{Key: "_id", Value: u.Id}}
+	} else if u.Status != "" {
+		doc = bson.D{{Key: "status", Value: u.Status}}
+	}
+	return
+}
+
+// bsonUpdate generates a bson update for MongoDB queries from the outboxModel data
+func (u *outboxModel) bsonUpdate() (doc bson.D, err error) {
+	inner, err := u.toDoc()
+	if err != nil {
+		return
+	}
+	doc = bson.D{{Key: "$set", Value: inner}}
+	return
+}
+
+// toRoot creates and return a new pointer to a DomainEvent JSON struct from a pointer to a BSON outboxModel
+func (u *outboxModel) toRoot() *models.DomainEvent {
+	return &models.DomainEvent{
+		Id:            u.Id.Hex(),
+		Type:          u.Type,
+		AggregateType: u.AggregateType,
+		AggregateId:   u.AggregateId,
+		GroupId:       u.GroupId,
+		ActorId:       u.ActorId,
+		Payload:       u.Payload,
+		Status:        u.Status,
+		Attempts:      u.Attempts,
+		LastError:     u.LastError,
+		DispatchedAt:  u.DispatchedAt,
+		OccurredAt:    u.OccurredAt,
+	}
+}
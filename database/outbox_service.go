@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// OutboxService is used by the app to manage the outbox_events collection of pending domain events
+type OutboxService struct {
+	collection    DBCollection
+	db            DBClient
+	outboxHandler *DBHandler[*outboxModel]
+}
+
+// NewOutboxService is an exported function used to initialize a new OutboxService struct
+func NewOutboxService(db DBClient, oHandler *DBHandler[*outboxModel]) *OutboxService {
+	collection := db.GetCollection("outbox_events")
+	return &OutboxService{collection, db, oHandler}
+}
+
+// OutboxCreate is used to persist a new DomainEvent to the outbox
+func (p *OutboxService) OutboxCreate(ctx context.Context, e *models.DomainEvent) (*models.DomainEvent, error) {
+	if e.Type == "" {
+		return nil, utilities.MissingFields("domain event", []string{"type"})
+	}
+	om, err := newOutboxModel(e)
+	if err != nil {
+		return nil, err
+	}
+	if om.Status == "" {
+		om.Status = models.OutboxPending
+	}
+	om, err = p.outboxHandler.InsertOne(ctx, om)
+	if err != nil {
+		return nil, err
+	}
+	return om.toRoot(), nil
+}
+
+// OutboxPending returns up to limit DomainEvents that have not been dispatched yet, oldest first
+func (p *OutboxService) OutboxPending(ctx context.Context, limit int64) ([]*models.DomainEvent, error) {
+	oms, err := p.outboxHandler.SortedFind(ctx, bson.D{{Key: "status", Value: models.OutboxPending}},
+		bson.D{{Key: "occurred_at", Value: 1}}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+	var events []*models.DomainEvent
+	for _, om := range oms {
+		if om.Status == models.OutboxPending {
+			events = append(events, om.toRoot())
+		}
+	}
+	return events, nil
+}
+
+// OutboxUpdate is used to record the dispatch status of a DomainEvent
+func (p *OutboxService) OutboxUpdate(ctx context.Context, e *models.DomainEvent) (*models.DomainEvent, error) {
+	f, err := newOutboxModel(&models.DomainEvent{Id: e.Id})
+	if err != nil {
+		return nil, err
+	}
+	if f.Id.IsZero() {
+		return nil, utilities.MissingFields("domain event", []string{"id"})
+	}
+	cur, err := p.outboxHandler.FindOne(ctx, f)
+	if err != nil {
+		return nil, lookupErr(err, "domain_event", e.Id)
+	}
+	cur.Status = e.Status
+	cur.Attempts = e.Attempts
+	cur.LastError = e.LastError
+	cur.DispatchedAt = e.DispatchedAt
+	om, err := p.outboxHandler.UpdateOne(ctx, f, cur)
+	if err != nil {
+		return nil, err
+	}
+	return om.toRoot(), nil
+}
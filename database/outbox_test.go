@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"testing"
+	"time"
+)
+
+func Test_OutboxDispatch(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string              // The name of the test
+		want    int                 // What out instance we want our function to return.
+		wantErr bool                // whether we want an error.
+		event   *models.DomainEvent // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"success",
+			0,
+			false,
+			&models.DomainEvent{
+				Type:          models.EventTaskCreated,
+				AggregateType: "task",
+				AggregateId:   "000000000000000000000023",
+				GroupId:       "000000000000000000000002",
+				Payload:       `{"id":"000000000000000000000023"}`,
+			},
+		},
+		{
+			"missing type",
+			0,
+			true,
+			&models.DomainEvent{AggregateId: "000000000000000000000023"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := initTestOutboxService()
+			ctx := context.Background()
+			got, err := testService.OutboxCreate(ctx, tt.event)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OutboxService.OutboxCreate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			pending, err := testService.OutboxPending(ctx, 10)
+			if err != nil || len(pending) != 1 || pending[0].Id != got.Id {
+				t.Errorf("OutboxService.OutboxPending() = %v, %v, want %v", pending, err, got.Id)
+				return
+			}
+			got.Status = models.OutboxDispatched
+			got.Attempts = 1
+			got.DispatchedAt = time.Now().UTC()
+			if _, err = testService.OutboxUpdate(ctx, got); err != nil {
+				t.Errorf("OutboxService.OutboxUpdate() error = %v", err)
+				return
+			}
+			pending, err = testService.OutboxPending(ctx, 10)
+			if err != nil || len(pending) != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("OutboxService.OutboxPending() = %v, want %v", len(pending), tt.want)
+			}
+		})
+	}
+}
@@ -96,25 +96,19 @@ func (u *taskModel) match(doc interface{}) bool {
 	}
 	um := taskModel{}
 	err = bson.Unmarshal(data, &um)
-	if um.Id.Hex() != "" && um.Id.Hex() != "000000000000000000000000" {
-		if u.Id == um.Id {
-			return true
-		}
+	if um.Id.Hex() != "" && um.Id.Hex() != "000000000000000000000000" && u.Id != um.Id {
 		return false
 	}
-	if um.UserId.Hex() != "" && um.UserId.Hex() != "000000000000000000000000" {
-		if u.UserId == um.UserId {
-			return true
-		}
+	if um.UserId.Hex() != "" && um.UserId.Hex() != "000000000000000000000000" && u.UserId != um.UserId {
 		return false
 	}
-	if um.GroupId.Hex() != "" && um.GroupId.Hex() != "000000000000000000000000" {
-		if u.GroupId == um.GroupId {
-			return true
-		}
+	if um.GroupId.Hex() != "" && um.GroupId.Hex() != "000000000000000000000000" && u.GroupId != um.GroupId {
 		return false
 	}
-	return false
+	if um.Status != models.UNSPECIFIED && u.Status != um.Status {
+		return false
+	}
+	return true // like MongoDB, a filter without criteria matches every task
 }
 
 // getID returns the unique identifier of the userModel
@@ -170,6 +164,24 @@ func (u *taskModel) bsonFilter() (doc bson.D, err error) {
 	return
 }
 
+// queryFilter generates a bson filter for task searches from the taskModel data, matching every criterion that is set
+func (u *taskModel) queryFilter() bson.D {
+	doc := bson.D{}
+	if !u.Id.IsZero() {
+		doc = append(doc, bson.E{Key: "_id", Value: u.Id})
+	}
+	if !u.GroupId.IsZero() {
+		doc = append(doc, bson.E{Key: "group_id", Value: u.GroupId})
+	}
+	if !u.UserId.IsZero() {
+		doc = append(doc, bson.E{Key: "user_id", Value: u.UserId})
+	}
+	if u.Status != models.UNSPECIFIED {
+		doc = append(doc, bson.E{Key: "status", Value: u.Status})
+	}
+	return doc
+}
+
 // bsonUpdate generates a bson update for MongoDB queries from the userModel data
 func (u *taskModel) bsonUpdate() (doc bson.D, err error) {
 	inner, err := u.toDoc()
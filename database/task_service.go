@@ -2,81 +2,134 @@ package database
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sync"
 	"time"
 )
 
+// taskChangeEvent structures a change stream document from the tasks collection
+type taskChangeEvent struct {
+	OperationType string    `bson:"operationType"`
+	WallTime      time.Time `bson:"wallTime"`
+	DocumentKey   struct {
+		Id primitive.ObjectID `bson:"_id"`
+	} `bson:"documentKey"`
+	FullDocument             *taskModel `bson:"fullDocument"`
+	FullDocumentBeforeChange *taskModel `bson:"fullDocumentBeforeChange"`
+}
+
+// toRoot converts a taskChangeEvent into a TaskChange, falling back to the pre-image or document key for deletes
+func (e *taskChangeEvent) toRoot(resumeToken bson.Raw) *models.TaskChange {
+	c := &models.TaskChange{
+		ResumeToken: base64.RawURLEncoding.EncodeToString(resumeToken),
+		OccurredAt:  e.WallTime,
+	}
+	if c.OccurredAt.IsZero() {
+		c.OccurredAt = time.Now().UTC()
+	}
+	switch e.OperationType {
+	case "insert":
+		c.Type = models.TaskChangeCreated
+	case "delete":
+		c.Type = models.TaskChangeDeleted
+	default:
+		c.Type = models.TaskChangeUpdated
+	}
+	switch {
+	case e.FullDocument != nil && c.Type != models.TaskChangeDeleted:
+		c.Task = e.FullDocument.toRoot()
+	case e.FullDocumentBeforeChange != nil:
+		c.Task = e.FullDocumentBeforeChange.toRoot()
+	default:
+		c.Task = &models.Task{Id: e.DocumentKey.Id.Hex()}
+	}
+	return c
+}
+
 // TaskService is used by the app to manage all Task related controllers and functionality
 type TaskService struct {
-	collection   DBCollection
-	db           DBClient
-	taskHandler  *DBHandler[*taskModel]
-	userHandler  *DBHandler[*userModel]
-	groupHandler *DBHandler[*groupModel]
+	collection        DBCollection
+	db                DBClient
+	taskHandler       *DBHandler[*taskModel]
+	userHandler       *DBHandler[*userModel]
+	groupHandler      *DBHandler[*groupModel]
+	membershipHandler *DBHandler[*membershipModel]
 }
 
 // NewTaskService is an exported function used to initialize a new TaskService struct
-func NewTaskService(db DBClient, tHandler *DBHandler[*taskModel], uHandler *DBHandler[*userModel], gHandler *DBHandler[*groupModel]) *TaskService {
+func NewTaskService(db DBClient, tHandler *DBHandler[*taskModel], uHandler *DBHandler[*userModel], gHandler *DBHandler[*groupModel], mHandler *DBHandler[*membershipModel]) *TaskService {
 	collection := db.GetCollection("tasks")
-	return &TaskService{collection, db, tHandler, uHandler, gHandler}
-}
-
-// checkLinkedRecords ensures the userId and groupId in the models.Task is correct
-func (p *TaskService) checkLinkedRecords(g *groupModel, u *userModel) error {
-	ctx, cancel := context.WithCancel(context.Background())
-	groupChan := make(chan *groupModel)
-	userChan := make(chan *userModel)
-	errChan := make(chan error)
-	defer func() {
-		cancel()
-		close(groupChan)
-		close(userChan)
-		close(errChan)
-	}()
-	go func() {
-		out, err := p.groupHandler.FindOne(g)
-		select {
-		case <-ctx.Done():
-			return
-		default:
-		}
-		groupChan <- out
-		errChan <- err
-	}()
-	go func() {
-		out, err := p.userHandler.FindOne(u)
-		select {
-		case <-ctx.Done():
-			return
-		default:
-		}
-		userChan <- out
-		errChan <- err
-	}()
-	var err error
-	for i := 0; i < 4; i++ {
-		select {
-		case group := <-groupChan:
-			g = group
-		case user := <-userChan:
-			u = user
-		case err = <-errChan:
-			if err != nil {
-				err = errors.New("invalid user id")
-				break
-			}
+	return &TaskService{collection, db, tHandler, uHandler, gHandler, mHandler}
+}
+
+// checkLinkedRecords ensures the userId and groupId in the models.Task is correct, and that the user belongs to the
+// group as its primary group or by a membership
+func (p *TaskService) checkLinkedRecords(ctx context.Context, g *groupModel, u *userModel) (err error) {
+	ctx, span := utilities.StartSpan(ctx, "TaskService.checkLinkedRecords")
+	defer func() { utilities.EndSpan(span, err) }()
+	if inTransaction(ctx) {
+		// a unit of work session cannot be shared by concurrent routines
+		group, gFindErr := p.groupHandler.FindOne(ctx, g)
+		user, uFindErr := p.userHandler.FindOne(ctx, u)
+		if err = taskLinkedRecordsErr(gFindErr, uFindErr); err != nil {
+			return err
 		}
+		return p.checkGroupMember(ctx, group, user)
+	}
+	var wg sync.WaitGroup
+	gCh := make(chan *groupModel)
+	gErr := make(chan error)
+	uCh := make(chan *userModel)
+	uErr := make(chan error)
+	gRoutine := p.groupHandler.newRoutine()
+	uRoutine := p.userHandler.newRoutine()
+	wg.Add(2)
+	go gRoutine.execute(ctx, FindOne, gCh, gErr, g, nil)
+	go uRoutine.execute(ctx, FindOne, uCh, uErr, u, nil)
+	go gRoutine.resolve(gCh, gErr, &wg)
+	go uRoutine.resolve(uCh, uErr, &wg)
+	wg.Wait()
+	close(gCh)
+	close(gErr)
+	close(uCh)
+	close(uErr)
+	if err = taskLinkedRecordsErr(gRoutine.err, uRoutine.err); err != nil {
+		return err
 	}
-	if g.Id != u.GroupId {
-		return errors.New("task user is not in task group")
+	return p.checkGroupMember(ctx, gRoutine.out, uRoutine.out)
+}
+
+// taskLinkedRecordsErr evaluates the group and user lookups made by checkLinkedRecords
+func taskLinkedRecordsErr(gErr error, uErr error) error {
+	if gErr != nil {
+		return utilities.FailedPrecondition("TASK_LINKED_RECORD_NOT_FOUND", "invalid group id").Wrap(gErr)
+	}
+	if uErr != nil {
+		return utilities.FailedPrecondition("TASK_LINKED_RECORD_NOT_FOUND", "invalid user id").Wrap(uErr)
+	}
+	return nil
+}
+
+// checkGroupMember ensures a task user belongs to the task group, as its primary group or by a membership
+func (p *TaskService) checkGroupMember(ctx context.Context, g *groupModel, u *userModel) error {
+	if g.Id == u.GroupId {
+		return nil
+	}
+	_, err := p.membershipHandler.FindOne(ctx, &membershipModel{UserId: u.Id, GroupId: g.Id})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return utilities.FailedPrecondition("TASK_USER_NOT_IN_GROUP", "task user is not in task group")
 	}
 	return err
 }
 
 // TaskCreate is used to create a new user Task
-func (p *TaskService) TaskCreate(g *models.Task) (*models.Task, error) {
+func (p *TaskService) TaskCreate(ctx context.Context, g *models.Task) (*models.Task, error) {
 	err := g.Validate("create")
 	if err != nil {
 		return nil, err
@@ -85,12 +138,12 @@ func (p *TaskService) TaskCreate(g *models.Task) (*models.Task, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = p.checkLinkedRecords(&groupModel{Id: gm.GroupId}, &userModel{Id: gm.UserId})
+	err = p.checkLinkedRecords(ctx, &groupModel{Id: gm.GroupId}, &userModel{Id: gm.UserId})
 	if err != nil {
 		return nil, err
 	}
 	gm.Status = models.NOT_STARTED
-	gm, err = p.taskHandler.InsertOne(gm)
+	gm, err = p.taskHandler.InsertOne(ctx, gm)
 	if err != nil {
 		return nil, err
 	}
@@ -98,13 +151,13 @@ func (p *TaskService) TaskCreate(g *models.Task) (*models.Task, error) {
 }
 
 // TasksFind is used to find all Task docs in a MongoDB Collection
-func (p *TaskService) TasksFind(g *models.Task) ([]*models.Task, error) {
+func (p *TaskService) TasksFind(ctx context.Context, g *models.Task) ([]*models.Task, error) {
 	var tasks []*models.Task
 	tm, err := newTaskModel(g)
 	if err != nil {
 		return tasks, err
 	}
-	gms, err := p.taskHandler.FindMany(tm)
+	gms, err := p.taskHandler.FindMany(ctx, tm)
 	if err != nil {
 		return tasks, err
 	}
@@ -115,25 +168,25 @@ func (p *TaskService) TasksFind(g *models.Task) ([]*models.Task, error) {
 }
 
 // TaskFind is used to find a specific Task doc
-func (p *TaskService) TaskFind(g *models.Task) (*models.Task, error) {
+func (p *TaskService) TaskFind(ctx context.Context, g *models.Task) (*models.Task, error) {
 	gm, err := newTaskModel(g)
 	if err != nil {
 		return nil, err
 	}
-	gm, err = p.taskHandler.FindOne(gm)
+	gm, err = p.taskHandler.FindOne(ctx, gm)
 	if err != nil {
-		return nil, err
+		return nil, lookupErr(err, "task", g.Id)
 	}
 	return gm.toRoot(), err
 }
 
 // TaskDelete is used to delete a Task doc
-func (p *TaskService) TaskDelete(g *models.Task) (*models.Task, error) {
+func (p *TaskService) TaskDelete(ctx context.Context, g *models.Task) (*models.Task, error) {
 	gm, err := newTaskModel(g)
 	if err != nil {
 		return nil, err
 	}
-	gm, err = p.taskHandler.DeleteOne(gm)
+	gm, err = p.taskHandler.DeleteOne(ctx, gm)
 	if err != nil {
 		return nil, err
 	}
@@ -141,12 +194,12 @@ func (p *TaskService) TaskDelete(g *models.Task) (*models.Task, error) {
 }
 
 // TaskDeleteMany is used to delete many Tasks
-func (p *TaskService) TaskDeleteMany(g *models.Task) (*models.Task, error) {
+func (p *TaskService) TaskDeleteMany(ctx context.Context, g *models.Task) (*models.Task, error) {
 	gm, err := newTaskModel(g)
 	if err != nil {
 		return nil, err
 	}
-	gm, err = p.taskHandler.DeleteMany(gm)
+	gm, err = p.taskHandler.DeleteMany(ctx, gm)
 	if err != nil {
 		return nil, err
 	}
@@ -154,7 +207,7 @@ func (p *TaskService) TaskDeleteMany(g *models.Task) (*models.Task, error) {
 }
 
 // TaskUpdate is used to update an existing Task
-func (p *TaskService) TaskUpdate(g *models.Task) (*models.Task, error) {
+func (p *TaskService) TaskUpdate(ctx context.Context, g *models.Task) (*models.Task, error) {
 	var filter models.Task
 	err := g.Validate("update")
 	if err != nil {
@@ -165,20 +218,20 @@ func (p *TaskService) TaskUpdate(g *models.Task) (*models.Task, error) {
 	if err != nil {
 		return nil, err
 	}
-	cur, TaskErr := p.taskHandler.FindOne(f)
+	cur, TaskErr := p.taskHandler.FindOne(ctx, f)
 	if TaskErr != nil {
-		return nil, errors.New("task not found")
+		return nil, lookupErr(TaskErr, "task", g.Id)
 	}
 	g.BuildUpdate(cur.toRoot())
 	gm, err := newTaskModel(g)
 	if err != nil {
 		return nil, err
 	}
-	err = p.checkLinkedRecords(&groupModel{Id: gm.GroupId}, &userModel{Id: gm.UserId})
+	err = p.checkLinkedRecords(ctx, &groupModel{Id: gm.GroupId}, &userModel{Id: gm.UserId})
 	if err != nil {
 		return nil, err
 	}
-	gm, err = p.taskHandler.UpdateOne(f, gm)
+	gm, err = p.taskHandler.UpdateOne(ctx, f, gm)
 	if err != nil {
 		return nil, err
 	}
@@ -186,12 +239,12 @@ func (p *TaskService) TaskUpdate(g *models.Task) (*models.Task, error) {
 }
 
 // TaskDocInsert is used to insert a Task doc directly into mongodb for testing purposes
-func (p *TaskService) TaskDocInsert(g *models.Task) (*models.Task, error) {
+func (p *TaskService) TaskDocInsert(ctx context.Context, g *models.Task) (*models.Task, error) {
 	insertTask, err := newTaskModel(g)
 	if err != nil {
 		return nil, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	_, err = p.collection.InsertOne(ctx, insertTask)
 	if err != nil {
@@ -200,16 +253,26 @@ func (p *TaskService) TaskDocInsert(g *models.Task) (*models.Task, error) {
 	return insertTask.toRoot(), nil
 }
 
-// TasksQuery is used for a paginated tasks search
-func (p *TaskService) TasksQuery(ctx context.Context, g *models.Task, pagination *utilities.Pagination) (*models.TasksRes, error) {
-	um, err := newTaskModel(g)
+// TasksCount is used to count the task docs matching a filter
+func (p *TaskService) TasksCount(ctx context.Context, g *models.Task) (int64, error) {
+	tm, err := newTaskModel(g)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	f, err := um.bsonFilter()
+	f, err := tm.bsonFilter()
+	if err != nil {
+		return 0, err
+	}
+	return p.collection.CountDocuments(ctx, f)
+}
+
+// TasksQuery is used for a paginated tasks search matching every criterion of the filter task
+func (p *TaskService) TasksQuery(ctx context.Context, g *models.Task, pagination *utilities.Pagination) (*models.TasksRes, error) {
+	um, err := newTaskModel(g)
 	if err != nil {
 		return nil, err
 	}
+	f := um.queryFilter()
 	count, err := p.collection.CountDocuments(ctx, f)
 	if err != nil {
 		return nil, err
@@ -224,7 +287,7 @@ func (p *TaskService) TasksQuery(ctx context.Context, g *models.Task, pagination
 			Tasks:      make([]*models.Task, 0),
 		}, nil
 	}
-	ums, err := p.taskHandler.PaginatedFind(ctx, um, pagination)
+	ums, err := p.taskHandler.SortedFind(ctx, f, bson.D{{Key: "_id", Value: 1}}, int64(pagination.GetLimit()), int64(pagination.GetOffset()))
 	if err != nil {
 		return nil, err
 	}
@@ -238,3 +301,46 @@ func (p *TaskService) TasksQuery(ctx context.Context, g *models.Task, pagination
 		Tasks:      tasks,
 	}, nil
 }
+
+// TasksWatch opens a tasks change stream, resuming after resumeToken when one is given, and relays its
+// changes until ctx is done; a stream failure is sent on the error channel before the changes channel closes
+func (p *TaskService) TasksWatch(ctx context.Context, resumeToken string) (<-chan *models.TaskChange, <-chan error, error) {
+	var token bson.Raw
+	if resumeToken != "" {
+		data, err := base64.RawURLEncoding.DecodeString(resumeToken)
+		if err != nil || bson.Raw(data).Validate() != nil {
+			return nil, nil, utilities.InvalidField("resume_token", "is not a valid resume token")
+		}
+		token = data
+	}
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.D{{Key: "operationType", Value: bson.D{
+		{Key: "$in", Value: bson.A{"insert", "update", "replace", "delete"}},
+	}}}}}}
+	stream, err := p.db.Watch(ctx, "tasks", pipeline, token)
+	if err != nil {
+		return nil, nil, err
+	}
+	changes := make(chan *models.TaskChange)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(changes)
+		defer close(errs)
+		defer stream.Close(context.Background())
+		for stream.Next(ctx) {
+			var e taskChangeEvent
+			if err := stream.Decode(&e); err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case changes <- e.toRoot(stream.ResumeToken()):
+			case <-ctx.Done():
+				return
+			}
+		}
+		if ctx.Err() == nil && stream.Err() != nil {
+			errs <- stream.Err()
+		}
+	}()
+	return changes, errs, nil
+}
@@ -1,8 +1,10 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"testing"
 	"time"
 )
@@ -14,6 +16,7 @@ func Test_TaskCreate(t *testing.T) {
 		want    *models.Task // What out instance we want our function to return.
 		wantErr bool         // whether we want an error.
 		task    *models.Task // The input of the test
+		inTxn   bool         // whether the task is created in a unit of work
 	}{
 		// Here we're declaring each unit test input and output data as defined before
 		{
@@ -27,6 +30,59 @@ func Test_TaskCreate(t *testing.T) {
 				UserId:  "000000000000000000000012",
 				GroupId: "000000000000000000000002",
 			},
+			false,
+		},
+		{
+			"success in unit of work",
+			&models.Task{Id: "000000000000000000000022", Name: "Task1", Status: models.NOT_STARTED},
+			false,
+			&models.Task{
+				Id:      "000000000000000000000022",
+				Name:    "Task1",
+				Due:     time.Now().UTC(),
+				UserId:  "000000000000000000000012",
+				GroupId: "000000000000000000000002",
+			},
+			true,
+		},
+		{
+			"missing user",
+			nil,
+			true,
+			&models.Task{
+				Id:      "000000000000000000000022",
+				Name:    "Task1",
+				Due:     time.Now().UTC(),
+				UserId:  "000000000000000000000099",
+				GroupId: "000000000000000000000002",
+			},
+			true,
+		},
+		{
+			"member of task group",
+			&models.Task{Id: "000000000000000000000022", Name: "Task1", Status: models.NOT_STARTED},
+			false,
+			&models.Task{
+				Id:      "000000000000000000000022",
+				Name:    "Task1",
+				Due:     time.Now().UTC(),
+				UserId:  "000000000000000000000012",
+				GroupId: "000000000000000000000003",
+			},
+			false,
+		},
+		{
+			"not in task group",
+			nil,
+			true,
+			&models.Task{
+				Id:      "000000000000000000000022",
+				Name:    "Task1",
+				Due:     time.Now().UTC(),
+				UserId:  "000000000000000000000012",
+				GroupId: "000000000000000000000001",
+			},
+			false,
 		},
 		{
 			"missing name",
@@ -38,6 +94,7 @@ func Test_TaskCreate(t *testing.T) {
 				UserId:  "000000000000000000000012",
 				GroupId: "000000000000000000000002",
 			},
+			false,
 		},
 	}
 	// Iterating over the previous test slice
@@ -45,7 +102,16 @@ func Test_TaskCreate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := initTestTaskService()
 			fmt.Println("\n\nPRE CREATE: ", tt.task)
-			got, err := testService.TaskCreate(tt.task)
+			var got *models.Task
+			var err error
+			if tt.inTxn {
+				err = testService.db.WithTransaction(context.Background(), func(ctx context.Context) (err error) {
+					got, err = testService.TaskCreate(ctx, tt.task)
+					return err
+				})
+			} else {
+				got, err = testService.TaskCreate(context.Background(), tt.task)
+			}
 			fmt.Println("\nPOST CREATE: ", got)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
@@ -60,7 +126,7 @@ func Test_TaskCreate(t *testing.T) {
 			}
 			var failMsg string
 			switch tt.name {
-			case "success":
+			case "success", "success in unit of work", "member of task group":
 				if got.Id != tt.want.Id || got.CreatedAt.IsZero() || got.Status != tt.want.Status { // Asserting whether we get the correct wanted value
 					failMsg = fmt.Sprintf("TaskService.TaskCreate() = %v, want %v", got, tt.want)
 				}
@@ -104,7 +170,7 @@ func Test_TasksFind(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestTasks()
-			got, err := testService.TasksFind(tt.task)
+			got, err := testService.TasksFind(context.Background(), tt.task)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("TaskService.TasksFind() error = %v, wantErr %v", err, tt.wantErr)
@@ -117,6 +183,108 @@ func Test_TasksFind(t *testing.T) {
 	}
 }
 
+func Test_TasksCount(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string // The name of the test
+		want    int64  // What out instance we want our function to return.
+		wantErr bool   // whether we want an error.
+		task    *models.Task
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"user tasks count",
+			1,
+			false,
+			&models.Task{UserId: "000000000000000000000012"},
+		},
+		{
+			"group tasks count",
+			2,
+			false,
+			&models.Task{GroupId: "000000000000000000000002"},
+		},
+		{
+			"no tasks count",
+			0,
+			false,
+			&models.Task{GroupId: "000000000000000000000009"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestTasks()
+			got, err := testService.TasksCount(context.Background(), tt.task)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TaskService.TasksCount() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("TaskService.TasksCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_TasksQuery(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string // The name of the test
+		want    int64  // What out instance we want our function to return.
+		wantErr bool   // whether we want an error.
+		task    *models.Task
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"no filter",
+			2,
+			false,
+			&models.Task{},
+		},
+		{
+			"group and user",
+			1,
+			false,
+			&models.Task{GroupId: "000000000000000000000002", UserId: "000000000000000000000012"},
+		},
+		{
+			"status",
+			2,
+			false,
+			&models.Task{GroupId: "000000000000000000000002", Status: models.NOT_STARTED},
+		},
+		{
+			"no matching status",
+			0,
+			false,
+			&models.Task{Status: models.IN_PROGRESS},
+		},
+		{
+			"id of another group",
+			0,
+			false,
+			&models.Task{Id: "000000000000000000000022", GroupId: "000000000000000000000003"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestTasks()
+			got, err := testService.TasksQuery(context.Background(), tt.task, utilities.NewPaginationQuery(10, 1))
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TaskService.TasksQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got.TotalCount != tt.want || int64(len(got.Tasks)) != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("TaskService.TasksQuery() = %v tasks of %v, want %v", len(got.Tasks), got.TotalCount, tt.want)
+			}
+		})
+	}
+}
+
 func Test_TaskFind(t *testing.T) {
 	// Defining our test slice. Each unit test should have the following properties:
 	tests := []struct {
@@ -137,7 +305,7 @@ func Test_TaskFind(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestTasks()
-			got, err := testService.TaskFind(tt.task)
+			got, err := testService.TaskFind(context.Background(), tt.task)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("TaskService.TaskFind() error = %v, wantErr %v", err, tt.wantErr)
@@ -190,7 +358,7 @@ func Test_TaskUpdate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestTasks()
-			got, err := testService.TaskUpdate(tt.task)
+			got, err := testService.TaskUpdate(context.Background(), tt.task)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("TaskService.TaskUpdate() error = %v, wantErr %v", err, tt.wantErr)
@@ -241,7 +409,7 @@ func Test_TaskDelete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestTasks()
-			got, err := testService.TaskDelete(tt.task)
+			got, err := testService.TaskDelete(context.Background(), tt.task)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("TaskService.TaskDelete() error = %v, wantErr %v", err, tt.wantErr)
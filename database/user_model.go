@@ -10,34 +10,36 @@ import (
 
 // userModel structures a group BSON document to save in a users collection
 type userModel struct {
-	Id           primitive.ObjectID `bson:"_id,omitempty"`
-	Username     string             `bson:"username,omitempty"`
-	Password     string             `bson:"password,omitempty"`
-	FirstName    string             `bson:"firstname,omitempty"`
-	LastName     string             `bson:"lastname,omitempty"`
-	Email        string             `bson:"email,omitempty"`
-	Role         string             `bson:"role,omitempty"`
-	RootAdmin    bool               `bson:"root_admin,omitempty"`
-	GroupId      primitive.ObjectID `bson:"group_id,omitempty"`
-	ImageId      primitive.ObjectID `bson:"image_id,omitempty"`
-	LastModified time.Time          `bson:"last_modified,omitempty"`
-	CreatedAt    time.Time          `bson:"created_at,omitempty"`
-	DeletedAt    time.Time          `bson:"deleted_at,omitempty"`
+	Id              primitive.ObjectID `bson:"_id,omitempty"`
+	Username        string             `bson:"username,omitempty"`
+	Password        string             `bson:"password,omitempty"`
+	FirstName       string             `bson:"firstname,omitempty"`
+	LastName        string             `bson:"lastname,omitempty"`
+	Email           string             `bson:"email,omitempty"`
+	Role            string             `bson:"role,omitempty"`
+	RootAdmin       bool               `bson:"root_admin,omitempty"`
+	GroupId         primitive.ObjectID `bson:"group_id,omitempty"`
+	ImageId         primitive.ObjectID `bson:"image_id,omitempty"`
+	TokensRevokedAt time.Time          `bson:"tokens_revoked_at,omitempty"`
+	LastModified    time.Time          `bson:"last_modified,omitempty"`
+	CreatedAt       time.Time          `bson:"created_at,omitempty"`
+	DeletedAt       time.Time          `bson:"deleted_at,omitempty"`
 }
 
 // newUserModel initializes a new pointer to a userModel struct from a pointer to a JSON User struct
 func newUserModel(u *models.User) (um *userModel, err error) {
 	um = &userModel{
-		Username:     u.Username,
-		Password:     u.Password,
-		FirstName:    u.FirstName,
-		LastName:     u.LastName,
-		Email:        u.Email,
-		Role:         u.Role,
-		RootAdmin:    u.RootAdmin,
-		LastModified: u.LastModified,
-		CreatedAt:    u.CreatedAt,
-		DeletedAt:    u.DeletedAt,
+		Username:        u.Username,
+		Password:        u.Password,
+		FirstName:       u.FirstName,
+		LastName:        u.LastName,
+		Email:           u.Email,
+		Role:            u.Role,
+		RootAdmin:       u.RootAdmin,
+		TokensRevokedAt: u.TokensRevokedAt,
+		LastModified:    u.LastModified,
+		CreatedAt:       u.CreatedAt,
+		DeletedAt:       u.DeletedAt,
 	}
 	if u.Id != "" && u.Id != "000000000000000000000000" {
 		um.Id, err = primitive.ObjectIDFromHex(u.Id)
@@ -83,6 +85,9 @@ func (u *userModel) update(doc interface{}) (err error) {
 	if len(um.Role) > 0 {
 		u.Role = um.Role
 	}
+	if !um.TokensRevokedAt.IsZero() {
+		u.TokensRevokedAt = um.TokensRevokedAt
+	}
 	if !um.LastModified.IsZero() {
 		u.LastModified = um.LastModified
 	}
@@ -195,19 +200,20 @@ func (u *userModel) bsonUpdate() (doc bson.D, err error) {
 // toRoot creates and return a new pointer to a User JSON struct from a pointer to a BSON userModel
 func (u *userModel) toRoot() *models.User {
 	return &models.User{
-		Id:           u.Id.Hex(),
-		Username:     u.Username,
-		Password:     u.Password,
-		FirstName:    u.FirstName,
-		LastName:     u.LastName,
-		Email:        u.Email,
-		Role:         u.Role,
-		RootAdmin:    u.RootAdmin,
-		GroupId:      u.GroupId.Hex(),
-		ImageId:      u.ImageId.Hex(),
-		LastModified: u.LastModified,
-		CreatedAt:    u.CreatedAt,
-		DeletedAt:    u.DeletedAt,
+		Id:              u.Id.Hex(),
+		Username:        u.Username,
+		Password:        u.Password,
+		FirstName:       u.FirstName,
+		LastName:        u.LastName,
+		Email:           u.Email,
+		Role:            u.Role,
+		RootAdmin:       u.RootAdmin,
+		GroupId:         u.GroupId.Hex(),
+		ImageId:         u.ImageId.Hex(),
+		TokensRevokedAt: u.TokensRevokedAt,
+		LastModified:    u.LastModified,
+		CreatedAt:       u.CreatedAt,
+		DeletedAt:       u.DeletedAt,
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/crypto/bcrypt"
 	"sync"
 	"time"
@@ -26,7 +27,15 @@ func NewUserService(db DBClient, uHandler *DBHandler[*userModel], gHandler *DBHa
 }
 
 // checkLinkedRecords ensures the email is unique and groupId valid for a User
-func (p *UserService) checkLinkedRecords(g *groupModel, u *userModel, curUser *userModel) error {
+func (p *UserService) checkLinkedRecords(ctx context.Context, g *groupModel, u *userModel, curUser *userModel) (err error) {
+	ctx, span := utilities.StartSpan(ctx, "UserService.checkLinkedRecords")
+	defer func() { utilities.EndSpan(span, err) }()
+	if inTransaction(ctx) {
+		// a unit of work session cannot be shared by concurrent routines
+		_, uFindErr := p.userHandler.FindOne(ctx, u)
+		_, gFindErr := p.groupHandler.FindOne(ctx, g)
+		return linkedRecordsErr(uFindErr, gFindErr, u, curUser)
+	}
 	var wg sync.WaitGroup
 	uCh := make(chan *userModel)
 	uErr := make(chan error)
@@ -35,8 +44,8 @@ func (p *UserService) checkLinkedRecords(g *groupModel, u *userModel, curUser *u
 	uRoutine := p.userHandler.newRoutine()
 	gRoutine := p.groupHandler.newRoutine()
 	wg.Add(2)
-	go uRoutine.execute(FindOne, uCh, uErr, u, nil)
-	go gRoutine.execute(FindOne, gCh, gErr, g, nil)
+	go uRoutine.execute(ctx, FindOne, uCh, uErr, u, nil)
+	go gRoutine.execute(ctx, FindOne, gCh, gErr, g, nil)
 	go uRoutine.resolve(uCh, uErr, &wg)
 	go gRoutine.resolve(gCh, gErr, &wg)
 	wg.Wait()
@@ -44,36 +53,43 @@ func (p *UserService) checkLinkedRecords(g *groupModel, u *userModel, curUser *u
 	close(uErr)
 	close(gCh)
 	close(gErr)
-	if curUser == nil && uRoutine.err == nil {
-		return errors.New("email is taken")
-	} else if uRoutine.err == nil && curUser.Email != u.Email {
-		return errors.New("email is taken")
-	} else if gRoutine.err != nil {
-		return errors.New("invalid group id")
-	}
-	return nil
+	return linkedRecordsErr(uRoutine.err, gRoutine.err, u, curUser)
+}
+
+// linkedRecordsErr evaluates the user email and group lookups made by checkLinkedRecords
+func linkedRecordsErr(uErr error, gErr error, u *userModel, curUser *userModel) error {
+	if curUser == nil && uErr == nil {
+		return utilities.Conflict("user", u.Email, "email is taken")
+	} else if uErr == nil && curUser.Email != u.Email {
+		return utilities.Conflict("user", u.Email, "email is taken")
+	} else if errors.Is(gErr, mongo.ErrNoDocuments) {
+		return utilities.FailedPrecondition("USER_GROUP_NOT_FOUND", "invalid group id")
+	}
+	return gErr
 }
 
 // AuthenticateUser is used to authenticate users that are signing in
-func (p *UserService) AuthenticateUser(u *models.User) (*models.User, error) {
+func (p *UserService) AuthenticateUser(ctx context.Context, u *models.User) (*models.User, error) {
 	um, err := newUserModel(u)
 	if err != nil {
 		return nil, err
 	}
-	checkUser, err := p.userHandler.FindOne(um)
-	if err != nil {
-		return nil, errors.New("invalid email")
+	checkUser, err := p.userHandler.FindOne(ctx, um)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, utilities.Unauthenticated("invalid email")
+	} else if err != nil {
+		return nil, err
 	}
 	rootUser := checkUser.toRoot()
 	err = rootUser.Authenticate(u.Password)
 	if err == nil {
 		return rootUser, nil
 	}
-	return nil, errors.New("invalid password")
+	return nil, utilities.Unauthenticated("invalid password")
 }
 
 // UserCreate is used to create a new user
-func (p *UserService) UserCreate(u *models.User) (*models.User, error) {
+func (p *UserService) UserCreate(ctx context.Context, u *models.User) (*models.User, error) {
 	if u.Id == "" {
 		u.Id = utilities.GenerateObjectID()
 	}
@@ -81,13 +97,13 @@ func (p *UserService) UserCreate(u *models.User) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	docCount, err := p.collection.CountDocuments(ctx, bson.M{})
 	if err != nil {
 		return nil, err
 	}
-	err = p.checkLinkedRecords(&groupModel{Id: um.GroupId}, &userModel{Email: um.Email}, nil)
+	err = p.checkLinkedRecords(ctx, &groupModel{Id: um.GroupId}, &userModel{Email: um.Email}, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +122,7 @@ func (p *UserService) UserCreate(u *models.User) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	um, err = p.userHandler.InsertOne(um)
+	um, err = p.userHandler.InsertOne(ctx, um)
 	if err != nil {
 		return nil, err
 	}
@@ -114,12 +130,12 @@ func (p *UserService) UserCreate(u *models.User) (*models.User, error) {
 }
 
 // UserDelete is used to delete an User
-func (p *UserService) UserDelete(u *models.User) (*models.User, error) {
+func (p *UserService) UserDelete(ctx context.Context, u *models.User) (*models.User, error) {
 	um, err := newUserModel(u)
 	if err != nil {
 		return nil, err
 	}
-	um, err = p.userHandler.DeleteOne(um)
+	um, err = p.userHandler.DeleteOne(ctx, um)
 	if err != nil {
 		return nil, err
 	}
@@ -127,12 +143,12 @@ func (p *UserService) UserDelete(u *models.User) (*models.User, error) {
 }
 
 // UserDeleteMany is used to delete many Users
-func (p *UserService) UserDeleteMany(u *models.User) (*models.User, error) {
+func (p *UserService) UserDeleteMany(ctx context.Context, u *models.User) (*models.User, error) {
 	um, err := newUserModel(u)
 	if err != nil {
 		return nil, err
 	}
-	um, err = p.userHandler.DeleteMany(um)
+	um, err = p.userHandler.DeleteMany(ctx, um)
 	if err != nil {
 		return nil, err
 	}
@@ -140,13 +156,13 @@ func (p *UserService) UserDeleteMany(u *models.User) (*models.User, error) {
 }
 
 // UsersFind is used to find all user docs
-func (p *UserService) UsersFind(u *models.User) ([]*models.User, error) {
+func (p *UserService) UsersFind(ctx context.Context, u *models.User) ([]*models.User, error) {
 	var users []*models.User
 	um, err := newUserModel(u)
 	if err != nil {
 		return users, err
 	}
-	ums, err := p.userHandler.FindMany(um)
+	ums, err := p.userHandler.FindMany(ctx, um)
 	if err != nil {
 		return users, err
 	}
@@ -157,38 +173,38 @@ func (p *UserService) UsersFind(u *models.User) ([]*models.User, error) {
 }
 
 // UserFind is used to find a specific user doc
-func (p *UserService) UserFind(u *models.User) (*models.User, error) {
+func (p *UserService) UserFind(ctx context.Context, u *models.User) (*models.User, error) {
 	um, err := newUserModel(u)
 	if err != nil {
 		return nil, err
 	}
-	um, err = p.userHandler.FindOne(um)
+	um, err = p.userHandler.FindOne(ctx, um)
 	if err != nil {
-		return nil, err
+		return nil, lookupErr(err, "user", u.Id)
 	}
 	return um.toRoot(), err
 }
 
 // UserUpdate is used to update an existing user doc
-func (p *UserService) UserUpdate(u *models.User) (*models.User, error) {
+func (p *UserService) UserUpdate(ctx context.Context, u *models.User) (*models.User, error) {
 	filter, err := u.BuildFilter()
 	if err != nil {
 		return nil, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	docCount, err := p.collection.CountDocuments(ctx, bson.M{})
 	if err != nil {
 		return nil, err
 	}
 	if docCount == 0 {
-		return nil, errors.New("no users found")
+		return nil, utilities.NotFound("user", "")
 	}
 	f, err := newUserModel(filter)
 	if err != nil {
 		return nil, err
 	}
-	curUser, err := p.userHandler.FindOne(f)
+	curUser, err := p.userHandler.FindOne(ctx, f)
 	if err != nil {
 		return u, err
 	}
@@ -197,7 +213,7 @@ func (p *UserService) UserUpdate(u *models.User) (*models.User, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = p.checkLinkedRecords(&groupModel{Id: um.GroupId}, &userModel{Email: um.Email}, curUser)
+	err = p.checkLinkedRecords(ctx, &groupModel{Id: um.GroupId}, &userModel{Email: um.Email}, curUser)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +224,7 @@ func (p *UserService) UserUpdate(u *models.User) (*models.User, error) {
 		}
 		um.Password = u.Password
 	}
-	um, err = p.userHandler.UpdateOne(f, um)
+	um, err = p.userHandler.UpdateOne(ctx, f, um)
 	if err != nil {
 		return nil, err
 	}
@@ -216,12 +232,12 @@ func (p *UserService) UserUpdate(u *models.User) (*models.User, error) {
 }
 
 // UpdatePassword is used to update the currently logged-in user's password
-func (p *UserService) UpdatePassword(u *models.User, currentPassword string, newPassword string) (*models.User, error) {
+func (p *UserService) UpdatePassword(ctx context.Context, u *models.User, currentPassword string, newPassword string) (*models.User, error) {
 	um, err := newUserModel(u)
 	if err != nil {
 		return nil, err
 	}
-	user, err := p.userHandler.FindOne(um)
+	user, err := p.userHandler.FindOne(ctx, um)
 	if err != nil {
 		return nil, err
 	}
@@ -240,7 +256,7 @@ func (p *UserService) UpdatePassword(u *models.User, currentPassword string, new
 				{"last_modified", currentTime},
 			},
 		}}
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 		defer cancel()
 		_, err = p.collection.UpdateOne(ctx, filter, update)
 		if err != nil {
@@ -249,11 +265,11 @@ func (p *UserService) UpdatePassword(u *models.User, currentPassword string, new
 		user.Password = ""
 		return user.toRoot(), nil
 	}
-	return nil, errors.New("invalid password")
+	return nil, utilities.PermissionDenied("invalid password")
 }
 
 // UserDocInsert is used to insert user doc directly into mongodb for testing purposes
-func (p *UserService) UserDocInsert(u *models.User) (*models.User, error) {
+func (p *UserService) UserDocInsert(ctx context.Context, u *models.User) (*models.User, error) {
 	password := []byte(u.Password)
 	hashedPassword, err := bcrypt.GenerateFromPassword(password, bcrypt.DefaultCost)
 	if err != nil {
@@ -264,7 +280,22 @@ func (p *UserService) UserDocInsert(u *models.User) (*models.User, error) {
 	if err != nil {
 		return u, err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	_, err = p.collection.InsertOne(ctx, insertUser)
+	if err != nil {
+		return u, err
+	}
+	return insertUser.toRoot(), nil
+}
+
+// UserImport is used to insert a user doc whose password is already hashed, such as one read from an export
+func (p *UserService) UserImport(ctx context.Context, u *models.User) (*models.User, error) {
+	insertUser, err := newUserModel(u)
+	if err != nil {
+		return u, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 	_, err = p.collection.InsertOne(ctx, insertUser)
 	if err != nil {
@@ -273,6 +304,19 @@ func (p *UserService) UserDocInsert(u *models.User) (*models.User, error) {
 	return insertUser.toRoot(), nil
 }
 
+// UsersCount is used to count the user docs matching a filter
+func (p *UserService) UsersCount(ctx context.Context, u *models.User) (int64, error) {
+	um, err := newUserModel(u)
+	if err != nil {
+		return 0, err
+	}
+	f, err := um.bsonFilter()
+	if err != nil {
+		return 0, err
+	}
+	return p.collection.CountDocuments(ctx, f)
+}
+
 // UsersQuery is used for a paginated users search
 func (p *UserService) UsersQuery(ctx context.Context, u *models.User, pagination *utilities.Pagination) (*models.UsersRes, error) {
 	um, err := newUserModel(u)
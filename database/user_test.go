@@ -1,6 +1,7 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	"testing"
@@ -103,7 +104,7 @@ func Test_UserCreate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := initTestUserService()
 			//fmt.Println("\n\nPRE CREATE: ", tt.user)
-			got, err := testService.UserCreate(tt.user)
+			got, err := testService.UserCreate(context.Background(), tt.user)
 			//fmt.Println("\nPOST CREATE: ", got)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
@@ -163,7 +164,7 @@ func Test_UsersFind(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestUsers()
 			// fmt.Println("\n\nPRE FIND: ", tt.user)
-			got, err := testService.UsersFind(tt.user)
+			got, err := testService.UsersFind(context.Background(), tt.user)
 			//fmt.Println("\n\nPOST FIND: ", got)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
@@ -198,7 +199,7 @@ func Test_UserFind(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestUsers()
 			fmt.Println("\nPRE FIND: ", tt.user)
-			got, err := testService.UserFind(tt.user)
+			got, err := testService.UserFind(context.Background(), tt.user)
 			fmt.Println("\nPOST FIND: ", got)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
@@ -257,7 +258,7 @@ func Test_UserUpdate(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestUsers()
-			got, err := testService.UserUpdate(tt.user)
+			got, err := testService.UserUpdate(context.Background(), tt.user)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UserService.UserUpdate() error = %v, wantErr %v", err, tt.wantErr)
@@ -303,7 +304,7 @@ func Test_UserDelete(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestUsers()
-			got, err := testService.UserDelete(tt.user)
+			got, err := testService.UserDelete(context.Background(), tt.user)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
 				t.Errorf("UserService.UserDelete() error = %v, wantErr %v", err, tt.wantErr)
@@ -361,7 +362,7 @@ func Test_AuthenticateUser(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestUsers()
 			//fmt.Println("\nPRE AUTH: ", tt.user)
-			got, err := testService.AuthenticateUser(tt.user)
+			got, err := testService.AuthenticateUser(context.Background(), tt.user)
 			//fmt.Println("\nPOST AUTH: ", got)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
@@ -425,7 +426,7 @@ func Test_UpdatePassword(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			testService := setupTestUsers()
 			//fmt.Println("\nPRE PW UPDATE: ", tt.user)
-			got, err := testService.UpdatePassword(tt.user, tt.CPW, tt.NPW)
+			got, err := testService.UpdatePassword(context.Background(), tt.user, tt.CPW, tt.NPW)
 			//fmt.Println("\nPOST PW UPDATE: ", got)
 			// Checking the error
 			if (err != nil) != tt.wantErr {
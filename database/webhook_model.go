@@ -0,0 +1,173 @@
+package database
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"time"
+)
+
+// webhookModel structures a webhook endpoint BSON document to save in the webhooks collection
+type webhookModel struct {
+	Id           primitive.ObjectID `bson:"_id,omitempty"`
+	GroupId      primitive.ObjectID `bson:"group_id,omitempty"`
+	URL          string             `bson:"url,omitempty"`
+	Secret       string             `bson:"secret,omitempty"`
+	EventTypes   []string           `bson:"event_types,omitempty"`
+	Disabled     bool               `bson:"disabled"`
+	LastModified time.Time          `bson:"last_modified,omitempty"`
+	CreatedAt    time.Time          `bson:"created_at,omitempty"`
+}
+
+// newWebhookModel initializes a new pointer to a webhookModel struct from a pointer to a JSON Webhook struct
+func newWebhookModel(u *models.Webhook) (um *webhookModel, err error) {
+	um = &webhookModel{
+		URL:          u.URL,
+		Secret:       u.Secret,
+		EventTypes:   u.EventTypes,
+		Disabled:     u.Disabled,
+		LastModified: u.LastModified,
+		CreatedAt:    u.CreatedAt,
+	}
+	if u.Id != "" && u.Id != "000000000000000000000000" {
+		um.Id, err = primitive.ObjectIDFromHex(u.Id)
+	}
+	if u.GroupId != "" && u.GroupId != "000000000000000000000000" {
+		um.GroupId, err = primitive.ObjectIDFromHex(u.GroupId)
+	}
+	return
+}
+
+// update the webhookModel using an overwrite bson.D doc
+func (u *webhookModel) update(doc interface{}) (err error) {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return
+	}
+	um := webhookModel{}
+	err = bson.Unmarshal(data, &um)
+	if len(um.URL) > 0 {
+		u.URL = um.URL
+	}
+	if len(um.EventTypes) > 0 {
+		u.EventTypes = um.EventTypes
+	}
+	u.Disabled = um.Disabled
+	if !um.LastModified.IsZero() {
+		u.LastModified = um.LastModified
+	}
+	return
+}
+
+// bsonLoad loads a bson doc into the webhookModel
+func (u *webhookModel) bsonLoad(doc bson.D) (err error) {
+	bData, err := bsonMarshall(doc)
+	if err != nil {
+		return err
+	}
+	err = bson.Unmarshal(bData, u)
+	return err
+}
+
+// match compares an input bson doc and returns whether there's a match with the webhookModel
+func (u *webhookModel) match(doc interface{}) bool {
+	data, err := bsonMarshall(doc)
+	if err != nil {
+		return false
+	}
+	um := webhookModel{}
+	err = bson.Unmarshal(data, &um)
+	if um.Id.Hex() != "" && um.Id.Hex() != "000000000000000000000000" {
+		if u.Id == um.Id {
+			return true
+		}
+		return false
+	}
+	if um.GroupId.Hex() != "" && um.GroupId.Hex() != "000000000000000000000000" {
+		if u.GroupId == um.GroupId {
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+// getID returns the unique identifier of the webhookModel
+func (u *webhookModel) getID() (id interface{}) {
+	return u.Id
+}
+
+// addTimeStamps updates a webhookModel struct with a timestamp
+func (u *webhookModel) addTimeStamps(newRecord bool) {
+	currentTime := time.Now().UTC()
+	u.LastModified = currentTime
+	if newRecord {
+		u.CreatedAt = currentTime
+	}
+}
+
+// addObjectID checks if a webhookModel has a value assigned for Id if no value a new one is generated and assigned
+func (u *webhookModel) addObjectID() {
+	if u.Id.Hex() == "" || u.Id.Hex() == "000000000000000000000000" {
+		u.Id = primitive.NewObjectID()
+	}
+}
+
+// postProcess updates a webhookModel struct after it is read from or written to the db
+func (u *webhookModel) postProcess() (err error) {
+	return
+}
+
+// toDoc converts the bson webhookModel into a bson.D
+func (u *webhookModel) toDoc() (doc bson.D, err error) {
+	data, err := bson.Marshal(u)
+	if err != nil {
+		return
+	}
+	err = bson.Unmarshal(data, &doc)
+	return
+}
+
+// bsonFilter generates a bson filter for MongoDB queries from the webhookModel data
+func (u *webhookModel) bsonFilter() (doc bson.D, err error) {
+	if u.Id.Hex() != "" && u.Id.Hex() != "000000000000000000000000" {
+		doc = bson.D{{Key: "_id", Value: u.Id}}
+		if !u.GroupId.IsZero() {
+			doc = append(doc, bson.E{Key: "group_id", Value: u.GroupId})
+		}
+	} else if u.GroupId.Hex() != "" && u.GroupId.Hex() != "000000000000000000000000" {
+		doc = bson.D{{Key: "group_id", Value: u.GroupId}}
+	}
+	return
+}
+
+// bsonUpdate generates a bson update for MongoDB queries from the webhookModel data
+func (u *webhookModel) bsonUpdate() (doc bson.D, err error) {
+	inner, err := u.toDoc()
+	if err != nil {
+		return
+	}
+	doc = bson.D{{Key: "$set", Value: inner}}
+	return
+}
+
+// toRoot creates and return a new pointer to a Webhook JSON struct from a pointer to a BSON webhookModel
+func (u *webhookModel) toRoot() *models.Webhook {
+	return &models.Webhook{
+		Id:           u.Id.Hex(),
+		GroupId:      u.GroupId.Hex(),
+		URL:          u.URL,
+		Secret:       u.Secret,
+		EventTypes:   u.EventTypes,
+		Disabled:     u.Disabled,
+		LastModified: u.LastModified,
+		CreatedAt:    u.CreatedAt,
+	}
+}
+
+func rootWebhooks(ms []*webhookModel) (webhooks []*models.Webhook) {
+	for _, m := range ms {
+		webhooks = append(webhooks, m.toRoot())
+	}
+	return
+}
@@ -0,0 +1,267 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"go.mongodb.org/mongo-driver/bson"
+	"time"
+)
+
+// WebhookService is used by the app to manage webhook endpoints and their delivery history
+type WebhookService struct {
+	collection      DBCollection
+	db              DBClient
+	webhookHandler  *DBHandler[*webhookModel]
+	deliveryHandler *DBHandler[*deliveryModel]
+}
+
+// NewWebhookService is an exported function used to initialize a new WebhookService struct
+func NewWebhookService(db DBClient, wHandler *DBHandler[*webhookModel], dHandler *DBHandler[*deliveryModel]) *WebhookService {
+	collection := db.GetCollection("webhooks")
+	return &WebhookService{collection, db, wHandler, dHandler}
+}
+
+// WebhookCreate is used to register a new Webhook endpoint with a generated signing secret
+func (p *WebhookService) WebhookCreate(ctx context.Context, g *models.Webhook) (*models.Webhook, error) {
+	err := g.Validate("create")
+	if err != nil {
+		return nil, err
+	}
+	if g.Secret == "" {
+		g.Secret, err = utilities.GenerateSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+	wm, err := newWebhookModel(g)
+	if err != nil {
+		return nil, err
+	}
+	wm, err = p.webhookHandler.InsertOne(ctx, wm)
+	if err != nil {
+		return nil, err
+	}
+	return wm.toRoot(), nil
+}
+
+// WebhookFind is used to find a specific Webhook doc
+func (p *WebhookService) WebhookFind(ctx context.Context, g *models.Webhook) (*models.Webhook, error) {
+	wm, err := newWebhookModel(g)
+	if err != nil {
+		return nil, err
+	}
+	wm, err = p.webhookHandler.FindOne(ctx, wm)
+	if err != nil {
+		return nil, lookupErr(err, "webhook", g.Id)
+	}
+	return wm.toRoot(), nil
+}
+
+// WebhooksFind is used to find every Webhook registered for a group
+func (p *WebhookService) WebhooksFind(ctx context.Context, g *models.Webhook) ([]*models.Webhook, error) {
+	wm, err := newWebhookModel(g)
+	if err != nil {
+		return nil, err
+	}
+	wms, err := p.webhookHandler.FindMany(ctx, wm)
+	if err != nil {
+		return nil, err
+	}
+	return rootWebhooks(wms), nil
+}
+
+// WebhookUpdate is used to update an existing Webhook
+func (p *WebhookService) WebhookUpdate(ctx context.Context, g *models.Webhook) (*models.Webhook, error) {
+	err := g.Validate("update")
+	if err != nil {
+		return nil, err
+	}
+	f, err := newWebhookModel(&models.Webhook{Id: g.Id})
+	if err != nil {
+		return nil, err
+	}
+	cur, err := p.webhookHandler.FindOne(ctx, f)
+	if err != nil {
+		return nil, lookupErr(err, "webhook", g.Id)
+	}
+	g.BuildUpdate(cur.toRoot())
+	wm, err := newWebhookModel(g)
+	if err != nil {
+		return nil, err
+	}
+	wm, err = p.webhookHandler.UpdateOne(ctx, f, wm)
+	if err != nil {
+		return nil, err
+	}
+	return wm.toRoot(), nil
+}
+
+// WebhookDelete is used to delete a Webhook doc
+func (p *WebhookService) WebhookDelete(ctx context.Context, g *models.Webhook) (*models.Webhook, error) {
+	wm, err := newWebhookModel(g)
+	if err != nil {
+		return nil, err
+	}
+	wm, err = p.webhookHandler.DeleteOne(ctx, wm)
+	if err != nil {
+		return nil, err
+	}
+	return wm.toRoot(), nil
+}
+
+// WebhooksQuery is used for a paginated webhooks search
+func (p *WebhookService) WebhooksQuery(ctx context.Context, g *models.Webhook, pagination *utilities.Pagination) (*models.WebhooksRes, error) {
+	wm, err := newWebhookModel(g)
+	if err != nil {
+		return nil, err
+	}
+	f, err := wm.bsonFilter()
+	if err != nil {
+		return nil, err
+	}
+	count, err := p.collection.CountDocuments(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return &models.WebhooksRes{
+			TotalCount: 0,
+			TotalPages: 0,
+			Page:       0,
+			Size:       0,
+			HasMore:    false,
+			Webhooks:   make([]*models.Webhook, 0),
+		}, nil
+	}
+	wms, err := p.webhookHandler.PaginatedFind(ctx, wm, pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &models.WebhooksRes{
+		TotalCount: count,
+		TotalPages: int64(pagination.GetTotalPages(int(count))),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(int(count)),
+		Webhooks:   rootWebhooks(wms),
+	}, nil
+}
+
+// DeliveryCreate is used to schedule a new WebhookDelivery
+func (p *WebhookService) DeliveryCreate(ctx context.Context, d *models.WebhookDelivery) (*models.WebhookDelivery, error) {
+	if !utilities.CheckObjectID(d.WebhookId) || d.EventId == "" {
+		return nil, utilities.MissingFields("delivery", []string{"webhook_id", "event_id"})
+	}
+	if d.Status == "" {
+		d.Status = models.DeliveryPending
+	}
+	if d.NextAttemptAt.IsZero() {
+		d.NextAttemptAt = time.Now().UTC()
+	}
+	dm, err := newDeliveryModel(d)
+	if err != nil {
+		return nil, err
+	}
+	dm, err = p.deliveryHandler.InsertOne(ctx, dm)
+	if err != nil {
+		return nil, err
+	}
+	return dm.toRoot(), nil
+}
+
+// DeliveryFind is used to find a specific WebhookDelivery doc
+func (p *WebhookService) DeliveryFind(ctx context.Context, d *models.WebhookDelivery) (*models.WebhookDelivery, error) {
+	dm, err := newDeliveryModel(d)
+	if err != nil {
+		return nil, err
+	}
+	dm, err = p.deliveryHandler.FindOne(ctx, dm)
+	if err != nil {
+		return nil, lookupErr(err, "delivery", d.Id)
+	}
+	return dm.toRoot(), nil
+}
+
+// DeliveryUpdate is used to record the outcome of a WebhookDelivery attempt
+func (p *WebhookService) DeliveryUpdate(ctx context.Context, d *models.WebhookDelivery) (*models.WebhookDelivery, error) {
+	f, err := newDeliveryModel(&models.WebhookDelivery{Id: d.Id})
+	if err != nil {
+		return nil, err
+	}
+	if f.Id.IsZero() {
+		return nil, utilities.MissingFields("delivery", []string{"id"})
+	}
+	cur, err := p.deliveryHandler.FindOne(ctx, f)
+	if err != nil {
+		return nil, lookupErr(err, "delivery", d.Id)
+	}
+	cur.Status = d.Status
+	cur.Attempts = d.Attempts
+	cur.ResponseCode = d.ResponseCode
+	cur.LastError = d.LastError
+	cur.NextAttemptAt = d.NextAttemptAt
+	dm, err := p.deliveryHandler.UpdateOne(ctx, f, cur)
+	if err != nil {
+		return nil, err
+	}
+	return dm.toRoot(), nil
+}
+
+// DeliveriesDue returns up to limit pending WebhookDeliveries whose next attempt is due at or before now
+func (p *WebhookService) DeliveriesDue(ctx context.Context, now time.Time, limit int64) ([]*models.WebhookDelivery, error) {
+	f := bson.D{
+		{Key: "status", Value: models.DeliveryPending},
+		{Key: "next_attempt_at", Value: bson.D{{Key: "$lte", Value: now}}},
+	}
+	dms, err := p.deliveryHandler.SortedFind(ctx, f, bson.D{{Key: "next_attempt_at", Value: 1}}, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+	var deliveries []*models.WebhookDelivery
+	for _, dm := range dms {
+		if dm.Status == models.DeliveryPending && !dm.NextAttemptAt.After(now) {
+			deliveries = append(deliveries, dm.toRoot())
+		}
+	}
+	return deliveries, nil
+}
+
+// DeliveriesQuery is used for a paginated delivery history search sorted newest first
+func (p *WebhookService) DeliveriesQuery(ctx context.Context, d *models.WebhookDelivery, pagination *utilities.Pagination) (*models.DeliveriesRes, error) {
+	dm, err := newDeliveryModel(d)
+	if err != nil {
+		return nil, err
+	}
+	f, err := dm.bsonFilter()
+	if err != nil {
+		return nil, err
+	}
+	count, err := p.db.GetCollection("webhook_deliveries").CountDocuments(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return &models.DeliveriesRes{
+			TotalCount: 0,
+			TotalPages: 0,
+			Page:       0,
+			Size:       0,
+			HasMore:    false,
+			Deliveries: make([]*models.WebhookDelivery, 0),
+		}, nil
+	}
+	dms, err := p.deliveryHandler.SortedFind(ctx, f, bson.D{{Key: "created_at", Value: -1}},
+		int64(pagination.GetLimit()), int64(pagination.GetOffset()))
+	if err != nil {
+		return nil, err
+	}
+	return &models.DeliveriesRes{
+		TotalCount: count,
+		TotalPages: int64(pagination.GetTotalPages(int(count))),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(int(count)),
+		Deliveries: rootDeliveries(dms),
+	}, nil
+}
@@ -0,0 +1,168 @@
+package database
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"testing"
+	"time"
+)
+
+func Test_WebhookCreate(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string          // The name of the test
+		wantErr bool            // whether we want an error.
+		webhook *models.Webhook // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"success",
+			false,
+			&models.Webhook{
+				GroupId:    "000000000000000000000002",
+				URL:        "https://example.com/hooks",
+				EventTypes: []string{models.EventTaskCompleted},
+			},
+		},
+		{
+			"invalid url",
+			true,
+			&models.Webhook{
+				GroupId: "000000000000000000000002",
+				URL:     "ftp://example.com/hooks",
+			},
+		},
+		{
+			"missing group",
+			true,
+			&models.Webhook{URL: "https://example.com/hooks"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := initTestWebhookService()
+			got, err := testService.WebhookCreate(context.Background(), tt.webhook)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WebhookService.WebhookCreate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && (got.Id == "" || got.Secret == "" || got.URL != tt.webhook.URL) {
+				t.Errorf("WebhookService.WebhookCreate() = %v, want %v", got, tt.webhook)
+			}
+		})
+	}
+}
+
+func Test_WebhooksFind(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string          // The name of the test
+		want    int             // What out instance we want our function to return.
+		wantErr bool            // whether we want an error.
+		webhook *models.Webhook // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"group 2",
+			1,
+			false,
+			&models.Webhook{GroupId: "000000000000000000000002"},
+		},
+		{
+			"no webhooks",
+			0,
+			false,
+			&models.Webhook{GroupId: "000000000000000000000004"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestWebhooks()
+			got, err := testService.WebhooksFind(context.Background(), tt.webhook)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WebhookService.WebhooksFind() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("WebhookService.WebhooksFind() = %v, want %v", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func Test_DeliveriesDue(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name     string                  // The name of the test
+		want     int                     // What out instance we want our function to return.
+		wantErr  bool                    // whether we want an error.
+		delivery *models.WebhookDelivery // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"due now",
+			1,
+			false,
+			&models.WebhookDelivery{
+				WebhookId: "000000000000000000000041",
+				GroupId:   "000000000000000000000002",
+				EventId:   "000000000000000000000051",
+				EventType: models.EventTaskCreated,
+			},
+		},
+		{
+			"backing off",
+			0,
+			false,
+			&models.WebhookDelivery{
+				WebhookId:     "000000000000000000000041",
+				GroupId:       "000000000000000000000002",
+				EventId:       "000000000000000000000051",
+				EventType:     models.EventTaskCreated,
+				NextAttemptAt: time.Now().UTC().Add(time.Hour),
+			},
+		},
+		{
+			"dead letter",
+			0,
+			false,
+			&models.WebhookDelivery{
+				WebhookId: "000000000000000000000041",
+				GroupId:   "000000000000000000000002",
+				EventId:   "000000000000000000000051",
+				EventType: models.EventTaskCreated,
+				Status:    models.DeliveryDead,
+			},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testService := setupTestWebhooks()
+			ctx := context.Background()
+			_, err := testService.DeliveryCreate(ctx, tt.delivery)
+			if err != nil {
+				t.Errorf("WebhookService.DeliveryCreate() error = %v", err)
+				return
+			}
+			got, err := testService.DeliveriesDue(ctx, time.Now().UTC(), 10)
+			// Checking the error
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WebhookService.DeliveriesDue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("WebhookService.DeliveriesDue() = %v, want %v", len(got), tt.want)
+			}
+			history, err := testService.DeliveriesQuery(ctx, &models.WebhookDelivery{WebhookId: tt.delivery.WebhookId}, utilities.NewPaginationQuery(10, 1))
+			if err != nil || history.TotalCount != 1 {
+				t.Errorf("WebhookService.DeliveriesQuery() = %v, %v, want 1", history, err)
+			}
+		})
+	}
+}
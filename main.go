@@ -1,12 +1,14 @@
 package main
 
-import "github.com/JECSand/go-grpc-server-boilerplate/cmd"
+import (
+	"fmt"
+	"github.com/JECSand/go-grpc-server-boilerplate/cmd"
+	"os"
+)
 
 func main() {
-	var app cmd.App
-	err := app.Initialize()
-	if err != nil {
-		panic(err)
+	if err := cmd.Execute(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
 	}
-	app.Run()
 }
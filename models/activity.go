@@ -0,0 +1,77 @@
+package models
+
+import (
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// Activity types recorded on the timeline of a Task
+const (
+	ActivityStatusChanged = "status_changed"
+	ActivityReassigned    = "reassigned"
+	ActivityDueChanged    = "due_changed"
+)
+
+// Activity is a root struct that is used to store the json encoded data for/from a mongodb task_activities doc. An
+// Activity records a single change made to a Task, with the values before and after it.
+type Activity struct {
+	Id        string    `json:"id,omitempty"`
+	TaskId    string    `json:"task_id,omitempty"`
+	UserId    string    `json:"user_id,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	From      string    `json:"from,omitempty"`
+	To        string    `json:"to,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// ToProto Convert Activity to proto
+func (g *Activity) ToProto() *tasksService.Activity {
+	return &tasksService.Activity{
+		Id:        g.Id,
+		TaskId:    g.TaskId,
+		UserId:    g.UserId,
+		Type:      g.Type,
+		From:      g.From,
+		To:        g.To,
+		CreatedAt: timestamppb.New(g.CreatedAt),
+	}
+}
+
+// NewTaskActivities returns the Activities of an update by userId that changed a Task from before to after: status
+// changes, reassignments, and due-date edits
+func NewTaskActivities(before *Task, after *Task, userId string) []*Activity {
+	var activities []*Activity
+	add := func(activityType string, from string, to string) {
+		activities = append(activities, &Activity{TaskId: after.Id, UserId: userId, Type: activityType, From: from, To: to})
+	}
+	if before.Status != after.Status {
+		add(ActivityStatusChanged, tasksService.TaskStatus(before.Status).String(), tasksService.TaskStatus(after.Status).String())
+	}
+	if before.UserId != after.UserId {
+		add(ActivityReassigned, before.UserId, after.UserId)
+	}
+	if !before.Due.Equal(after.Due) {
+		add(ActivityDueChanged, before.Due.UTC().Format(time.RFC3339), after.Due.UTC().Format(time.RFC3339))
+	}
+	return activities
+}
+
+// ActivitiesRes Multiple Activities in a paginated response
+type ActivitiesRes struct {
+	TotalCount int64       `json:"total_count"`
+	TotalPages int64       `json:"total_pages"`
+	Page       int64       `json:"page"`
+	Size       int64       `json:"size"`
+	HasMore    bool        `json:"has_more"`
+	Activities []*Activity `json:"activities"`
+}
+
+// ToProto convert ActivitiesRes to proto
+func (p *ActivitiesRes) ToProto() []*tasksService.Activity {
+	uList := make([]*tasksService.Activity, 0, len(p.Activities))
+	for _, u := range p.Activities {
+		uList = append(uList, u.ToProto())
+	}
+	return uList
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_NewTaskActivities(t *testing.T) {
+	due := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	before := &Task{Id: "000000000000000000000021", Status: NOT_STARTED, Due: due, UserId: "000000000000000000000014"}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name  string   // The name of the test
+		after *Task    // The task after the update
+		want  []string // The types of the activities we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"unchanged", &Task{Id: before.Id, Status: NOT_STARTED, Due: due, UserId: before.UserId, Name: "renamed"}, nil},
+		{"status", &Task{Id: before.Id, Status: COMPLETED, Due: due, UserId: before.UserId}, []string{ActivityStatusChanged}},
+		{"same due in another zone", &Task{Id: before.Id, Status: NOT_STARTED, Due: due.In(time.FixedZone("EST", -5*3600)), UserId: before.UserId}, nil},
+		{
+			"reassigned and due",
+			&Task{Id: before.Id, Status: NOT_STARTED, Due: due.Add(24 * time.Hour), UserId: "000000000000000000000012"},
+			[]string{ActivityReassigned, ActivityDueChanged},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewTaskActivities(before, tt.after, "000000000000000000000014")
+			if len(got) != len(tt.want) {
+				t.Fatalf("NewTaskActivities() = %v, want %v", got, tt.want)
+			}
+			for i, a := range got {
+				if a.Type != tt.want[i] || a.TaskId != before.Id || a.From == a.To {
+					t.Errorf("NewTaskActivities()[%d] = %v, want a %s change", i, a, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MaxAttachmentSize is the maximum number of bytes in a file attached to a Task
+const MaxAttachmentSize = 32 << 20
+
+// LoadAddAttachmentProto inputs the first tasksService.AddAttachmentReq of an upload and returns a File owned by the task
+func LoadAddAttachmentProto(u *tasksService.AddAttachmentReq) *File {
+	return &File{
+		OwnerId:    u.GetTaskId(),
+		OwnerType:  "task",
+		BucketType: "task-attachments",
+		Name:       u.GetName(),
+		FileType:   u.GetFileType(),
+	}
+}
+
+// ToAttachmentProto Convert a File owned by a Task to an attachment proto
+func (g *File) ToAttachmentProto() *tasksService.Attachment {
+	return &tasksService.Attachment{
+		Id:           g.Id,
+		TaskId:       g.OwnerId,
+		Name:         g.Name,
+		FileType:     g.FileType,
+		Size:         int64(g.Size),
+		LastModified: timestamppb.New(g.LastModified),
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+	}
+}
+
+// ToAttachmentsProto convert FilesRes owned by a Task to attachment protos
+func (p *FilesRes) ToAttachmentsProto() []*tasksService.Attachment {
+	uList := make([]*tasksService.Attachment, 0, len(p.Files))
+	for _, u := range p.Files {
+		uList = append(uList, u.ToAttachmentProto())
+	}
+	return uList
+}
@@ -0,0 +1,221 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	auditsService "github.com/JECSand/go-grpc-server-boilerplate/protos/audit"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+// Audit actions recorded for security-relevant operations
+const (
+	AuditUserCreate     = "user.create"
+	AuditUserUpdate     = "user.update"
+	AuditUserRoleChange = "user.role_change"
+	AuditUserDelete     = "user.delete"
+	AuditUserMove       = "user.move"
+	AuditGroupDelete    = "group.delete"
+	AuditGroupMove      = "group.move"
+	AuditGroupSettings  = "group.settings_update"
+	AuditMemberAdd      = "group.member_add"
+	AuditMemberRemove   = "group.member_remove"
+	AuditAdminTransfer  = "group.admin_transfer"
+	AuditInviteCreate   = "group.invite_create"
+	AuditInviteRevoke   = "group.invite_revoke"
+	AuditRegister       = "auth.register"
+	AuditLogin          = "auth.login"
+	AuditLoginFailed    = "auth.login_failed"
+	AuditLogout         = "auth.logout"
+	AuditPasswordUpdate = "auth.password_update"
+	AuditAPIKeyGenerate = "auth.api_key_generate"
+	AuditTokensRevoke   = "auth.tokens_revoke"
+	AuditGroupSwitch    = "auth.group_switch"
+	AuditInviteAccept   = "auth.invite_accept"
+	AuditConfigReload   = "config.reload"
+)
+
+// zeroTimeJSON is the json encoding of an unset time.Time
+const zeroTimeJSON = "0001-01-01T00:00:00Z"
+
+// auditIgnoredFields are bookkeeping fields left out of audit diffs
+var auditIgnoredFields = map[string]bool{"last_modified": true, "created_at": true, "deleted_at": true}
+
+// AuditChange records the before and after value of a single field modified by an audited action
+type AuditChange struct {
+	Field  string `json:"field"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// AuditEvent is a root struct that is used to store the json encoded data for/from a mongodb audit_events doc.
+type AuditEvent struct {
+	Id           string            `json:"id,omitempty"`
+	ActorId      string            `json:"actor_id,omitempty"`
+	ActorGroupId string            `json:"actor_group_id,omitempty"`
+	Action       string            `json:"action,omitempty"`
+	TargetType   string            `json:"target_type,omitempty"`
+	TargetId     string            `json:"target_id,omitempty"`
+	GroupId      string            `json:"group_id,omitempty"`
+	Changes      []*AuditChange    `json:"changes,omitempty"`
+	Metadata     map[string]string `json:"metadata,omitempty"`
+	ClientIP     string            `json:"client_ip,omitempty"`
+	RequestId    string            `json:"request_id,omitempty"`
+	CreatedAt    time.Time         `json:"created_at,omitempty"`
+}
+
+// NewAuditEvent initializes an AuditEvent with the actor, client IP, and request ID found in the request context
+func NewAuditEvent(ctx context.Context, action string, targetType string, targetId string, groupId string) *AuditEvent {
+	e := &AuditEvent{
+		Action:     action,
+		TargetType: targetType,
+		TargetId:   targetId,
+		GroupId:    groupId,
+	}
+	if tokenData, err := LoadTokenFromContext(ctx); err == nil {
+		e.ActorId = tokenData.UserId
+		e.ActorGroupId = tokenData.GroupId
+	}
+	e.ClientIP = utilities.PeerIP(ctx)
+	e.RequestId = utilities.RequestIDFromContext(ctx)
+	return e
+}
+
+// DiffAudit compares the before and after state of a record and returns the changed fields with secrets redacted
+func DiffAudit(before interface{}, after interface{}) []*AuditChange {
+	b := auditFields(before)
+	a := auditFields(after)
+	keys := make([]string, 0, len(b)+len(a))
+	for k := range b {
+		keys = append(keys, k)
+	}
+	for k := range a {
+		if _, ok := b[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var changes []*AuditChange
+	for _, k := range keys {
+		if auditIgnoredFields[k] || b[k] == a[k] {
+			continue
+		}
+		c := &AuditChange{Field: k, Before: b[k], After: a[k]}
+		if utilities.IsSensitiveKey(k) {
+			c.Before, c.After = redactAuditValue(c.Before), redactAuditValue(c.After)
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// auditFields flattens a record into a map of its json field names and string values
+func auditFields(record interface{}) map[string]string {
+	fields := make(map[string]string)
+	if record == nil {
+		return fields
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fields
+	}
+	raw := make(map[string]interface{})
+	if err = json.Unmarshal(data, &raw); err != nil {
+		return fields
+	}
+	for k, v := range raw {
+		if v == zeroTimeJSON {
+			continue // an unset timestamp is not a value
+		}
+		fields[k] = fmt.Sprint(v)
+	}
+	return fields
+}
+
+// redactAuditValue hides a secret value while preserving whether it was set
+func redactAuditValue(v string) string {
+	if v == "" {
+		return ""
+	}
+	return utilities.RedactedValue
+}
+
+// ToProto Convert AuditEvent to proto
+func (g *AuditEvent) ToProto() *auditsService.AuditEvent {
+	changes := make([]*auditsService.AuditChange, 0, len(g.Changes))
+	for _, c := range g.Changes {
+		changes = append(changes, &auditsService.AuditChange{Field: c.Field, Before: c.Before, After: c.After})
+	}
+	return &auditsService.AuditEvent{
+		Id:           g.Id,
+		ActorId:      g.ActorId,
+		ActorGroupId: g.ActorGroupId,
+		Action:       g.Action,
+		TargetType:   g.TargetType,
+		TargetId:     g.TargetId,
+		GroupId:      g.GroupId,
+		Changes:      changes,
+		Metadata:     g.Metadata,
+		ClientIp:     g.ClientIP,
+		RequestId:    g.RequestId,
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+	}
+}
+
+// AuditFilter stores the criteria of an audit log search
+type AuditFilter struct {
+	ActorId    string    `json:"actor_id,omitempty"`
+	GroupId    string    `json:"group_id,omitempty"`
+	Action     string    `json:"action,omitempty"`
+	TargetType string    `json:"target_type,omitempty"`
+	TargetId   string    `json:"target_id,omitempty"`
+	Since      time.Time `json:"since,omitempty"`
+	Until      time.Time `json:"until,omitempty"`
+}
+
+// LoadAuditFindProto inputs an auditsService.FindReq and returns an AuditFilter
+func LoadAuditFindProto(u *auditsService.FindReq) *AuditFilter {
+	f := &AuditFilter{
+		ActorId:    u.GetActorId(),
+		GroupId:    u.GetGroupId(),
+		Action:     u.GetAction(),
+		TargetType: u.GetTargetType(),
+		TargetId:   u.GetTargetId(),
+	}
+	if u.GetSince() != nil {
+		f.Since = u.GetSince().AsTime()
+	}
+	if u.GetUntil() != nil {
+		f.Until = u.GetUntil().AsTime()
+	}
+	return f
+}
+
+// LoadScope restricts an AuditFilter to the group of a non-root requester
+func (g *AuditFilter) LoadScope(tokenData *TokenData) {
+	if !tokenData.RootAdmin {
+		g.GroupId = tokenData.GroupId
+	}
+}
+
+// AuditsRes Multiple AuditEvents in a paginated response
+type AuditsRes struct {
+	TotalCount int64         `json:"total_count"`
+	TotalPages int64         `json:"total_pages"`
+	Page       int64         `json:"page"`
+	Size       int64         `json:"size"`
+	HasMore    bool          `json:"has_more"`
+	Events     []*AuditEvent `json:"events"`
+}
+
+// ToProto convert AuditsRes to proto
+func (p *AuditsRes) ToProto() []*auditsService.AuditEvent {
+	uList := make([]*auditsService.AuditEvent, 0, len(p.Events))
+	for _, u := range p.Events {
+		uList = append(uList, u.ToProto())
+	}
+	return uList
+}
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"testing"
+)
+
+// bufconnAddr is the address of a client of the in-process listener used by the HTTP gateway
+type bufconnAddr struct{}
+
+func (bufconnAddr) Network() string { return "bufconn" }
+func (bufconnAddr) String() string  { return "bufconn" }
+
+func Test_DiffAudit(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name   string         // The name of the test
+		want   []*AuditChange // What out instance we want our function to return.
+		before *User          // The input of the test
+		after  *User          // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"role change",
+			[]*AuditChange{{Field: "role", Before: "member", After: "admin"}},
+			&User{Id: "000000000000000000000011", Role: "member"},
+			&User{Id: "000000000000000000000011", Role: "admin"},
+		},
+		{
+			"password redacted",
+			[]*AuditChange{{Field: "password", Before: utilities.RedactedValue, After: utilities.RedactedValue}},
+			&User{Id: "000000000000000000000011", Password: "old-hash"},
+			&User{Id: "000000000000000000000011", Password: "new-hash"},
+		},
+		{
+			"create",
+			[]*AuditChange{{Field: "email", After: "test@example.com"}, {Field: "id", After: "000000000000000000000011"}},
+			nil,
+			&User{Id: "000000000000000000000011", Email: "test@example.com"},
+		},
+		{
+			"no change",
+			nil,
+			&User{Id: "000000000000000000000011"},
+			&User{Id: "000000000000000000000011"},
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []*AuditChange
+			if tt.before == nil {
+				got = DiffAudit(nil, tt.after)
+			} else {
+				got = DiffAudit(tt.before, tt.after)
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("DiffAudit() = %v, want %v", got, tt.want)
+				return
+			}
+			for i := range got {
+				if *got[i] != *tt.want[i] {
+					t.Errorf("DiffAudit()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_NewAuditEventClientIP(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name      string   // The name of the test
+		want      string   // The client IP we want the event to record
+		addr      net.Addr // The peer address of the request
+		forwarded string   // The client IP the HTTP gateway passes along
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"grpc client", "203.0.113.7", &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 51234}, ""},
+		{"gateway client", "198.51.100.4", bufconnAddr{}, "198.51.100.4"},
+		{"forwarded header from grpc client", "203.0.113.7", &net.TCPAddr{IP: net.ParseIP("203.0.113.7"), Port: 51234}, "198.51.100.4"},
+		{"no peer", "", nil, ""},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.addr != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: tt.addr})
+			}
+			if tt.forwarded != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(utilities.ForwardedForHeader, tt.forwarded))
+			}
+			got := NewAuditEvent(ctx, AuditLogin, "user", "000000000000000000000011", "000000000000000000000002")
+			if got.ClientIP != tt.want {
+				t.Errorf("NewAuditEvent().ClientIP = %q, want %q", got.ClientIP, tt.want)
+			}
+		})
+	}
+}
@@ -7,15 +7,58 @@ import (
 	authService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"github.com/dgrijalva/jwt-go"
-	"os"
+	"math"
+	"time"
 )
 
-// TokenData stores the structured data from a session token for use
+// tokenSecret is the key JWT tokens are signed and verified with
+var tokenSecret []byte
+
+// SetTokenSecret sets the key JWT tokens are signed and verified with, from the TokenSecret of the Configuration
+func SetTokenSecret(secret string) {
+	tokenSecret = []byte(secret)
+}
+
+// TokenData stores the structured data from a session token for use; GroupId is the active group of the session and
+// Role the User's role in it
 type TokenData struct {
-	UserId    string
-	Role      string
-	RootAdmin bool
-	GroupId   string
+	UserId      string
+	Role        string
+	RootAdmin   bool
+	GroupId     string
+	IssuedAt    time.Time         // to the microsecond, so that a token issued right after a revocation is told apart
+	Memberships map[string]string // the User's role by group id, when verified against the db for the request
+}
+
+// membershipsKey is the context key of the group roles of an authenticated request
+type membershipsKey struct{}
+
+// ContextWithMemberships returns a copy of ctx carrying the role by group id of the authenticated User
+func ContextWithMemberships(ctx context.Context, roles map[string]string) context.Context {
+	return context.WithValue(ctx, membershipsKey{}, roles)
+}
+
+// GroupRole returns the role of the token User in a group, or an empty string when it is not a member
+func (t *TokenData) GroupRole(groupId string) string {
+	if t.Memberships != nil {
+		return t.Memberships[groupId]
+	}
+	if groupId == t.GroupId {
+		return t.Role
+	}
+	return ""
+}
+
+// GroupIds returns the ids of the groups the token User is a member of
+func (t *TokenData) GroupIds() []string {
+	if t.Memberships == nil {
+		return []string{t.GroupId}
+	}
+	groupIds := make([]string, 0, len(t.Memberships))
+	for groupId := range t.Memberships {
+		groupIds = append(groupIds, groupId)
+	}
+	return groupIds
 }
 
 // InitUserToken inputs a pointer to a user and returns TokenData
@@ -51,7 +94,7 @@ func (t *TokenData) GetGroupsScope() *Group {
 	return &g
 }
 
-// GetUsersScope returns a scoped User ID filter based on token User role
+// GetUsersScope returns a scoped User ID filter based on token User role in the active group
 func (t *TokenData) GetUsersScope(scopeType string) *User {
 	g := User{Id: t.UserId, GroupId: t.GroupId, RootAdmin: t.RootAdmin, Role: t.Role}
 	if t.RootAdmin {
@@ -84,7 +127,6 @@ func (t *TokenData) CreateToken(exp int64) (string, error) {
 	if exp == 0 {
 		return "", errors.New("new token must have a expiration time greater than 0")
 	}
-	var MySigningKey = []byte(os.Getenv("TOKEN_SECRET"))
 	token := jwt.New(jwt.SigningMethodHS256)
 	claims := token.Claims.(jwt.MapClaims)
 	claims["id"] = t.UserId
@@ -92,25 +134,25 @@ func (t *TokenData) CreateToken(exp int64) (string, error) {
 	claims["root"] = t.RootAdmin
 	claims["group_id"] = t.GroupId
 	claims["exp"] = exp
-	return token.SignedString(MySigningKey)
+	claims["iat"] = float64(time.Now().UnixMicro()) / 1e6 // a NumericDate may carry fractional seconds
+	return token.SignedString(tokenSecret)
 }
 
 // DecodeJWT is used to decode a JWT token
 func DecodeJWT(curToken string) (*TokenData, error) {
 	var tokenData TokenData
 	if curToken == "" {
-		return &tokenData, errors.New("unauthorized")
+		return &tokenData, utilities.Unauthenticated("unauthorized")
 	}
-	var MySigningKey = []byte(os.Getenv("TOKEN_SECRET"))
 	// Decode token
 	token, err := jwt.Parse(curToken, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("error")
 		}
-		return []byte(MySigningKey), nil
+		return tokenSecret, nil
 	})
 	if err != nil {
-		return &tokenData, err
+		return &tokenData, utilities.Unauthenticated("invalid token").Wrap(err)
 	}
 	// Determine user based on token
 	if token.Valid {
@@ -119,9 +161,12 @@ func DecodeJWT(curToken string) (*TokenData, error) {
 		tokenData.Role = tokenClaims["role"].(string)
 		tokenData.RootAdmin = tokenClaims["root"].(bool)
 		tokenData.GroupId = tokenClaims["group_id"].(string)
+		if iat, ok := tokenClaims["iat"].(float64); ok {
+			tokenData.IssuedAt = time.UnixMicro(int64(math.Round(iat * 1e6))).UTC()
+		}
 		return &tokenData, nil
 	}
-	return &tokenData, errors.New("invalid token")
+	return &tokenData, utilities.Unauthenticated("invalid token")
 }
 
 // LoadTokenFromContext inputs a http request and returns decrypted TokenData or an error
@@ -134,39 +179,66 @@ func LoadTokenFromContext(ctx context.Context) (*TokenData, error) {
 	if err != nil {
 		return nil, err
 	}
+	if roles, ok := ctx.Value(membershipsKey{}).(map[string]string); ok {
+		tokenData.Memberships = roles
+		tokenData.Role = roles[tokenData.GroupId] // the current role in the active group replaces the role issued
+	}
 	return tokenData, nil
 }
 
-// VerifyGroupRequestScope inputs a Group http request and returns decrypted TokenData or an error
+// VerifyGroupRequestScope inputs a Group http request and returns the group id when the requester is a member of the
+// group or a root admin
 func VerifyGroupRequestScope(ctx context.Context, groupId string) (string, error) {
 	tokenData, err := LoadTokenFromContext(ctx)
 	if err != nil {
 		return "", err
 	}
-	if tokenData.RootAdmin || tokenData.GroupId == groupId {
+	if tokenData.RootAdmin || tokenData.GroupRole(groupId) != "" {
 		return groupId, nil
 	}
-	return "", errors.New("unauthorized")
+	return "", utilities.PermissionDenied("unauthorized")
 }
 
-// VerifyUserRequestScope inputs User http request and returns decrypted TokenData or an error
-func VerifyUserRequestScope(ctx context.Context, userId string, scopeType string) (*User, error) {
+// VerifyGroupAdminScope inputs a Group http request and returns the group id when the requester is an admin of the
+// group or a root admin
+func VerifyGroupAdminScope(ctx context.Context, groupId string) (string, error) {
+	tokenData, err := LoadTokenFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if tokenData.RootAdmin || tokenData.GroupRole(groupId) == "admin" {
+		return groupId, nil
+	}
+	return "", utilities.PermissionDenied("unauthorized")
+}
+
+// VerifyUserRequestScope inputs User http request with the User it targets and that User's Memberships, and returns
+// the scope of the request or an error
+func VerifyUserRequestScope(ctx context.Context, user *User, memberships []*Membership, scopeType string) (*User, error) {
 	tokenData, err := LoadTokenFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	userScope := tokenData.GetUsersScope(scopeType)
-	if tokenData.RootAdmin || tokenData.Role == "admin" { // default scope ok if user is a root admin or group admin
-		userScope.Id = userId
+	if tokenData.RootAdmin { // default scope ok if user is a root admin
+		userScope.Id = user.Id
 		return userScope, nil
 	}
-	if scopeType == "update" && userScope.Id == userId { // default also ok if user is updating itself
+	if scopeType == "update" && tokenData.UserId == user.Id { // default also ok if user is updating itself
+		userScope.Id = user.Id
+		return userScope, nil
+	}
+	if !InGroup(user, memberships, tokenData.GroupId) { // otherwise the user must belong to the active group
+		return nil, utilities.PermissionDenied("unauthorized")
+	}
+	if tokenData.Role == "admin" { // default scope ok if user is an admin of the active group
+		userScope.Id = user.Id
 		return userScope, nil
 	}
-	if scopeType == "find" && userScope.GroupId == tokenData.GroupId { // default also ok if user is finding in group
+	if scopeType == "find" { // default also ok if user is finding in group
 		return userScope, nil
 	}
-	return nil, errors.New("unauthorized")
+	return nil, utilities.PermissionDenied("unauthorized")
 }
 
 // VerifyRequestScope inputs generic http requests and returns decrypted TokenData or an error
@@ -206,13 +278,13 @@ type PasswordUpdate struct {
 // Validate a PasswordUpdate action
 func (r *PasswordUpdate) Validate() error {
 	if r.CurrentPassword == "" {
-		return errors.New("missing current password")
+		return utilities.InvalidField("current_password", "is required")
 	}
 	if r.NewPassword == "" {
-		return errors.New("missing new password")
+		return utilities.InvalidField("new_password", "is required")
 	}
 	if r.CurrentPassword == r.NewPassword {
-		return errors.New("passwords cannot match")
+		return utilities.InvalidField("new_password", "cannot match the current password")
 	}
 	return nil
 }
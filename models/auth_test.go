@@ -1,6 +1,8 @@
 package models
 
 import (
+	"context"
+	"google.golang.org/grpc/metadata"
 	"reflect"
 	"testing"
 	"time"
@@ -127,9 +129,172 @@ func Test_decodeToken(t *testing.T) {
 				t.Errorf("DecodeJWT() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if !tt.wantErr && got.IssuedAt.IsZero() {
+				t.Errorf("DecodeJWT() IssuedAt is not set")
+			}
+			got.IssuedAt = tt.want.IssuedAt
 			if !reflect.DeepEqual(got, tt.want) { // Asserting whether we get the correct wanted value
 				t.Errorf("DecodeJWT() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func Test_VerifyGroupScope(t *testing.T) {
+	tokenData := &TokenData{UserId: "000000000000000000000001", GroupId: "000000000000000000000011", Role: "admin", RootAdmin: false}
+	testToken, _ := tokenData.CreateToken(time.Now().Add(time.Hour * 1).Unix())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", testToken))
+	memberships := map[string]string{"000000000000000000000011": "admin", "000000000000000000000012": "member"}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name        string            // The name of the test
+		memberships map[string]string // The group roles the request was verified with, if any
+		groupId     string            // The group of the request
+		wantMember  bool              // whether we want the member scope check to pass
+		wantAdmin   bool              // whether we want the admin scope check to pass
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"active group from token",
+			nil,
+			"000000000000000000000011",
+			true,
+			true,
+		},
+		{
+			"other group from token",
+			nil,
+			"000000000000000000000012",
+			false,
+			false,
+		},
+		{
+			"member group",
+			memberships,
+			"000000000000000000000012",
+			true,
+			false,
+		},
+		{
+			"admin group",
+			memberships,
+			"000000000000000000000011",
+			true,
+			true,
+		},
+		{
+			"not a member",
+			memberships,
+			"000000000000000000000013",
+			false,
+			false,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqCtx := ctx
+			if tt.memberships != nil {
+				reqCtx = ContextWithMemberships(ctx, tt.memberships)
+			}
+			_, err := VerifyGroupRequestScope(reqCtx, tt.groupId)
+			if (err == nil) != tt.wantMember {
+				t.Errorf("VerifyGroupRequestScope() error = %v, want pass %v", err, tt.wantMember)
+			}
+			_, err = VerifyGroupAdminScope(reqCtx, tt.groupId)
+			if (err == nil) != tt.wantAdmin {
+				t.Errorf("VerifyGroupAdminScope() error = %v, want pass %v", err, tt.wantAdmin)
+			}
+		})
+	}
+}
+
+func Test_VerifyUserScope(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name        string        // The name of the test
+		role        string        // The role of the requester in its active group
+		user        *User         // The user the request targets
+		memberships []*Membership // The memberships of the targeted user
+		scopeType   string        // The scope type of the request
+		wantErr     bool          // whether we want an error
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{
+			"admin updates user of group",
+			"admin",
+			&User{Id: "000000000000000000000002", GroupId: "000000000000000000000011"},
+			nil,
+			"update",
+			false,
+		},
+		{
+			"admin updates member of group",
+			"admin",
+			&User{Id: "000000000000000000000002", GroupId: "000000000000000000000012"},
+			[]*Membership{{UserId: "000000000000000000000002", GroupId: "000000000000000000000011", Role: "member"}},
+			"update",
+			false,
+		},
+		{
+			"admin updates user of other group",
+			"admin",
+			&User{Id: "000000000000000000000002", GroupId: "000000000000000000000012"},
+			nil,
+			"update",
+			true,
+		},
+		{
+			"admin finds user of other group",
+			"admin",
+			&User{Id: "000000000000000000000002", GroupId: "000000000000000000000012"},
+			nil,
+			"find",
+			true,
+		},
+		{
+			"member updates itself",
+			"member",
+			&User{Id: "000000000000000000000001", GroupId: "000000000000000000000011"},
+			nil,
+			"update",
+			false,
+		},
+		{
+			"member updates user of group",
+			"member",
+			&User{Id: "000000000000000000000002", GroupId: "000000000000000000000011"},
+			nil,
+			"update",
+			true,
+		},
+		{
+			"member finds user of group",
+			"member",
+			&User{Id: "000000000000000000000002", GroupId: "000000000000000000000011"},
+			nil,
+			"find",
+			false,
+		},
+		{
+			"member finds user of other group",
+			"member",
+			&User{Id: "000000000000000000000002", GroupId: "000000000000000000000012"},
+			nil,
+			"find",
+			true,
+		},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokenData := &TokenData{UserId: "000000000000000000000001", GroupId: "000000000000000000000011", Role: tt.role}
+			testToken, _ := tokenData.CreateToken(time.Now().Add(time.Hour * 1).Unix())
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", testToken))
+			_, err := VerifyUserRequestScope(ctx, tt.user, tt.memberships, tt.scopeType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VerifyUserRequestScope() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
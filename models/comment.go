@@ -0,0 +1,123 @@
+package models
+
+import (
+	"errors"
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
+)
+
+// MaxCommentLength is the maximum number of characters in the Body of a Comment
+const MaxCommentLength = 4096
+
+// Comment is a root struct that is used to store the json encoded data for/from a mongodb comments doc. A Comment
+// belongs to a Task, and is visible to the members of the task's group.
+type Comment struct {
+	Id           string    `json:"id,omitempty"`
+	TaskId       string    `json:"task_id,omitempty"`
+	UserId       string    `json:"user_id,omitempty"`
+	Body         string    `json:"body,omitempty"`
+	LastModified time.Time `json:"last_modified,omitempty"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+}
+
+// ToProto Convert Comment to proto
+func (g *Comment) ToProto() *tasksService.Comment {
+	return &tasksService.Comment{
+		Id:           g.Id,
+		TaskId:       g.TaskId,
+		UserId:       g.UserId,
+		Body:         g.Body,
+		LastModified: timestamppb.New(g.LastModified),
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+	}
+}
+
+// LoadAddCommentProto inputs a tasksService.AddCommentReq and returns a Comment
+func LoadAddCommentProto(u *tasksService.AddCommentReq) *Comment {
+	return &Comment{
+		TaskId: u.GetTaskId(),
+		Body:   strings.TrimSpace(u.GetBody()),
+	}
+}
+
+// LoadUpdateCommentProto inputs a tasksService.UpdateCommentReq and returns a Comment
+func LoadUpdateCommentProto(u *tasksService.UpdateCommentReq) *Comment {
+	return &Comment{
+		Id:   u.GetId(),
+		Body: strings.TrimSpace(u.GetBody()),
+	}
+}
+
+// CheckID determines whether a specified ID is set or not
+func (g *Comment) CheckID(chkId string) bool {
+	switch chkId {
+	case "id":
+		if !utilities.CheckObjectID(g.Id) {
+			return false
+		}
+	case "task_id":
+		if !utilities.CheckObjectID(g.TaskId) {
+			return false
+		}
+	case "user_id":
+		if !utilities.CheckObjectID(g.UserId) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate a Comment for different scenarios such as adding a new Comment or editing one
+func (g *Comment) Validate(valCase string) (err error) {
+	var missingFields []string
+	switch valCase {
+	case "create":
+		if !g.CheckID("task_id") {
+			missingFields = append(missingFields, "task_id")
+		}
+		if !g.CheckID("user_id") {
+			missingFields = append(missingFields, "user_id")
+		}
+		if g.Body == "" {
+			missingFields = append(missingFields, "body")
+		}
+	case "update":
+		if !g.CheckID("id") {
+			missingFields = append(missingFields, "id")
+		}
+		if g.Body == "" {
+			missingFields = append(missingFields, "body")
+		}
+	default:
+		return errors.New("unrecognized validation case")
+	}
+	if len(missingFields) > 0 {
+		return utilities.MissingFields("comment", missingFields)
+	}
+	if len([]rune(g.Body)) > MaxCommentLength {
+		return utilities.InvalidField("body", "must be at most 4096 characters")
+	}
+	return
+}
+
+// CommentsRes Multiple Comments in a paginated response
+type CommentsRes struct {
+	TotalCount int64      `json:"total_count"`
+	TotalPages int64      `json:"total_pages"`
+	Page       int64      `json:"page"`
+	Size       int64      `json:"size"`
+	HasMore    bool       `json:"has_more"`
+	Comments   []*Comment `json:"comments"`
+}
+
+// ToProto convert CommentsRes to proto
+func (p *CommentsRes) ToProto() []*tasksService.Comment {
+	uList := make([]*tasksService.Comment, 0, len(p.Comments))
+	for _, u := range p.Comments {
+		uList = append(uList, u.ToProto())
+	}
+	return uList
+}
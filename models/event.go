@@ -0,0 +1,83 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Domain event types published by the Task, User, and Group services; invite.created carries the invite code so that a
+// webhook can deliver invitations issued to an email address
+const (
+	EventAll             = "*"
+	EventTaskCreated     = "task.created"
+	EventTaskUpdated     = "task.updated"
+	EventTaskCompleted   = "task.completed"
+	EventTaskReassigned  = "task.reassigned"
+	EventTaskDeleted     = "task.deleted"
+	EventUserCreated     = "user.created"
+	EventUserJoinedGroup = "user.joined_group"
+	EventUserDeleted     = "user.deleted"
+	EventGroupCreated    = "group.created"
+	EventGroupUpdated    = "group.updated"
+	EventGroupDeleted    = "group.deleted"
+	EventInviteCreated   = "invite.created"
+)
+
+// EventTypes lists every domain event type a webhook may subscribe to
+var EventTypes = []string{
+	EventTaskCreated, EventTaskUpdated, EventTaskCompleted, EventTaskReassigned, EventTaskDeleted,
+	EventUserCreated, EventUserJoinedGroup, EventUserDeleted,
+	EventGroupCreated, EventGroupUpdated, EventGroupDeleted,
+	EventInviteCreated,
+}
+
+// Outbox event statuses
+const (
+	OutboxPending    = "pending"
+	OutboxDispatched = "dispatched"
+)
+
+// DomainEvent is a root struct that is used to store the json encoded data for/from a mongodb outbox_events doc.
+type DomainEvent struct {
+	Id            string    `json:"id,omitempty"`
+	Type          string    `json:"type,omitempty"`
+	AggregateType string    `json:"aggregate_type,omitempty"`
+	AggregateId   string    `json:"aggregate_id,omitempty"`
+	GroupId       string    `json:"group_id,omitempty"`
+	ActorId       string    `json:"actor_id,omitempty"`
+	Payload       string    `json:"payload,omitempty"`
+	Status        string    `json:"-"`
+	Attempts      int       `json:"-"`
+	LastError     string    `json:"-"`
+	DispatchedAt  time.Time `json:"-"`
+	OccurredAt    time.Time `json:"occurred_at,omitempty"`
+}
+
+// NewDomainEvent initializes a pending DomainEvent with a JSON encoded payload and the actor found in the request context
+func NewDomainEvent(ctx context.Context, eventType string, aggregateType string, aggregateId string, groupId string, payload interface{}) (*DomainEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	e := &DomainEvent{
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateId:   aggregateId,
+		GroupId:       groupId,
+		Payload:       string(data),
+		Status:        OutboxPending,
+	}
+	if tokenData, err := LoadTokenFromContext(ctx); err == nil {
+		e.ActorId = tokenData.UserId
+	}
+	return e, nil
+}
+
+// Envelope returns the JSON document delivered to webhook endpoints for the DomainEvent
+func (e *DomainEvent) Envelope() ([]byte, error) {
+	return json.Marshal(struct {
+		*DomainEvent
+		Payload json.RawMessage `json:"payload,omitempty"`
+	}{e, json.RawMessage(e.Payload)})
+}
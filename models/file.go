@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"errors"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
-	"strings"
 	"time"
 )
 
@@ -28,6 +27,16 @@ func (f *InFile) Write(chunk []byte) error {
 	return err
 }
 
+// Len returns the number of bytes written unto an InFile
+func (f *InFile) Len() int {
+	return f.buffer.Len()
+}
+
+// Bytes returns the content written unto an InFile
+func (f *InFile) Bytes() []byte {
+	return f.buffer.Bytes()
+}
+
 // File is a root struct that is used to store the json encoded data for/from a mongodb file doc.
 type File struct {
 	Id           string    `json:"id,omitempty"`
@@ -101,7 +110,7 @@ func (g *File) Validate(valCase string) (err error) {
 		return errors.New("unrecognized validation case")
 	}
 	if len(missingFields) > 0 {
-		return errors.New("missing the following file fields: " + strings.Join(missingFields, ", "))
+		return utilities.MissingFields("file", missingFields)
 	}
 	return
 }
@@ -116,7 +125,7 @@ func (g *File) BuildFilter() (*File, error) {
 	} else if g.CheckID("gridfs_id") {
 		filter.GridFSId = g.GridFSId
 	} else {
-		return nil, errors.New("file is missing a valid query filter")
+		return nil, utilities.InvalidArgument("file is missing a valid query filter")
 	}
 	return &filter, nil
 }
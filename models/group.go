@@ -2,21 +2,28 @@ package models
 
 import (
 	"errors"
+	"fmt"
 	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"strings"
+	"sort"
 	"time"
 )
 
-// Group is a root struct that is used to store the json encoded data for/from a mongodb group doc.
+// MaxGroupDepth is the deepest a Group can be nested, counting a top level organization as 1
+const MaxGroupDepth = 5
+
+// Group is a root struct that is used to store the json encoded data for/from a mongodb group doc. A Group with a
+// ParentId is a team within the parent organization; admins of a Group are admins of all the Groups nested within it.
 type Group struct {
-	Id           string    `json:"id,omitempty"`
-	Name         string    `json:"name,omitempty"`
-	RootAdmin    bool      `json:"root_admin,omitempty"`
-	LastModified time.Time `json:"last_modified,omitempty"`
-	CreatedAt    time.Time `json:"created_at,omitempty"`
-	DeletedAt    time.Time `json:"deleted_at,omitempty"`
+	Id           string        `json:"id,omitempty"`
+	Name         string        `json:"name,omitempty"`
+	RootAdmin    bool          `json:"root_admin,omitempty"`
+	ParentId     string        `json:"parent_id,omitempty"`
+	Settings     GroupSettings `json:"settings"`
+	LastModified time.Time     `json:"last_modified,omitempty"`
+	CreatedAt    time.Time     `json:"created_at,omitempty"`
+	DeletedAt    time.Time     `json:"deleted_at,omitempty"`
 }
 
 // ToProto Convert Group to proto
@@ -25,6 +32,8 @@ func (g *Group) ToProto() *groupsService.Group {
 		Id:           g.Id,
 		Name:         g.Name,
 		RootAdmin:    g.RootAdmin,
+		ParentId:     g.ParentId,
+		Settings:     g.Settings.ToProto(),
 		LastModified: timestamppb.New(g.LastModified),
 		CreatedAt:    timestamppb.New(g.CreatedAt),
 		DeletedAt:    timestamppb.New(g.DeletedAt),
@@ -37,6 +46,8 @@ func LoadGroupProto(u *groupsService.Group) *Group {
 		Id:           u.GetId(),
 		Name:         u.GetName(),
 		RootAdmin:    u.GetRootAdmin(),
+		ParentId:     u.GetParentId(),
+		Settings:     LoadGroupSettingsProto(u.GetSettings()),
 		LastModified: u.GetLastModified().AsTime(),
 		CreatedAt:    u.GetCreatedAt().AsTime(),
 		DeletedAt:    u.GetDeletedAt().AsTime(),
@@ -48,6 +59,7 @@ func LoadGroupCreateProto(u *groupsService.CreateReq) *Group {
 	return &Group{
 		Name:      u.GetName(),
 		RootAdmin: u.GetRootAdmin(),
+		ParentId:  u.GetParentId(),
 	}
 }
 
@@ -74,6 +86,10 @@ func (g *Group) CheckID(chkId string) bool {
 		if !utilities.CheckObjectID(g.Id) {
 			return false
 		}
+	case "parent_id":
+		if !utilities.CheckObjectID(g.ParentId) {
+			return false
+		}
 	}
 	return true
 }
@@ -94,7 +110,10 @@ func (g *Group) Validate(valCase string) (err error) {
 		return errors.New("unrecognized validation case")
 	}
 	if len(missingFields) > 0 {
-		return errors.New("missing the following group fields: " + strings.Join(missingFields, ", "))
+		return utilities.MissingFields("group", missingFields)
+	}
+	if g.RootAdmin && g.ParentId != "" {
+		return utilities.InvalidField("parent_id", "a root admin group cannot have a parent")
 	}
 	return
 }
@@ -118,6 +137,71 @@ func (p *GroupsRes) ToProto() []*groupsService.Group {
 	return uList
 }
 
+// NewGroupsRes paginates Groups gathered from several queries, ordered by name
+func NewGroupsRes(gs []*Group, pagination *utilities.Pagination) *GroupsRes {
+	sort.Slice(gs, func(i, j int) bool { return gs[i].Name < gs[j].Name })
+	count := len(gs)
+	start := pagination.GetOffset()
+	if start > count {
+		start = count
+	}
+	end := start + pagination.GetLimit()
+	if pagination.GetLimit() <= 0 || end > count { // as with MongoDB, a limit of 0 is no limit
+		end = count
+	}
+	return &GroupsRes{
+		TotalCount: int64(count),
+		TotalPages: int64(pagination.GetTotalPages(count)),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(count),
+		Groups:     gs[start:end],
+	}
+}
+
+// CheckMove returns why a Group cannot be moved under a new parent, given the ancestors of the parent, nearest first,
+// and the Groups nested within the moved Group, or nil when it can
+func (g *Group) CheckMove(parent *Group, ancestors []*Group, descendants []*Group) error {
+	if g.RootAdmin {
+		return utilities.InvalidField("parent_id", "a root admin group cannot have a parent")
+	}
+	if parent == nil {
+		return nil
+	}
+	if parent.Id == g.Id {
+		return utilities.InvalidField("parent_id", "a group cannot be its own parent")
+	}
+	for _, a := range ancestors {
+		if a.Id == g.Id {
+			return utilities.FailedPrecondition("GROUP_CYCLE", "a group cannot be moved within its own descendants")
+		}
+	}
+	if len(ancestors)+2+g.Height(descendants) > MaxGroupDepth {
+		return utilities.FailedPrecondition("GROUP_TOO_DEEP", fmt.Sprintf("groups cannot be nested more than %d levels deep", MaxGroupDepth))
+	}
+	return nil
+}
+
+// Height returns how many levels of descendants are nested within the Group
+func (g *Group) Height(descendants []*Group) int {
+	depths := map[string]int{g.Id: 0}
+	height := 0
+	for changed := true; changed; { // descendants may be in any order
+		changed = false
+		for _, d := range descendants {
+			if pd, ok := depths[d.ParentId]; ok {
+				if _, seen := depths[d.Id]; !seen {
+					depths[d.Id], changed = pd+1, true
+					if pd+1 > height {
+						height = pd + 1
+					}
+				}
+			}
+		}
+	}
+	return height
+}
+
 // GroupUsers is used for storing a Group record with it's associated Users
 type GroupUsers struct {
 	Group *Group
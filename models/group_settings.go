@@ -0,0 +1,90 @@
+package models
+
+import (
+	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"time"
+)
+
+// GroupSettings is the configuration of a Group, stored as a sub-document of its groups doc. The zero value applies no
+// restrictions: tasks in any status, no member limit, and members may create tasks.
+type GroupSettings struct {
+	Timezone        string   `json:"timezone,omitempty"`
+	DefaultDueDays  int      `json:"default_due_days,omitempty"`
+	AllowedStatuses []string `json:"allowed_statuses,omitempty"`
+	MemberLimit     int      `json:"member_limit,omitempty"`
+	AdminOnlyTasks  bool     `json:"admin_only_tasks,omitempty"`
+}
+
+// ToProto Convert GroupSettings to proto
+func (g *GroupSettings) ToProto() *groupsService.GroupSettings {
+	return &groupsService.GroupSettings{
+		Timezone:        g.Timezone,
+		DefaultDueDays:  int64(g.DefaultDueDays),
+		AllowedStatuses: g.AllowedStatuses,
+		MemberLimit:     int64(g.MemberLimit),
+		AdminOnlyTasks:  g.AdminOnlyTasks,
+	}
+}
+
+// LoadGroupSettingsProto inputs a groupsService.GroupSettings and returns a GroupSettings
+func LoadGroupSettingsProto(u *groupsService.GroupSettings) GroupSettings {
+	return GroupSettings{
+		Timezone:        u.GetTimezone(),
+		DefaultDueDays:  int(u.GetDefaultDueDays()),
+		AllowedStatuses: u.GetAllowedStatuses(),
+		MemberLimit:     int(u.GetMemberLimit()),
+		AdminOnlyTasks:  u.GetAdminOnlyTasks(),
+	}
+}
+
+// Validate the GroupSettings, returning an InvalidField error for the first invalid setting
+func (g *GroupSettings) Validate() error {
+	if _, err := time.LoadLocation(g.Timezone); err != nil {
+		return utilities.InvalidField("timezone", "must be an IANA time zone name")
+	}
+	if g.DefaultDueDays < 0 {
+		return utilities.InvalidField("default_due_days", "must not be negative")
+	}
+	for _, s := range g.AllowedStatuses {
+		if n, ok := tasksService.TaskStatus_value[s]; !ok || n == int32(UNSPECIFIED) {
+			return utilities.InvalidField("allowed_statuses", s+" is not a task status")
+		}
+	}
+	if !g.AllowsStatus(NOT_STARTED) { // new Tasks start out not started
+		return utilities.InvalidField("allowed_statuses", "must include NOT_STARTED")
+	}
+	if g.MemberLimit < 0 {
+		return utilities.InvalidField("member_limit", "must not be negative")
+	}
+	return nil
+}
+
+// AllowsStatus returns whether Tasks of the Group may be in a status; an empty AllowedStatuses allows every status
+func (g *GroupSettings) AllowsStatus(status TaskStatus) bool {
+	if len(g.AllowedStatuses) == 0 || status == UNSPECIFIED {
+		return true
+	}
+	name := tasksService.TaskStatus(status).String()
+	for _, s := range g.AllowedStatuses {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultDue returns the end of the day DefaultDueDays after now in the Group's timezone, or a zero time when the Group
+// has no default due date
+func (g *GroupSettings) DefaultDue(now time.Time) time.Time {
+	if g.DefaultDueDays == 0 {
+		return time.Time{}
+	}
+	loc, err := time.LoadLocation(g.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	y, m, d := now.In(loc).AddDate(0, 0, g.DefaultDueDays).Date()
+	return time.Date(y, m, d, 23, 59, 59, 0, loc).UTC()
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc/codes"
+	"testing"
+	"time"
+)
+
+func Test_GroupSettingsValidate(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name     string         // The name of the test
+		code     codes.Code     // The status code of the error we want
+		settings *GroupSettings // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"unset", codes.OK, &GroupSettings{}},
+		{"all set", codes.OK, &GroupSettings{Timezone: "Europe/Paris", DefaultDueDays: 7, AllowedStatuses: []string{"NOT_STARTED", "COMPLETED"}, MemberLimit: 10, AdminOnlyTasks: true}},
+		{"unknown timezone", codes.InvalidArgument, &GroupSettings{Timezone: "Europe/Atlantis"}},
+		{"negative due days", codes.InvalidArgument, &GroupSettings{DefaultDueDays: -1}},
+		{"unknown status", codes.InvalidArgument, &GroupSettings{AllowedStatuses: []string{"NOT_STARTED", "BLOCKED"}}},
+		{"unspecified status", codes.InvalidArgument, &GroupSettings{AllowedStatuses: []string{"NOT_STARTED", "UNSPECIFIED"}}},
+		{"without not started", codes.InvalidArgument, &GroupSettings{AllowedStatuses: []string{"IN_PROGRESS"}}},
+		{"negative member limit", codes.InvalidArgument, &GroupSettings{MemberLimit: -1}},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			code := codes.OK
+			if err != nil {
+				code = utilities.ParseGRPCErrStatusCode(err)
+			}
+			if code != tt.code {
+				t.Errorf("GroupSettings.Validate() error = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
+
+func Test_GroupSettingsDefaultDue(t *testing.T) {
+	now := time.Date(2030, 1, 31, 3, 0, 0, 0, time.UTC) // still January 30 in New York
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name     string         // The name of the test
+		want     time.Time      // The due date we want
+		settings *GroupSettings // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"no default", time.Time{}, &GroupSettings{DefaultDueDays: 0}},
+		{"utc", time.Date(2030, 2, 2, 23, 59, 59, 0, time.UTC), &GroupSettings{DefaultDueDays: 2}},
+		{"timezone", time.Date(2030, 2, 3, 4, 59, 59, 0, time.UTC), &GroupSettings{Timezone: "America/New_York", DefaultDueDays: 3}},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.settings.DefaultDue(now); !got.Equal(tt.want) {
+				t.Errorf("GroupSettings.DefaultDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+func Test_GroupCheckMove(t *testing.T) {
+	org := &Group{Id: "000000000000000000000002"}
+	team := &Group{Id: "000000000000000000000004", ParentId: org.Id}
+	subTeam := &Group{Id: "000000000000000000000005", ParentId: team.Id}
+	other := &Group{Id: "000000000000000000000003"}
+	deep := []*Group{{Id: "a", ParentId: other.Id}, {Id: "b", ParentId: "a"}, {Id: "c", ParentId: "b"}}
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name        string     // The name of the test
+		group       *Group     // The group being moved
+		parent      *Group     // The new parent of the group
+		ancestors   []*Group   // The ancestors of the new parent
+		descendants []*Group   // The descendants of the group being moved
+		code        codes.Code // The status code of the error we want
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"to top level", team, nil, nil, nil, codes.OK},
+		{"root admin group", &Group{Id: "000000000000000000000001", RootAdmin: true}, org, nil, nil, codes.InvalidArgument},
+		{"to itself", team, team, []*Group{org}, nil, codes.InvalidArgument},
+		{"within its descendant", team, subTeam, []*Group{team, org}, []*Group{subTeam}, codes.FailedPrecondition},
+		{"to another parent", team, other, nil, []*Group{subTeam}, codes.OK},
+		{"at the maximum depth", subTeam, deep[2], []*Group{deep[1], deep[0], other}, nil, codes.OK},
+		{"too deep", other, subTeam, []*Group{team, org}, deep, codes.FailedPrecondition},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.group.CheckMove(tt.parent, tt.ancestors, tt.descendants)
+			code := codes.OK
+			if err != nil {
+				code = utilities.ParseGRPCErrStatusCode(err)
+			}
+			if code != tt.code {
+				t.Errorf("Group.CheckMove() error = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
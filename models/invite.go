@@ -0,0 +1,142 @@
+package models
+
+import (
+	"errors"
+	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+	"time"
+)
+
+// DefaultInviteTTL is how long an Invite created without an expiry can be accepted
+const DefaultInviteTTL = 7 * 24 * time.Hour
+
+// Invite is a root struct that is used to store the json encoded data for/from a mongodb invites doc. Only the hash of
+// its Code is stored; the Code itself is returned once, when the Invite is created.
+type Invite struct {
+	Id           string    `json:"id,omitempty"`
+	GroupId      string    `json:"group_id,omitempty"`
+	Code         string    `json:"code,omitempty"`
+	CodeHash     string    `json:"-"`
+	Email        string    `json:"email,omitempty"`
+	Role         string    `json:"role,omitempty"`
+	MaxUses      int       `json:"max_uses,omitempty"`
+	Uses         int       `json:"uses,omitempty"`
+	CreatedBy    string    `json:"created_by,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	LastModified time.Time `json:"last_modified,omitempty"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+}
+
+// ToProto Convert Invite to proto
+func (g *Invite) ToProto() *groupsService.Invite {
+	return &groupsService.Invite{
+		Id:           g.Id,
+		GroupId:      g.GroupId,
+		Code:         g.Code,
+		Email:        g.Email,
+		Role:         g.Role,
+		MaxUses:      int64(g.MaxUses),
+		Uses:         int64(g.Uses),
+		CreatedBy:    g.CreatedBy,
+		ExpiresAt:    timestamppb.New(g.ExpiresAt),
+		LastModified: timestamppb.New(g.LastModified),
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+	}
+}
+
+// LoadCreateInviteProto inputs a groupsService.CreateInviteReq and returns an Invite
+func LoadCreateInviteProto(u *groupsService.CreateInviteReq) *Invite {
+	i := &Invite{
+		GroupId: u.GetGroupId(),
+		Email:   strings.ToLower(u.GetEmail()),
+		Role:    u.GetRole(),
+		MaxUses: int(u.GetMaxUses()),
+	}
+	if u.GetExpiresAt() != nil {
+		i.ExpiresAt = u.GetExpiresAt().AsTime()
+	}
+	return i
+}
+
+// CheckID determines whether a specified ID is set or not
+func (g *Invite) CheckID(chkId string) bool {
+	switch chkId {
+	case "id":
+		if !utilities.CheckObjectID(g.Id) {
+			return false
+		}
+	case "group_id":
+		if !utilities.CheckObjectID(g.GroupId) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate an Invite for different scenarios such as creating a new Invite
+func (g *Invite) Validate(valCase string) (err error) {
+	var missingFields []string
+	switch valCase {
+	case "create":
+		if !g.CheckID("group_id") {
+			missingFields = append(missingFields, "group_id")
+		}
+		if g.Role == "" {
+			g.Role = "member"
+		}
+		if g.ExpiresAt.IsZero() {
+			g.ExpiresAt = time.Now().UTC().Add(DefaultInviteTTL)
+		}
+	default:
+		return errors.New("unrecognized validation case")
+	}
+	if len(missingFields) > 0 {
+		return utilities.MissingFields("invite", missingFields)
+	}
+	if g.Role != "member" && g.Role != "admin" {
+		return utilities.InvalidField("role", "must be member or admin")
+	}
+	if g.MaxUses < 0 {
+		return utilities.InvalidField("max_uses", "must not be negative")
+	}
+	if !g.ExpiresAt.After(time.Now()) {
+		return utilities.InvalidField("expires_at", "must be in the future")
+	}
+	return
+}
+
+// Redeemable returns why the Invite cannot be accepted by a user with an email address, or nil when it can. An Invite
+// issued to an email address can only be accepted by that address, and a MaxUses of 0 allows any number of uses.
+func (g *Invite) Redeemable(email string) error {
+	if !g.ExpiresAt.After(time.Now()) {
+		return utilities.FailedPrecondition("INVITE_EXPIRED", "invite has expired")
+	}
+	if g.MaxUses > 0 && g.Uses >= g.MaxUses {
+		return utilities.FailedPrecondition("INVITE_EXHAUSTED", "invite has no uses left")
+	}
+	if g.Email != "" && !strings.EqualFold(g.Email, email) {
+		return utilities.PermissionDenied("invite was issued to another email address")
+	}
+	return nil
+}
+
+// InvitesRes Multiple Invites in a paginated response
+type InvitesRes struct {
+	TotalCount int64     `json:"total_count"`
+	TotalPages int64     `json:"total_pages"`
+	Page       int64     `json:"page"`
+	Size       int64     `json:"size"`
+	HasMore    bool      `json:"has_more"`
+	Invites    []*Invite `json:"invites"`
+}
+
+// ToProto convert InvitesRes to proto
+func (p *InvitesRes) ToProto() []*groupsService.Invite {
+	uList := make([]*groupsService.Invite, 0, len(p.Invites))
+	for _, u := range p.Invites {
+		uList = append(uList, u.ToProto())
+	}
+	return uList
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc/codes"
+	"testing"
+	"time"
+)
+
+func Test_InviteRedeemable(t *testing.T) {
+	future, past := time.Now().Add(time.Hour), time.Now().Add(-time.Hour)
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name   string     // The name of the test
+		email  string     // The email address of the accepting user
+		code   codes.Code // The status code of the error we want
+		invite *Invite    // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"unlimited uses", "a@test.com", codes.OK, &Invite{Uses: 10, ExpiresAt: future}},
+		{"uses left", "a@test.com", codes.OK, &Invite{MaxUses: 2, Uses: 1, ExpiresAt: future}},
+		{"used up", "a@test.com", codes.FailedPrecondition, &Invite{MaxUses: 2, Uses: 2, ExpiresAt: future}},
+		{"expired", "a@test.com", codes.FailedPrecondition, &Invite{ExpiresAt: past}},
+		{"email matches", "A@Test.com", codes.OK, &Invite{Email: "a@test.com", ExpiresAt: future}},
+		{"email differs", "b@test.com", codes.PermissionDenied, &Invite{Email: "a@test.com", ExpiresAt: future}},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.invite.Redeemable(tt.email)
+			code := codes.OK
+			if err != nil {
+				code = utilities.ParseGRPCErrStatusCode(err)
+			}
+			if code != tt.code {
+				t.Errorf("Invite.Redeemable() error = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"errors"
+	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+// Membership is a root struct that is used to store the json encoded data for/from a mongodb memberships doc. A User's
+// primary group, its GroupId, is an implicit Membership with its Role; a Membership doc adds the User to another group.
+type Membership struct {
+	Id           string    `json:"id,omitempty"`
+	UserId       string    `json:"user_id,omitempty"`
+	GroupId      string    `json:"group_id,omitempty"`
+	Role         string    `json:"role,omitempty"`
+	Primary      bool      `json:"primary,omitempty"`
+	LastModified time.Time `json:"last_modified,omitempty"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+}
+
+// PrimaryMembership returns the implicit Membership of a User in its primary group
+func PrimaryMembership(u *User) *Membership {
+	return &Membership{
+		UserId:       u.Id,
+		GroupId:      u.GroupId,
+		Role:         u.Role,
+		Primary:      true,
+		LastModified: u.LastModified,
+		CreatedAt:    u.CreatedAt,
+	}
+}
+
+// InGroup returns whether a User belongs to a group, as its primary group or through one of its Memberships
+func InGroup(u *User, memberships []*Membership, groupId string) bool {
+	if u.GroupId == groupId {
+		return true
+	}
+	for _, m := range memberships {
+		if m.GroupId == groupId {
+			return true
+		}
+	}
+	return false
+}
+
+// ToProto Convert Membership to proto
+func (g *Membership) ToProto() *groupsService.Member {
+	return &groupsService.Member{
+		UserId:       g.UserId,
+		GroupId:      g.GroupId,
+		Role:         g.Role,
+		Primary:      g.Primary,
+		LastModified: timestamppb.New(g.LastModified),
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+	}
+}
+
+// LoadAddMemberProto inputs a groupsService.AddMemberReq and returns a Membership
+func LoadAddMemberProto(u *groupsService.AddMemberReq) *Membership {
+	return &Membership{
+		UserId:  u.GetUserId(),
+		GroupId: u.GetGroupId(),
+		Role:    u.GetRole(),
+	}
+}
+
+// CheckID determines whether a specified ID is set or not
+func (g *Membership) CheckID(chkId string) bool {
+	switch chkId {
+	case "id":
+		if !utilities.CheckObjectID(g.Id) {
+			return false
+		}
+	case "user_id":
+		if !utilities.CheckObjectID(g.UserId) {
+			return false
+		}
+	case "group_id":
+		if !utilities.CheckObjectID(g.GroupId) {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate a Membership for different scenarios such as creating a new Membership
+func (g *Membership) Validate(valCase string) (err error) {
+	var missingFields []string
+	switch valCase {
+	case "create":
+		if !g.CheckID("user_id") {
+			missingFields = append(missingFields, "user_id")
+		}
+		if !g.CheckID("group_id") {
+			missingFields = append(missingFields, "group_id")
+		}
+		if g.Role == "" {
+			g.Role = "member"
+		}
+	case "update":
+		if !g.CheckID("user_id") {
+			missingFields = append(missingFields, "user_id")
+		}
+		if !g.CheckID("group_id") {
+			missingFields = append(missingFields, "group_id")
+		}
+		if g.Role == "" {
+			missingFields = append(missingFields, "role")
+		}
+	default:
+		return errors.New("unrecognized validation case")
+	}
+	if len(missingFields) > 0 {
+		return utilities.MissingFields("membership", missingFields)
+	}
+	if g.Role != "member" && g.Role != "admin" {
+		return utilities.InvalidField("role", "must be member or admin")
+	}
+	return
+}
+
+// MembershipsRes Multiple Memberships in a paginated response
+type MembershipsRes struct {
+	TotalCount  int64         `json:"total_count"`
+	TotalPages  int64         `json:"total_pages"`
+	Page        int64         `json:"page"`
+	Size        int64         `json:"size"`
+	HasMore     bool          `json:"has_more"`
+	Memberships []*Membership `json:"memberships"`
+}
+
+// NewMembershipsRes paginates the members of a group, ordered by user id
+func NewMembershipsRes(ms []*Membership, pagination *utilities.Pagination) *MembershipsRes {
+	sort.Slice(ms, func(i, j int) bool { return ms[i].UserId < ms[j].UserId })
+	count := len(ms)
+	start := pagination.GetOffset()
+	if start > count {
+		start = count
+	}
+	end := start + pagination.GetLimit()
+	if pagination.GetLimit() <= 0 || end > count { // as with MongoDB, a limit of 0 is no limit
+		end = count
+	}
+	return &MembershipsRes{
+		TotalCount:  int64(count),
+		TotalPages:  int64(pagination.GetTotalPages(count)),
+		Page:        int64(pagination.GetPage()),
+		Size:        int64(pagination.GetSize()),
+		HasMore:     pagination.GetHasMore(count),
+		Memberships: ms[start:end],
+	}
+}
+
+// ToProto convert MembershipsRes to proto
+func (p *MembershipsRes) ToProto() []*groupsService.Member {
+	uList := make([]*groupsService.Member, 0, len(p.Memberships))
+	for _, u := range p.Memberships {
+		uList = append(uList, u.ToProto())
+	}
+	return uList
+}
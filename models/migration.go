@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// Migration is a root struct that is used to store the json encoded data for/from a mongodb migrations doc.
+type Migration struct {
+	Version     int64     `json:"version"`
+	Description string    `json:"description,omitempty"`
+	Applied     bool      `json:"applied"`
+	AppliedAt   time.Time `json:"applied_at,omitempty"`
+}
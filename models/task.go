@@ -5,7 +5,7 @@ import (
 	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"strings"
+	"sort"
 	"time"
 )
 
@@ -64,28 +64,34 @@ func LoadTaskProto(u *tasksService.Task) *Task {
 	}
 }
 
-// LoadTaskCreateProto inputs a tasksService.CreateReq and returns a Task
+// LoadTaskCreateProto inputs a tasksService.CreateReq and returns a Task; an unset Due is left for the group's default
 func LoadTaskCreateProto(u *tasksService.CreateReq) *Task {
-	return &Task{
+	t := &Task{
 		Name:        u.GetName(),
-		Due:         u.GetDue().AsTime(),
 		Description: u.GetDescription(),
 		UserId:      u.GetUserId(),
 		GroupId:     u.GetGroupId(),
 	}
+	if u.GetDue() != nil {
+		t.Due = u.GetDue().AsTime()
+	}
+	return t
 }
 
-// LoadTaskUpdateProto inputs a tasksService.UpdateReq and returns a Task
+// LoadTaskUpdateProto inputs a tasksService.UpdateReq and returns a Task; an unset Due leaves the due date unchanged
 func LoadTaskUpdateProto(u *tasksService.UpdateReq) *Task {
-	return &Task{
+	t := &Task{
 		Id:          u.GetId(),
 		Name:        u.GetName(),
 		Status:      TaskStatus(u.GetStatus().Number()),
-		Due:         u.GetDue().AsTime(),
 		Description: u.GetDescription(),
 		UserId:      u.GetUserId(),
 		GroupId:     u.GetGroupId(),
 	}
+	if u.GetDue() != nil {
+		t.Due = u.GetDue().AsTime()
+	}
+	return t
 }
 
 // LoadTaskFindProto inputs a tasksService.FindReq and returns a Task
@@ -160,7 +166,7 @@ func (g *Task) Validate(valCase string) (err error) {
 		return errors.New("unrecognized validation case")
 	}
 	if len(missingFields) > 0 {
-		return errors.New("missing the following group fields: " + strings.Join(missingFields, ", "))
+		return utilities.MissingFields("task", missingFields)
 	}
 	return
 }
@@ -197,6 +203,28 @@ type TasksRes struct {
 	Tasks      []*Task `json:"tasks"`
 }
 
+// NewTasksRes paginates tasks gathered from several groups, ordered by creation time
+func NewTasksRes(ts []*Task, pagination *utilities.Pagination) *TasksRes {
+	sort.SliceStable(ts, func(i, j int) bool { return ts[i].CreatedAt.Before(ts[j].CreatedAt) })
+	count := len(ts)
+	start := pagination.GetOffset()
+	if start > count {
+		start = count
+	}
+	end := start + pagination.GetLimit()
+	if pagination.GetLimit() <= 0 || end > count { // as with MongoDB, a limit of 0 is no limit
+		end = count
+	}
+	return &TasksRes{
+		TotalCount: int64(count),
+		TotalPages: int64(pagination.GetTotalPages(count)),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(count),
+		Tasks:      ts[start:end],
+	}
+}
+
 // ToProto convert TasksRes to proto
 func (p *TasksRes) ToProto() []*tasksService.Task {
 	uList := make([]*tasksService.Task, 0, len(p.Tasks))
@@ -205,3 +233,43 @@ func (p *TasksRes) ToProto() []*tasksService.Task {
 	}
 	return uList
 }
+
+// Task change types streamed by TaskService.WatchTasks
+const (
+	TaskChangeCreated = "created"
+	TaskChangeUpdated = "updated"
+	TaskChangeDeleted = "deleted"
+)
+
+// TaskChange is a single create, update, or delete of a Task read from the tasks change stream
+type TaskChange struct {
+	Type        string    `json:"type"`
+	Task        *Task     `json:"task"`
+	ResumeToken string    `json:"resume_token"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+// InScope determines whether a TaskChange is visible to the requester: every task for a root admin,
+// the group's tasks for a group admin, and only their own tasks for a member
+func (c *TaskChange) InScope(tokenData *TokenData) bool {
+	if tokenData.RootAdmin {
+		return true
+	}
+	if c.Task == nil || c.Task.GroupId != tokenData.GroupId {
+		return false
+	}
+	return tokenData.Role == "admin" || c.Task.UserId == tokenData.UserId
+}
+
+// ToProto convert TaskChange to proto
+func (c *TaskChange) ToProto() *tasksService.TaskEvent {
+	e := &tasksService.TaskEvent{
+		Type:        c.Type,
+		ResumeToken: c.ResumeToken,
+		OccurredAt:  timestamppb.New(c.OccurredAt),
+	}
+	if c.Task != nil {
+		e.Task = c.Task.ToProto()
+	}
+	return e
+}
@@ -7,25 +7,33 @@ import (
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"strings"
+	"sort"
 	"time"
 )
 
 // User is a root struct that is used to store the json encoded data for/from a mongodb user doc.
 type User struct {
-	Id           string    `json:"id,omitempty"`
-	Username     string    `json:"username,omitempty"`
-	Password     string    `json:"password,omitempty"`
-	FirstName    string    `json:"firstname,omitempty"`
-	LastName     string    `json:"lastname,omitempty"`
-	Email        string    `json:"email,omitempty"`
-	Role         string    `json:"role,omitempty"`
-	RootAdmin    bool      `json:"root_admin,omitempty"`
-	GroupId      string    `json:"group_id,omitempty"`
-	ImageId      string    `json:"image_id,omitempty"`
-	LastModified time.Time `json:"last_modified,omitempty"`
-	CreatedAt    time.Time `json:"created_at,omitempty"`
-	DeletedAt    time.Time `json:"deleted_at,omitempty"`
+	Id              string    `json:"id,omitempty"`
+	Username        string    `json:"username,omitempty"`
+	Password        string    `json:"password,omitempty"`
+	FirstName       string    `json:"firstname,omitempty"`
+	LastName        string    `json:"lastname,omitempty"`
+	Email           string    `json:"email,omitempty"`
+	Role            string    `json:"role,omitempty"`
+	RootAdmin       bool      `json:"root_admin,omitempty"`
+	GroupId         string    `json:"group_id,omitempty"`
+	ImageId         string    `json:"image_id,omitempty"`
+	TokensRevokedAt time.Time `json:"tokens_revoked_at,omitempty"`
+	LastModified    time.Time `json:"last_modified,omitempty"`
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+	DeletedAt       time.Time `json:"deleted_at,omitempty"`
+}
+
+// Public returns a copy of the User with its password hash removed
+func (g *User) Public() *User {
+	u := *g
+	u.Password = ""
+	return &u
 }
 
 // ToProto Convert User to proto
@@ -243,7 +251,7 @@ func (g *User) Validate(valCase string) (err error) {
 		return errors.New("unrecognized validation case")
 	}
 	if len(missingFields) > 0 {
-		return errors.New("missing the following user fields: " + strings.Join(missingFields, ", "))
+		return utilities.MissingFields("user", missingFields)
 	}
 	return
 }
@@ -256,7 +264,7 @@ func (g *User) BuildFilter() (*User, error) {
 	} else if g.Email != "" {
 		filter.Email = g.Email
 	} else {
-		return nil, errors.New("user is missing a valid query filter")
+		return nil, utilities.InvalidArgument("user is missing a valid query filter")
 	}
 	return &filter, nil
 }
@@ -316,3 +324,25 @@ func (p *UsersRes) ToProto() []*usersService.User {
 	}
 	return uList
 }
+
+// NewUsersRes paginates Users gathered from several queries, oldest first
+func NewUsersRes(us []*User, pagination *utilities.Pagination) *UsersRes {
+	sort.SliceStable(us, func(i, j int) bool { return us[i].CreatedAt.Before(us[j].CreatedAt) })
+	count := len(us)
+	start := pagination.GetOffset()
+	if start > count {
+		start = count
+	}
+	end := start + pagination.GetLimit()
+	if pagination.GetLimit() <= 0 || end > count { // as with MongoDB, a limit of 0 is no limit
+		end = count
+	}
+	return &UsersRes{
+		TotalCount: int64(count),
+		TotalPages: int64(pagination.GetTotalPages(count)),
+		Page:       int64(pagination.GetPage()),
+		Size:       int64(pagination.GetSize()),
+		HasMore:    pagination.GetHasMore(count),
+		Users:      us[start:end],
+	}
+}
@@ -0,0 +1,225 @@
+package models
+
+import (
+	"errors"
+	webhooksService "github.com/JECSand/go-grpc-server-boilerplate/protos/webhook"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/url"
+	"time"
+)
+
+// Webhook delivery statuses
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryDead      = "dead"
+)
+
+// Webhook is a root struct that is used to store the json encoded data for/from a mongodb webhooks doc.
+type Webhook struct {
+	Id           string    `json:"id,omitempty"`
+	GroupId      string    `json:"group_id,omitempty"`
+	URL          string    `json:"url,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	EventTypes   []string  `json:"event_types,omitempty"`
+	Disabled     bool      `json:"disabled,omitempty"`
+	LastModified time.Time `json:"last_modified,omitempty"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+}
+
+// ToProto Convert Webhook to proto, leaving out the signing secret
+func (g *Webhook) ToProto() *webhooksService.Webhook {
+	return &webhooksService.Webhook{
+		Id:           g.Id,
+		GroupId:      g.GroupId,
+		Url:          g.URL,
+		EventTypes:   g.EventTypes,
+		Disabled:     g.Disabled,
+		LastModified: timestamppb.New(g.LastModified),
+		CreatedAt:    timestamppb.New(g.CreatedAt),
+	}
+}
+
+// LoadWebhookCreateProto inputs a webhooksService.CreateReq and returns a Webhook
+func LoadWebhookCreateProto(u *webhooksService.CreateReq) *Webhook {
+	return &Webhook{
+		GroupId:    u.GetGroupId(),
+		URL:        u.GetUrl(),
+		EventTypes: u.GetEventTypes(),
+	}
+}
+
+// LoadWebhookUpdateProto inputs a webhooksService.UpdateReq and returns a Webhook
+func LoadWebhookUpdateProto(u *webhooksService.UpdateReq) *Webhook {
+	return &Webhook{
+		Id:         u.GetId(),
+		URL:        u.GetUrl(),
+		EventTypes: u.GetEventTypes(),
+		Disabled:   u.GetDisabled(),
+	}
+}
+
+// CheckID determines whether a specified ID is set or not
+func (g *Webhook) CheckID(chkId string) bool {
+	switch chkId {
+	case "id":
+		if !utilities.CheckObjectID(g.Id) {
+			return false
+		}
+	case "group_id":
+		if !utilities.CheckObjectID(g.GroupId) {
+			return false
+		}
+	}
+	return true
+}
+
+// Subscribed determines whether the Webhook should receive an event type
+func (g *Webhook) Subscribed(eventType string) bool {
+	if g.Disabled {
+		return false
+	}
+	if len(g.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range g.EventTypes {
+		if t == eventType || t == EventAll {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate a Webhook for different scenarios such as creating a new Webhook or updating a Webhook
+func (g *Webhook) Validate(valCase string) (err error) {
+	var missingFields []string
+	switch valCase {
+	case "create":
+		if !g.CheckID("group_id") {
+			missingFields = append(missingFields, "group_id")
+		}
+		if g.URL == "" {
+			missingFields = append(missingFields, "url")
+		}
+	case "update":
+		if !g.CheckID("id") {
+			missingFields = append(missingFields, "id")
+		}
+	default:
+		return errors.New("unrecognized validation case")
+	}
+	if len(missingFields) > 0 {
+		return utilities.MissingFields("webhook", missingFields)
+	}
+	if g.URL != "" {
+		u, err := url.Parse(g.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return utilities.InvalidField("url", "must be an absolute http or https url")
+		}
+	}
+	for _, t := range g.EventTypes {
+		if !validEventType(t) {
+			return utilities.InvalidField("event_types", "has an unrecognized webhook event type: "+t)
+		}
+	}
+	return
+}
+
+// validEventType determines whether an event type can be subscribed to
+func validEventType(eventType string) bool {
+	if eventType == EventAll {
+		return true
+	}
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildUpdate is a function that setups the base webhook struct during a webhook modification request
+func (g *Webhook) BuildUpdate(cur *Webhook) {
+	g.GroupId = cur.GroupId
+	g.Secret = cur.Secret
+	if len(g.URL) == 0 {
+		g.URL = cur.URL
+	}
+	if len(g.EventTypes) == 0 {
+		g.EventTypes = cur.EventTypes
+	}
+}
+
+// WebhooksRes Multiple Webhooks in a paginated response
+type WebhooksRes struct {
+	TotalCount int64      `json:"total_count"`
+	TotalPages int64      `json:"total_pages"`
+	Page       int64      `json:"page"`
+	Size       int64      `json:"size"`
+	HasMore    bool       `json:"has_more"`
+	Webhooks   []*Webhook `json:"webhooks"`
+}
+
+// ToProto convert WebhooksRes to proto
+func (p *WebhooksRes) ToProto() []*webhooksService.Webhook {
+	uList := make([]*webhooksService.Webhook, 0, len(p.Webhooks))
+	for _, u := range p.Webhooks {
+		uList = append(uList, u.ToProto())
+	}
+	return uList
+}
+
+// WebhookDelivery is a root struct that is used to store the json encoded data for/from a mongodb webhook_deliveries doc.
+type WebhookDelivery struct {
+	Id            string    `json:"id,omitempty"`
+	WebhookId     string    `json:"webhook_id,omitempty"`
+	GroupId       string    `json:"group_id,omitempty"`
+	EventId       string    `json:"event_id,omitempty"`
+	EventType     string    `json:"event_type,omitempty"`
+	Payload       string    `json:"payload,omitempty"`
+	Status        string    `json:"status,omitempty"`
+	Attempts      int       `json:"attempts,omitempty"`
+	ResponseCode  int       `json:"response_code,omitempty"`
+	LastError     string    `json:"last_error,omitempty"`
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	LastModified  time.Time `json:"last_modified,omitempty"`
+	CreatedAt     time.Time `json:"created_at,omitempty"`
+}
+
+// ToProto Convert WebhookDelivery to proto
+func (g *WebhookDelivery) ToProto() *webhooksService.Delivery {
+	return &webhooksService.Delivery{
+		Id:            g.Id,
+		WebhookId:     g.WebhookId,
+		GroupId:       g.GroupId,
+		EventId:       g.EventId,
+		EventType:     g.EventType,
+		Status:        g.Status,
+		Attempts:      int64(g.Attempts),
+		ResponseCode:  int64(g.ResponseCode),
+		LastError:     g.LastError,
+		NextAttemptAt: timestamppb.New(g.NextAttemptAt),
+		LastModified:  timestamppb.New(g.LastModified),
+		CreatedAt:     timestamppb.New(g.CreatedAt),
+	}
+}
+
+// DeliveriesRes Multiple WebhookDeliveries in a paginated response
+type DeliveriesRes struct {
+	TotalCount int64              `json:"total_count"`
+	TotalPages int64              `json:"total_pages"`
+	Page       int64              `json:"page"`
+	Size       int64              `json:"size"`
+	HasMore    bool               `json:"has_more"`
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+}
+
+// ToProto convert DeliveriesRes to proto
+func (p *DeliveriesRes) ToProto() []*webhooksService.Delivery {
+	uList := make([]*webhooksService.Delivery, 0, len(p.Deliveries))
+	for _, u := range p.Deliveries {
+		uList = append(uList, u.ToProto())
+	}
+	return uList
+}
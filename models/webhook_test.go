@@ -0,0 +1,54 @@
+package models
+
+import "testing"
+
+func Test_WebhookSubscribed(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name      string   // The name of the test
+		want      bool     // What out instance we want our function to return.
+		webhook   *Webhook // The input of the test
+		eventType string   // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"no filter", true, &Webhook{}, EventTaskCreated},
+		{"listed", true, &Webhook{EventTypes: []string{EventTaskCompleted}}, EventTaskCompleted},
+		{"not listed", false, &Webhook{EventTypes: []string{EventTaskCompleted}}, EventTaskCreated},
+		{"wildcard", true, &Webhook{EventTypes: []string{EventAll}}, EventGroupDeleted},
+		{"disabled", false, &Webhook{Disabled: true}, EventTaskCreated},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.webhook.Subscribed(tt.eventType); got != tt.want { // Asserting whether we get the correct wanted value
+				t.Errorf("Webhook.Subscribed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_WebhookValidate(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name    string   // The name of the test
+		wantErr bool     // whether we want an error.
+		webhook *Webhook // The input of the test
+		valCase string   // The input of the test
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"valid create", false, &Webhook{GroupId: "000000000000000000000002", URL: "https://example.com/hook"}, "create"},
+		{"missing url", true, &Webhook{GroupId: "000000000000000000000002"}, "create"},
+		{"relative url", true, &Webhook{GroupId: "000000000000000000000002", URL: "/hook"}, "create"},
+		{"unknown event", true, &Webhook{GroupId: "000000000000000000000002", URL: "https://example.com/hook", EventTypes: []string{"task.exploded"}}, "create"},
+		{"valid update", false, &Webhook{Id: "000000000000000000000041"}, "update"},
+		{"missing id", true, &Webhook{URL: "https://example.com/hook"}, "update"},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.webhook.Validate(tt.valCase); (err != nil) != tt.wantErr {
+				t.Errorf("Webhook.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
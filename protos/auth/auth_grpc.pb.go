@@ -28,6 +28,8 @@ type AuthServiceClient interface {
 	Refresh(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*RefreshRes, error)
 	GenerateKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GenerateKeyRes, error)
 	UpdatePassword(ctx context.Context, in *UpdatePasswordReq, opts ...grpc.CallOption) (*UpdatePasswordRes, error)
+	SwitchGroup(ctx context.Context, in *SwitchGroupReq, opts ...grpc.CallOption) (*SwitchGroupRes, error)
+	AcceptInvite(ctx context.Context, in *AcceptInviteReq, opts ...grpc.CallOption) (*AcceptInviteRes, error)
 }
 
 type authServiceClient struct {
@@ -92,6 +94,24 @@ func (c *authServiceClient) UpdatePassword(ctx context.Context, in *UpdatePasswo
 	return out, nil
 }
 
+func (c *authServiceClient) SwitchGroup(ctx context.Context, in *SwitchGroupReq, opts ...grpc.CallOption) (*SwitchGroupRes, error) {
+	out := new(SwitchGroupRes)
+	err := c.cc.Invoke(ctx, "/authService.AuthService/SwitchGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) AcceptInvite(ctx context.Context, in *AcceptInviteReq, opts ...grpc.CallOption) (*AcceptInviteRes, error) {
+	out := new(AcceptInviteRes)
+	err := c.cc.Invoke(ctx, "/authService.AuthService/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations should embed UnimplementedAuthServiceServer
 // for forward compatibility
@@ -102,6 +122,8 @@ type AuthServiceServer interface {
 	Refresh(context.Context, *Empty) (*RefreshRes, error)
 	GenerateKey(context.Context, *Empty) (*GenerateKeyRes, error)
 	UpdatePassword(context.Context, *UpdatePasswordReq) (*UpdatePasswordRes, error)
+	SwitchGroup(context.Context, *SwitchGroupReq) (*SwitchGroupRes, error)
+	AcceptInvite(context.Context, *AcceptInviteReq) (*AcceptInviteRes, error)
 }
 
 // UnimplementedAuthServiceServer should be embedded to have forward compatible implementations.
@@ -126,6 +148,12 @@ func (UnimplementedAuthServiceServer) GenerateKey(context.Context, *Empty) (*Gen
 func (UnimplementedAuthServiceServer) UpdatePassword(context.Context, *UpdatePasswordReq) (*UpdatePasswordRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassword not implemented")
 }
+func (UnimplementedAuthServiceServer) SwitchGroup(context.Context, *SwitchGroupReq) (*SwitchGroupRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchGroup not implemented")
+}
+func (UnimplementedAuthServiceServer) AcceptInvite(context.Context, *AcceptInviteReq) (*AcceptInviteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AuthServiceServer will
@@ -246,6 +274,42 @@ func _AuthService_UpdatePassword_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_SwitchGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).SwitchGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authService.AuthService/SwitchGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).SwitchGroup(ctx, req.(*SwitchGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/authService.AuthService/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).AcceptInvite(ctx, req.(*AcceptInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +341,14 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePassword",
 			Handler:    _AuthService_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "SwitchGroup",
+			Handler:    _AuthService_SwitchGroup_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _AuthService_AcceptInvite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
@@ -27,6 +27,17 @@ type GroupServiceClient interface {
 	Get(ctx context.Context, in *GetReq, opts ...grpc.CallOption) (*GetRes, error)
 	Find(ctx context.Context, in *FindReq, opts ...grpc.CallOption) (*FindRes, error)
 	Delete(ctx context.Context, in *DeleteReq, opts ...grpc.CallOption) (*DeleteRes, error)
+	GetSettings(ctx context.Context, in *GetSettingsReq, opts ...grpc.CallOption) (*GetSettingsRes, error)
+	UpdateSettings(ctx context.Context, in *UpdateSettingsReq, opts ...grpc.CallOption) (*UpdateSettingsRes, error)
+	Move(ctx context.Context, in *MoveReq, opts ...grpc.CallOption) (*MoveRes, error)
+	ListDescendants(ctx context.Context, in *ListDescendantsReq, opts ...grpc.CallOption) (*ListDescendantsRes, error)
+	AddMember(ctx context.Context, in *AddMemberReq, opts ...grpc.CallOption) (*AddMemberRes, error)
+	RemoveMember(ctx context.Context, in *RemoveMemberReq, opts ...grpc.CallOption) (*RemoveMemberRes, error)
+	ListMembers(ctx context.Context, in *ListMembersReq, opts ...grpc.CallOption) (*ListMembersRes, error)
+	TransferAdmin(ctx context.Context, in *TransferAdminReq, opts ...grpc.CallOption) (*TransferAdminRes, error)
+	CreateInvite(ctx context.Context, in *CreateInviteReq, opts ...grpc.CallOption) (*CreateInviteRes, error)
+	ListInvites(ctx context.Context, in *ListInvitesReq, opts ...grpc.CallOption) (*ListInvitesRes, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteReq, opts ...grpc.CallOption) (*RevokeInviteRes, error)
 }
 
 type groupServiceClient struct {
@@ -82,6 +93,105 @@ func (c *groupServiceClient) Delete(ctx context.Context, in *DeleteReq, opts ...
 	return out, nil
 }
 
+func (c *groupServiceClient) GetSettings(ctx context.Context, in *GetSettingsReq, opts ...grpc.CallOption) (*GetSettingsRes, error) {
+	out := new(GetSettingsRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/GetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateSettings(ctx context.Context, in *UpdateSettingsReq, opts ...grpc.CallOption) (*UpdateSettingsRes, error) {
+	out := new(UpdateSettingsRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/UpdateSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) Move(ctx context.Context, in *MoveReq, opts ...grpc.CallOption) (*MoveRes, error) {
+	out := new(MoveRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/Move", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListDescendants(ctx context.Context, in *ListDescendantsReq, opts ...grpc.CallOption) (*ListDescendantsRes, error) {
+	out := new(ListDescendantsRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/ListDescendants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) AddMember(ctx context.Context, in *AddMemberReq, opts ...grpc.CallOption) (*AddMemberRes, error) {
+	out := new(AddMemberRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/AddMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) RemoveMember(ctx context.Context, in *RemoveMemberReq, opts ...grpc.CallOption) (*RemoveMemberRes, error) {
+	out := new(RemoveMemberRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/RemoveMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListMembers(ctx context.Context, in *ListMembersReq, opts ...grpc.CallOption) (*ListMembersRes, error) {
+	out := new(ListMembersRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/ListMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) TransferAdmin(ctx context.Context, in *TransferAdminReq, opts ...grpc.CallOption) (*TransferAdminRes, error) {
+	out := new(TransferAdminRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/TransferAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) CreateInvite(ctx context.Context, in *CreateInviteReq, opts ...grpc.CallOption) (*CreateInviteRes, error) {
+	out := new(CreateInviteRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/CreateInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) ListInvites(ctx context.Context, in *ListInvitesReq, opts ...grpc.CallOption) (*ListInvitesRes, error) {
+	out := new(ListInvitesRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/ListInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) RevokeInvite(ctx context.Context, in *RevokeInviteReq, opts ...grpc.CallOption) (*RevokeInviteRes, error) {
+	out := new(RevokeInviteRes)
+	err := c.cc.Invoke(ctx, "/groupsService.GroupService/RevokeInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServiceServer is the server API for GroupService service.
 // All implementations should embed UnimplementedGroupServiceServer
 // for forward compatibility
@@ -91,6 +201,17 @@ type GroupServiceServer interface {
 	Get(context.Context, *GetReq) (*GetRes, error)
 	Find(context.Context, *FindReq) (*FindRes, error)
 	Delete(context.Context, *DeleteReq) (*DeleteRes, error)
+	GetSettings(context.Context, *GetSettingsReq) (*GetSettingsRes, error)
+	UpdateSettings(context.Context, *UpdateSettingsReq) (*UpdateSettingsRes, error)
+	Move(context.Context, *MoveReq) (*MoveRes, error)
+	ListDescendants(context.Context, *ListDescendantsReq) (*ListDescendantsRes, error)
+	AddMember(context.Context, *AddMemberReq) (*AddMemberRes, error)
+	RemoveMember(context.Context, *RemoveMemberReq) (*RemoveMemberRes, error)
+	ListMembers(context.Context, *ListMembersReq) (*ListMembersRes, error)
+	TransferAdmin(context.Context, *TransferAdminReq) (*TransferAdminRes, error)
+	CreateInvite(context.Context, *CreateInviteReq) (*CreateInviteRes, error)
+	ListInvites(context.Context, *ListInvitesReq) (*ListInvitesRes, error)
+	RevokeInvite(context.Context, *RevokeInviteReq) (*RevokeInviteRes, error)
 }
 
 // UnimplementedGroupServiceServer should be embedded to have forward compatible implementations.
@@ -112,6 +233,39 @@ func (UnimplementedGroupServiceServer) Find(context.Context, *FindReq) (*FindRes
 func (UnimplementedGroupServiceServer) Delete(context.Context, *DeleteReq) (*DeleteRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedGroupServiceServer) GetSettings(context.Context, *GetSettingsReq) (*GetSettingsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateSettings(context.Context, *UpdateSettingsReq) (*UpdateSettingsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
+func (UnimplementedGroupServiceServer) Move(context.Context, *MoveReq) (*MoveRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Move not implemented")
+}
+func (UnimplementedGroupServiceServer) ListDescendants(context.Context, *ListDescendantsReq) (*ListDescendantsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDescendants not implemented")
+}
+func (UnimplementedGroupServiceServer) AddMember(context.Context, *AddMemberReq) (*AddMemberRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMember not implemented")
+}
+func (UnimplementedGroupServiceServer) RemoveMember(context.Context, *RemoveMemberReq) (*RemoveMemberRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMember not implemented")
+}
+func (UnimplementedGroupServiceServer) ListMembers(context.Context, *ListMembersReq) (*ListMembersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedGroupServiceServer) TransferAdmin(context.Context, *TransferAdminReq) (*TransferAdminRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAdmin not implemented")
+}
+func (UnimplementedGroupServiceServer) CreateInvite(context.Context, *CreateInviteReq) (*CreateInviteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedGroupServiceServer) ListInvites(context.Context, *ListInvitesReq) (*ListInvitesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (UnimplementedGroupServiceServer) RevokeInvite(context.Context, *RevokeInviteReq) (*RevokeInviteRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
+}
 
 // UnsafeGroupServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to GroupServiceServer will
@@ -214,6 +368,204 @@ func _GroupService_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/GetSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetSettings(ctx, req.(*GetSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/UpdateSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateSettings(ctx, req.(*UpdateSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/Move",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).Move(ctx, req.(*MoveReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListDescendants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDescendantsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListDescendants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/ListDescendants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListDescendants(ctx, req.(*ListDescendantsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_AddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).AddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/AddMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).AddMember(ctx, req.(*AddMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_RemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/RemoveMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RemoveMember(ctx, req.(*RemoveMemberReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/ListMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListMembers(ctx, req.(*ListMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_TransferAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).TransferAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/TransferAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).TransferAdmin(ctx, req.(*TransferAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/CreateInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).CreateInvite(ctx, req.(*CreateInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/ListInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).ListInvites(ctx, req.(*ListInvitesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/groupsService.GroupService/RevokeInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).RevokeInvite(ctx, req.(*RevokeInviteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +593,50 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _GroupService_Delete_Handler,
 		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _GroupService_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _GroupService_UpdateSettings_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _GroupService_Move_Handler,
+		},
+		{
+			MethodName: "ListDescendants",
+			Handler:    _GroupService_ListDescendants_Handler,
+		},
+		{
+			MethodName: "AddMember",
+			Handler:    _GroupService_AddMember_Handler,
+		},
+		{
+			MethodName: "RemoveMember",
+			Handler:    _GroupService_RemoveMember_Handler,
+		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _GroupService_ListMembers_Handler,
+		},
+		{
+			MethodName: "TransferAdmin",
+			Handler:    _GroupService_TransferAdmin_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _GroupService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _GroupService_ListInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _GroupService_RevokeInvite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "group.proto",
@@ -29,6 +29,16 @@ type TaskServiceClient interface {
 	Delete(ctx context.Context, in *DeleteReq, opts ...grpc.CallOption) (*DeleteRes, error)
 	GetUserTasks(ctx context.Context, in *GetUserTasksReq, opts ...grpc.CallOption) (*GetUserTasksRes, error)
 	GetGroupTasks(ctx context.Context, in *GetGroupTasksReq, opts ...grpc.CallOption) (*GetGroupTasksRes, error)
+	WatchTasks(ctx context.Context, in *WatchTasksReq, opts ...grpc.CallOption) (TaskService_WatchTasksClient, error)
+	AddComment(ctx context.Context, in *AddCommentReq, opts ...grpc.CallOption) (*AddCommentRes, error)
+	UpdateComment(ctx context.Context, in *UpdateCommentReq, opts ...grpc.CallOption) (*UpdateCommentRes, error)
+	DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*DeleteCommentRes, error)
+	ListComments(ctx context.Context, in *ListCommentsReq, opts ...grpc.CallOption) (*ListCommentsRes, error)
+	ListActivity(ctx context.Context, in *ListActivityReq, opts ...grpc.CallOption) (*ListActivityRes, error)
+	AddAttachment(ctx context.Context, opts ...grpc.CallOption) (TaskService_AddAttachmentClient, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsReq, opts ...grpc.CallOption) (*ListAttachmentsRes, error)
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentReq, opts ...grpc.CallOption) (TaskService_DownloadAttachmentClient, error)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentReq, opts ...grpc.CallOption) (*DeleteAttachmentRes, error)
 }
 
 type taskServiceClient struct {
@@ -102,6 +112,167 @@ func (c *taskServiceClient) GetGroupTasks(ctx context.Context, in *GetGroupTasks
 	return out, nil
 }
 
+func (c *taskServiceClient) WatchTasks(ctx context.Context, in *WatchTasksReq, opts ...grpc.CallOption) (TaskService_WatchTasksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[0], "/tasksService.TaskService/WatchTasks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskServiceWatchTasksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaskService_WatchTasksClient interface {
+	Recv() (*TaskEvent, error)
+	grpc.ClientStream
+}
+
+type taskServiceWatchTasksClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskServiceWatchTasksClient) Recv() (*TaskEvent, error) {
+	m := new(TaskEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskServiceClient) AddComment(ctx context.Context, in *AddCommentReq, opts ...grpc.CallOption) (*AddCommentRes, error) {
+	out := new(AddCommentRes)
+	err := c.cc.Invoke(ctx, "/tasksService.TaskService/AddComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) UpdateComment(ctx context.Context, in *UpdateCommentReq, opts ...grpc.CallOption) (*UpdateCommentRes, error) {
+	out := new(UpdateCommentRes)
+	err := c.cc.Invoke(ctx, "/tasksService.TaskService/UpdateComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DeleteComment(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*DeleteCommentRes, error) {
+	out := new(DeleteCommentRes)
+	err := c.cc.Invoke(ctx, "/tasksService.TaskService/DeleteComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListComments(ctx context.Context, in *ListCommentsReq, opts ...grpc.CallOption) (*ListCommentsRes, error) {
+	out := new(ListCommentsRes)
+	err := c.cc.Invoke(ctx, "/tasksService.TaskService/ListComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListActivity(ctx context.Context, in *ListActivityReq, opts ...grpc.CallOption) (*ListActivityRes, error) {
+	out := new(ListActivityRes)
+	err := c.cc.Invoke(ctx, "/tasksService.TaskService/ListActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) AddAttachment(ctx context.Context, opts ...grpc.CallOption) (TaskService_AddAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[1], "/tasksService.TaskService/AddAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskServiceAddAttachmentClient{stream}
+	return x, nil
+}
+
+type TaskService_AddAttachmentClient interface {
+	Send(*AddAttachmentReq) error
+	CloseAndRecv() (*AddAttachmentRes, error)
+	grpc.ClientStream
+}
+
+type taskServiceAddAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskServiceAddAttachmentClient) Send(m *AddAttachmentReq) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *taskServiceAddAttachmentClient) CloseAndRecv() (*AddAttachmentRes, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(AddAttachmentRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsReq, opts ...grpc.CallOption) (*ListAttachmentsRes, error) {
+	out := new(ListAttachmentsRes)
+	err := c.cc.Invoke(ctx, "/tasksService.TaskService/ListAttachments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentReq, opts ...grpc.CallOption) (TaskService_DownloadAttachmentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[2], "/tasksService.TaskService/DownloadAttachment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &taskServiceDownloadAttachmentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TaskService_DownloadAttachmentClient interface {
+	Recv() (*DownloadAttachmentRes, error)
+	grpc.ClientStream
+}
+
+type taskServiceDownloadAttachmentClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskServiceDownloadAttachmentClient) Recv() (*DownloadAttachmentRes, error) {
+	m := new(DownloadAttachmentRes)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *taskServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentReq, opts ...grpc.CallOption) (*DeleteAttachmentRes, error) {
+	out := new(DeleteAttachmentRes)
+	err := c.cc.Invoke(ctx, "/tasksService.TaskService/DeleteAttachment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations should embed UnimplementedTaskServiceServer
 // for forward compatibility
@@ -113,6 +284,16 @@ type TaskServiceServer interface {
 	Delete(context.Context, *DeleteReq) (*DeleteRes, error)
 	GetUserTasks(context.Context, *GetUserTasksReq) (*GetUserTasksRes, error)
 	GetGroupTasks(context.Context, *GetGroupTasksReq) (*GetGroupTasksRes, error)
+	WatchTasks(*WatchTasksReq, TaskService_WatchTasksServer) error
+	AddComment(context.Context, *AddCommentReq) (*AddCommentRes, error)
+	UpdateComment(context.Context, *UpdateCommentReq) (*UpdateCommentRes, error)
+	DeleteComment(context.Context, *DeleteCommentReq) (*DeleteCommentRes, error)
+	ListComments(context.Context, *ListCommentsReq) (*ListCommentsRes, error)
+	ListActivity(context.Context, *ListActivityReq) (*ListActivityRes, error)
+	AddAttachment(TaskService_AddAttachmentServer) error
+	ListAttachments(context.Context, *ListAttachmentsReq) (*ListAttachmentsRes, error)
+	DownloadAttachment(*DownloadAttachmentReq, TaskService_DownloadAttachmentServer) error
+	DeleteAttachment(context.Context, *DeleteAttachmentReq) (*DeleteAttachmentRes, error)
 }
 
 // UnimplementedTaskServiceServer should be embedded to have forward compatible implementations.
@@ -140,6 +321,36 @@ func (UnimplementedTaskServiceServer) GetUserTasks(context.Context, *GetUserTask
 func (UnimplementedTaskServiceServer) GetGroupTasks(context.Context, *GetGroupTasksReq) (*GetGroupTasksRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroupTasks not implemented")
 }
+func (UnimplementedTaskServiceServer) WatchTasks(*WatchTasksReq, TaskService_WatchTasksServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) AddComment(context.Context, *AddCommentReq) (*AddCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddComment not implemented")
+}
+func (UnimplementedTaskServiceServer) UpdateComment(context.Context, *UpdateCommentReq) (*UpdateCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteComment(context.Context, *DeleteCommentReq) (*DeleteCommentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedTaskServiceServer) ListComments(context.Context, *ListCommentsReq) (*ListCommentsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedTaskServiceServer) ListActivity(context.Context, *ListActivityReq) (*ListActivityRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActivity not implemented")
+}
+func (UnimplementedTaskServiceServer) AddAttachment(TaskService_AddAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method AddAttachment not implemented")
+}
+func (UnimplementedTaskServiceServer) ListAttachments(context.Context, *ListAttachmentsReq) (*ListAttachmentsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedTaskServiceServer) DownloadAttachment(*DownloadAttachmentReq, TaskService_DownloadAttachmentServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedTaskServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentReq) (*DeleteAttachmentRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
 
 // UnsafeTaskServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to TaskServiceServer will
@@ -278,6 +489,200 @@ func _TaskService_GetGroupTasks_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_WatchTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTasksReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).WatchTasks(m, &taskServiceWatchTasksServer{stream})
+}
+
+type TaskService_WatchTasksServer interface {
+	Send(*TaskEvent) error
+	grpc.ServerStream
+}
+
+type taskServiceWatchTasksServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskServiceWatchTasksServer) Send(m *TaskEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TaskService_AddComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).AddComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasksService.TaskService/AddComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).AddComment(ctx, req.(*AddCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasksService.TaskService/UpdateComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateComment(ctx, req.(*UpdateCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCommentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasksService.TaskService/DeleteComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteComment(ctx, req.(*DeleteCommentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasksService.TaskService/ListComments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListComments(ctx, req.(*ListCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActivityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasksService.TaskService/ListActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListActivity(ctx, req.(*ListActivityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_AddAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TaskServiceServer).AddAttachment(&taskServiceAddAttachmentServer{stream})
+}
+
+type TaskService_AddAttachmentServer interface {
+	SendAndClose(*AddAttachmentRes) error
+	Recv() (*AddAttachmentReq, error)
+	grpc.ServerStream
+}
+
+type taskServiceAddAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskServiceAddAttachmentServer) SendAndClose(m *AddAttachmentRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *taskServiceAddAttachmentServer) Recv() (*AddAttachmentReq, error) {
+	m := new(AddAttachmentReq)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TaskService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasksService.TaskService/ListAttachments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListAttachments(ctx, req.(*ListAttachmentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).DownloadAttachment(m, &taskServiceDownloadAttachmentServer{stream})
+}
+
+type TaskService_DownloadAttachmentServer interface {
+	Send(*DownloadAttachmentRes) error
+	grpc.ServerStream
+}
+
+type taskServiceDownloadAttachmentServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskServiceDownloadAttachmentServer) Send(m *DownloadAttachmentRes) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TaskService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tasksService.TaskService/DeleteAttachment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -313,7 +718,51 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroupTasks",
 			Handler:    _TaskService_GetGroupTasks_Handler,
 		},
+		{
+			MethodName: "AddComment",
+			Handler:    _TaskService_AddComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _TaskService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _TaskService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _TaskService_ListComments_Handler,
+		},
+		{
+			MethodName: "ListActivity",
+			Handler:    _TaskService_ListActivity_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _TaskService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _TaskService_DeleteAttachment_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTasks",
+			Handler:       _TaskService_WatchTasks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AddAttachment",
+			Handler:       _TaskService_AddAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _TaskService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "task.proto",
 }
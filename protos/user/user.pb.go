@@ -21,6 +21,52 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TaskTransfer int32
+
+const (
+	TaskTransfer_MOVE_WITH_USER TaskTransfer = 0
+	TaskTransfer_REASSIGN       TaskTransfer = 1
+)
+
+// Enum value maps for TaskTransfer.
+var (
+	TaskTransfer_name = map[int32]string{
+		0: "MOVE_WITH_USER",
+		1: "REASSIGN",
+	}
+	TaskTransfer_value = map[string]int32{
+		"MOVE_WITH_USER": 0,
+		"REASSIGN":       1,
+	}
+)
+
+func (x TaskTransfer) Enum() *TaskTransfer {
+	p := new(TaskTransfer)
+	*p = x
+	return p
+}
+
+func (x TaskTransfer) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskTransfer) Descriptor() protoreflect.EnumDescriptor {
+	return file_user_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskTransfer) Type() protoreflect.EnumType {
+	return &file_user_proto_enumTypes[0]
+}
+
+func (x TaskTransfer) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskTransfer.Descriptor instead.
+func (TaskTransfer) EnumDescriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{0}
+}
+
 type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -998,6 +1044,140 @@ func (x *DeleteRes) GetUser() *User {
 	return nil
 }
 
+type MoveUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string       `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	GroupId    string       `protobuf:"bytes,2,opt,name=GroupId,proto3" json:"GroupId,omitempty"`
+	Role       string       `protobuf:"bytes,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	Tasks      TaskTransfer `protobuf:"varint,4,opt,name=Tasks,proto3,enum=usersService.TaskTransfer" json:"Tasks,omitempty"`
+	AssigneeId string       `protobuf:"bytes,5,opt,name=AssigneeId,proto3" json:"AssigneeId,omitempty"`
+}
+
+func (x *MoveUserReq) Reset() {
+	*x = MoveUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserReq) ProtoMessage() {}
+
+func (x *MoveUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserReq.ProtoReflect.Descriptor instead.
+func (*MoveUserReq) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MoveUserReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveUserReq) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *MoveUserReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *MoveUserReq) GetTasks() TaskTransfer {
+	if x != nil {
+		return x.Tasks
+	}
+	return TaskTransfer_MOVE_WITH_USER
+}
+
+func (x *MoveUserReq) GetAssigneeId() string {
+	if x != nil {
+		return x.AssigneeId
+	}
+	return ""
+}
+
+type MoveUserRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      *User `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	TaskCount int64 `protobuf:"varint,2,opt,name=TaskCount,proto3" json:"TaskCount,omitempty"`
+}
+
+func (x *MoveUserRes) Reset() {
+	*x = MoveUserRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_user_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveUserRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveUserRes) ProtoMessage() {}
+
+func (x *MoveUserRes) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveUserRes.ProtoReflect.Descriptor instead.
+func (*MoveUserRes) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MoveUserRes) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MoveUserRes) GetTaskCount() int64 {
+	if x != nil {
+		return x.TaskCount
+	}
+	return 0
+}
+
 type UploadImageReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1010,7 +1190,7 @@ type UploadImageReq struct {
 func (x *UploadImageReq) Reset() {
 	*x = UploadImageReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[14]
+		mi := &file_user_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1023,7 +1203,7 @@ func (x *UploadImageReq) String() string {
 func (*UploadImageReq) ProtoMessage() {}
 
 func (x *UploadImageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[14]
+	mi := &file_user_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,7 +1216,7 @@ func (x *UploadImageReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadImageReq.ProtoReflect.Descriptor instead.
 func (*UploadImageReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{14}
+	return file_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UploadImageReq) GetMime() string {
@@ -1064,7 +1244,7 @@ type UploadImageRes struct {
 func (x *UploadImageRes) Reset() {
 	*x = UploadImageRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[15]
+		mi := &file_user_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1077,7 +1257,7 @@ func (x *UploadImageRes) String() string {
 func (*UploadImageRes) ProtoMessage() {}
 
 func (x *UploadImageRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[15]
+	mi := &file_user_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,7 +1270,7 @@ func (x *UploadImageRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadImageRes.ProtoReflect.Descriptor instead.
 func (*UploadImageRes) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{15}
+	return file_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UploadImageRes) GetName() string {
@@ -1111,7 +1291,7 @@ type DownloadImageReq struct {
 func (x *DownloadImageReq) Reset() {
 	*x = DownloadImageReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[16]
+		mi := &file_user_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1124,7 +1304,7 @@ func (x *DownloadImageReq) String() string {
 func (*DownloadImageReq) ProtoMessage() {}
 
 func (x *DownloadImageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[16]
+	mi := &file_user_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1317,7 @@ func (x *DownloadImageReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadImageReq.ProtoReflect.Descriptor instead.
 func (*DownloadImageReq) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{16}
+	return file_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DownloadImageReq) GetId() string {
@@ -1158,7 +1338,7 @@ type DownloadImageRes struct {
 func (x *DownloadImageRes) Reset() {
 	*x = DownloadImageRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_user_proto_msgTypes[17]
+		mi := &file_user_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1171,7 +1351,7 @@ func (x *DownloadImageRes) String() string {
 func (*DownloadImageRes) ProtoMessage() {}
 
 func (x *DownloadImageRes) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[17]
+	mi := &file_user_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1184,7 +1364,7 @@ func (x *DownloadImageRes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadImageRes.ProtoReflect.Descriptor instead.
 func (*DownloadImageRes) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{17}
+	return file_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *DownloadImageRes) GetChunk() []byte {
@@ -1309,44 +1489,67 @@ var file_user_proto_rawDesc = []byte{
 	0x22, 0x33, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a,
 	0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x75, 0x73,
 	0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x04, 0x55, 0x73, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e,
-	0x6b, 0x22, 0x24, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
-	0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x10, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x32, 0x87, 0x03, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x04, 0x55, 0x73, 0x65, 0x72, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x05,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x65, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x0b, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x0a, 0x0e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x24, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x10,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64,
+	0x22, 0x28, 0x0a, 0x10, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x2a, 0x30, 0x0a, 0x0c, 0x54, 0x61,
+	0x73, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x4f,
+	0x56, 0x45, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x32, 0xcb, 0x03, 0x0a,
+	0x0b, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x06,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a,
 	0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x06, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
 	0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x22,
-	0x00, 0x12, 0x33, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x33, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12,
+	0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e,
 	0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x04, 0x46, 0x69, 0x6e,
-	0x64, 0x12, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x22,
-	0x00, 0x12, 0x3c, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x75, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x04, 0x46, 0x69, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
+	0x15, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73,
 	0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x42,
-	0x10, 0x5a, 0x0e, 0x2e, 0x3b, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x52, 0x65, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x19, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x22, 0x00, 0x42, 0x10, 0x5a, 0x0e, 0x2e, 0x3b,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1361,56 +1564,64 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_user_proto_goTypes = []interface{}{
-	(*User)(nil),                  // 0: usersService.User
-	(*Empty)(nil),                 // 1: usersService.Empty
-	(*CreateReq)(nil),             // 2: usersService.CreateReq
-	(*CreateRes)(nil),             // 3: usersService.CreateRes
-	(*UpdateReq)(nil),             // 4: usersService.UpdateReq
-	(*UpdateRes)(nil),             // 5: usersService.UpdateRes
-	(*GetReq)(nil),                // 6: usersService.GetReq
-	(*GetRes)(nil),                // 7: usersService.GetRes
-	(*GetGroupUsersReq)(nil),      // 8: usersService.GetGroupUsersReq
-	(*GetGroupUsersRes)(nil),      // 9: usersService.GetGroupUsersRes
-	(*FindReq)(nil),               // 10: usersService.FindReq
-	(*FindRes)(nil),               // 11: usersService.FindRes
-	(*DeleteReq)(nil),             // 12: usersService.DeleteReq
-	(*DeleteRes)(nil),             // 13: usersService.DeleteRes
-	(*UploadImageReq)(nil),        // 14: usersService.UploadImageReq
-	(*UploadImageRes)(nil),        // 15: usersService.UploadImageRes
-	(*DownloadImageReq)(nil),      // 16: usersService.DownloadImageReq
-	(*DownloadImageRes)(nil),      // 17: usersService.DownloadImageRes
-	(*timestamppb.Timestamp)(nil), // 18: google.protobuf.Timestamp
+	(TaskTransfer)(0),             // 0: usersService.TaskTransfer
+	(*User)(nil),                  // 1: usersService.User
+	(*Empty)(nil),                 // 2: usersService.Empty
+	(*CreateReq)(nil),             // 3: usersService.CreateReq
+	(*CreateRes)(nil),             // 4: usersService.CreateRes
+	(*UpdateReq)(nil),             // 5: usersService.UpdateReq
+	(*UpdateRes)(nil),             // 6: usersService.UpdateRes
+	(*GetReq)(nil),                // 7: usersService.GetReq
+	(*GetRes)(nil),                // 8: usersService.GetRes
+	(*GetGroupUsersReq)(nil),      // 9: usersService.GetGroupUsersReq
+	(*GetGroupUsersRes)(nil),      // 10: usersService.GetGroupUsersRes
+	(*FindReq)(nil),               // 11: usersService.FindReq
+	(*FindRes)(nil),               // 12: usersService.FindRes
+	(*DeleteReq)(nil),             // 13: usersService.DeleteReq
+	(*DeleteRes)(nil),             // 14: usersService.DeleteRes
+	(*MoveUserReq)(nil),           // 15: usersService.MoveUserReq
+	(*MoveUserRes)(nil),           // 16: usersService.MoveUserRes
+	(*UploadImageReq)(nil),        // 17: usersService.UploadImageReq
+	(*UploadImageRes)(nil),        // 18: usersService.UploadImageRes
+	(*DownloadImageReq)(nil),      // 19: usersService.DownloadImageReq
+	(*DownloadImageRes)(nil),      // 20: usersService.DownloadImageRes
+	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
 }
 var file_user_proto_depIdxs = []int32{
-	18, // 0: usersService.User.LastModified:type_name -> google.protobuf.Timestamp
-	18, // 1: usersService.User.CreatedAt:type_name -> google.protobuf.Timestamp
-	18, // 2: usersService.User.DeletedAt:type_name -> google.protobuf.Timestamp
-	0,  // 3: usersService.CreateRes.User:type_name -> usersService.User
-	0,  // 4: usersService.UpdateRes.User:type_name -> usersService.User
-	0,  // 5: usersService.GetRes.User:type_name -> usersService.User
-	0,  // 6: usersService.GetGroupUsersRes.Users:type_name -> usersService.User
-	0,  // 7: usersService.FindReq.User:type_name -> usersService.User
-	0,  // 8: usersService.FindRes.Users:type_name -> usersService.User
-	0,  // 9: usersService.DeleteRes.User:type_name -> usersService.User
-	2,  // 10: usersService.UserService.Create:input_type -> usersService.CreateReq
-	4,  // 11: usersService.UserService.Update:input_type -> usersService.UpdateReq
-	6,  // 12: usersService.UserService.Get:input_type -> usersService.GetReq
-	8,  // 13: usersService.UserService.GetGroupUsers:input_type -> usersService.GetGroupUsersReq
-	10, // 14: usersService.UserService.Find:input_type -> usersService.FindReq
-	12, // 15: usersService.UserService.Delete:input_type -> usersService.DeleteReq
-	3,  // 16: usersService.UserService.Create:output_type -> usersService.CreateRes
-	5,  // 17: usersService.UserService.Update:output_type -> usersService.UpdateRes
-	7,  // 18: usersService.UserService.Get:output_type -> usersService.GetRes
-	9,  // 19: usersService.UserService.GetGroupUsers:output_type -> usersService.GetGroupUsersRes
-	11, // 20: usersService.UserService.Find:output_type -> usersService.FindRes
-	13, // 21: usersService.UserService.Delete:output_type -> usersService.DeleteRes
-	16, // [16:22] is the sub-list for method output_type
-	10, // [10:16] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	21, // 0: usersService.User.LastModified:type_name -> google.protobuf.Timestamp
+	21, // 1: usersService.User.CreatedAt:type_name -> google.protobuf.Timestamp
+	21, // 2: usersService.User.DeletedAt:type_name -> google.protobuf.Timestamp
+	1,  // 3: usersService.CreateRes.User:type_name -> usersService.User
+	1,  // 4: usersService.UpdateRes.User:type_name -> usersService.User
+	1,  // 5: usersService.GetRes.User:type_name -> usersService.User
+	1,  // 6: usersService.GetGroupUsersRes.Users:type_name -> usersService.User
+	1,  // 7: usersService.FindReq.User:type_name -> usersService.User
+	1,  // 8: usersService.FindRes.Users:type_name -> usersService.User
+	1,  // 9: usersService.DeleteRes.User:type_name -> usersService.User
+	0,  // 10: usersService.MoveUserReq.Tasks:type_name -> usersService.TaskTransfer
+	1,  // 11: usersService.MoveUserRes.User:type_name -> usersService.User
+	3,  // 12: usersService.UserService.Create:input_type -> usersService.CreateReq
+	5,  // 13: usersService.UserService.Update:input_type -> usersService.UpdateReq
+	7,  // 14: usersService.UserService.Get:input_type -> usersService.GetReq
+	9,  // 15: usersService.UserService.GetGroupUsers:input_type -> usersService.GetGroupUsersReq
+	11, // 16: usersService.UserService.Find:input_type -> usersService.FindReq
+	13, // 17: usersService.UserService.Delete:input_type -> usersService.DeleteReq
+	15, // 18: usersService.UserService.MoveUser:input_type -> usersService.MoveUserReq
+	4,  // 19: usersService.UserService.Create:output_type -> usersService.CreateRes
+	6,  // 20: usersService.UserService.Update:output_type -> usersService.UpdateRes
+	8,  // 21: usersService.UserService.Get:output_type -> usersService.GetRes
+	10, // 22: usersService.UserService.GetGroupUsers:output_type -> usersService.GetGroupUsersRes
+	12, // 23: usersService.UserService.Find:output_type -> usersService.FindRes
+	14, // 24: usersService.UserService.Delete:output_type -> usersService.DeleteRes
+	16, // 25: usersService.UserService.MoveUser:output_type -> usersService.MoveUserRes
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -1588,7 +1799,7 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadImageReq); i {
+			switch v := v.(*MoveUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1600,7 +1811,7 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadImageRes); i {
+			switch v := v.(*MoveUserRes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1612,7 +1823,7 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownloadImageReq); i {
+			switch v := v.(*UploadImageReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1624,6 +1835,30 @@ func file_user_proto_init() {
 			}
 		}
 		file_user_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadImageRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadImageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_user_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DownloadImageRes); i {
 			case 0:
 				return &v.state
@@ -1641,13 +1876,14 @@ func file_user_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_user_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      1,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_user_proto_goTypes,
 		DependencyIndexes: file_user_proto_depIdxs,
+		EnumInfos:         file_user_proto_enumTypes,
 		MessageInfos:      file_user_proto_msgTypes,
 	}.Build()
 	File_user_proto = out.File
@@ -28,6 +28,7 @@ type UserServiceClient interface {
 	GetGroupUsers(ctx context.Context, in *GetGroupUsersReq, opts ...grpc.CallOption) (*GetGroupUsersRes, error)
 	Find(ctx context.Context, in *FindReq, opts ...grpc.CallOption) (*FindRes, error)
 	Delete(ctx context.Context, in *DeleteReq, opts ...grpc.CallOption) (*DeleteRes, error)
+	MoveUser(ctx context.Context, in *MoveUserReq, opts ...grpc.CallOption) (*MoveUserRes, error)
 }
 
 type userServiceClient struct {
@@ -92,6 +93,15 @@ func (c *userServiceClient) Delete(ctx context.Context, in *DeleteReq, opts ...g
 	return out, nil
 }
 
+func (c *userServiceClient) MoveUser(ctx context.Context, in *MoveUserReq, opts ...grpc.CallOption) (*MoveUserRes, error) {
+	out := new(MoveUserRes)
+	err := c.cc.Invoke(ctx, "/usersService.UserService/MoveUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations should embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -102,6 +112,7 @@ type UserServiceServer interface {
 	GetGroupUsers(context.Context, *GetGroupUsersReq) (*GetGroupUsersRes, error)
 	Find(context.Context, *FindReq) (*FindRes, error)
 	Delete(context.Context, *DeleteReq) (*DeleteRes, error)
+	MoveUser(context.Context, *MoveUserReq) (*MoveUserRes, error)
 }
 
 // UnimplementedUserServiceServer should be embedded to have forward compatible implementations.
@@ -126,6 +137,9 @@ func (UnimplementedUserServiceServer) Find(context.Context, *FindReq) (*FindRes,
 func (UnimplementedUserServiceServer) Delete(context.Context, *DeleteReq) (*DeleteRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedUserServiceServer) MoveUser(context.Context, *MoveUserReq) (*MoveUserRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveUser not implemented")
+}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to UserServiceServer will
@@ -246,6 +260,24 @@ func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_MoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).MoveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/usersService.UserService/MoveUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MoveUser(ctx, req.(*MoveUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +309,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UserService_Delete_Handler,
 		},
+		{
+			MethodName: "MoveUser",
+			Handler:    _UserService_MoveUser_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
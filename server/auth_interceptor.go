@@ -2,12 +2,14 @@ package server
 
 import (
 	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	"github.com/JECSand/go-grpc-server-boilerplate/services"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"log"
 )
 
 // AuthInterceptor enforces JWT based authentication on gRPC services
@@ -30,12 +32,12 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		log.Println("--> unary interceptor: ", info.FullMethod)
-		err := i.authorize(ctx, info.FullMethod)
+		i.log.WithContext(ctx).Debugf("--> unary interceptor: %s", info.FullMethod)
+		ctx, err := i.authorize(ctx, info.FullMethod)
 		if err != nil {
 			return nil, err
 		}
-		return handler(ctx, req)
+		return handler(i.withUserLogger(ctx), req)
 	}
 }
 
@@ -47,38 +49,55 @@ func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 		info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler,
 	) error {
-		log.Println("--> stream interceptor: ", info.FullMethod)
-		err := i.authorize(stream.Context(), info.FullMethod)
+		i.log.WithContext(stream.Context()).Debugf("--> stream interceptor: %s", info.FullMethod)
+		ctx, err := i.authorize(stream.Context(), info.FullMethod)
 		if err != nil {
 			return err
 		}
-		return handler(srv, stream)
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = i.withUserLogger(ctx)
+		return handler(srv, wrapped)
 	}
 }
 
-func (i *AuthInterceptor) authorize(ctx context.Context, method string) error {
+// withUserLogger adds the authenticated user's identifiers to the request-scoped logger
+func (i *AuthInterceptor) withUserLogger(ctx context.Context) context.Context {
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		return ctx
+	}
+	reqLog := i.log.WithContext(ctx).With("user_id", tokenData.UserId, "group_id", tokenData.GroupId)
+	return utilities.ContextWithLogger(ctx, reqLog)
+}
+
+// authorize verifies the access token of a protected method, returning a copy of ctx that carries the requester's role
+// by group id for the membership-aware scope checks of the services
+func (i *AuthInterceptor) authorize(ctx context.Context, method string) (_ context.Context, err error) {
+	spanCtx, span := utilities.StartSpan(ctx, "AuthInterceptor.authorize", attribute.String("rpc.full_method", method))
+	defer func() { utilities.EndSpan(span, err) }()
 	roleMap, ok := i.accessibleRoles[method]
 	if !ok {
-		return nil // unprotected endpoint
+		return ctx, nil // unprotected endpoint
 	}
-	accessToken, err := utilities.GetTokenFromContext(ctx)
+	accessToken, err := utilities.GetTokenFromContext(spanCtx)
 	if err != nil {
-		return err
+		return ctx, err
 	}
+	var roles map[string]string
 	var authorized bool
 	switch roleMap[0] {
 	case "Root":
-		authorized, err = i.tokenService.RootAdminTokenVerifyMiddleWare(accessToken)
+		roles, authorized, err = i.tokenService.RootAdminTokenVerifyMiddleWare(spanCtx, accessToken)
 	case "Admin":
-		authorized, err = i.tokenService.AdminTokenVerifyMiddleWare(accessToken)
+		roles, authorized, err = i.tokenService.AdminTokenVerifyMiddleWare(spanCtx, accessToken)
 	case "Member":
-		authorized, err = i.tokenService.MemberTokenVerifyMiddleWare(accessToken)
+		roles, authorized, err = i.tokenService.MemberTokenVerifyMiddleWare(spanCtx, accessToken)
 	}
 	if err != nil {
-		return status.Errorf(codes.Unauthenticated, "access token is invalid: %v", err)
+		return ctx, utilities.ErrorResponse(err, "access token is invalid")
 	}
 	if authorized {
-		return nil
+		return models.ContextWithMemberships(ctx, roles), nil
 	}
-	return status.Error(codes.PermissionDenied, "no permission to access this RPC")
+	return ctx, status.Error(codes.PermissionDenied, "no permission to access this RPC")
 }
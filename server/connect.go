@@ -0,0 +1,240 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// connectEndStreamFlag marks the Connect frame that ends a streaming response
+const connectEndStreamFlag = 0x02
+
+// connectCompressedFlag marks a compressed Connect or gRPC message frame
+const connectCompressedFlag = 0x01
+
+// connectCodes are the Connect names and HTTP statuses of the gRPC status codes
+var connectCodes = map[codes.Code]struct {
+	name       string
+	httpStatus int
+}{
+	codes.Canceled:           {"canceled", 499},
+	codes.Unknown:            {"unknown", http.StatusInternalServerError},
+	codes.InvalidArgument:    {"invalid_argument", http.StatusBadRequest},
+	codes.DeadlineExceeded:   {"deadline_exceeded", http.StatusGatewayTimeout},
+	codes.NotFound:           {"not_found", http.StatusNotFound},
+	codes.AlreadyExists:      {"already_exists", http.StatusConflict},
+	codes.PermissionDenied:   {"permission_denied", http.StatusForbidden},
+	codes.ResourceExhausted:  {"resource_exhausted", http.StatusTooManyRequests},
+	codes.FailedPrecondition: {"failed_precondition", http.StatusBadRequest},
+	codes.Aborted:            {"aborted", http.StatusConflict},
+	codes.OutOfRange:         {"out_of_range", http.StatusBadRequest},
+	codes.Unimplemented:      {"unimplemented", http.StatusNotImplemented},
+	codes.Internal:           {"internal", http.StatusInternalServerError},
+	codes.Unavailable:        {"unavailable", http.StatusServiceUnavailable},
+	codes.DataLoss:           {"data_loss", http.StatusInternalServerError},
+	codes.Unauthenticated:    {"unauthenticated", http.StatusUnauthorized},
+}
+
+// connectErrorDetail is an error detail of a Connect error, a base64 encoded protobuf message and its type
+type connectErrorDetail struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// connectError is the JSON body of a failed Connect unary response, and the error of a Connect end of stream frame
+type connectError struct {
+	Code    string               `json:"code"`
+	Message string               `json:"message,omitempty"`
+	Details []connectErrorDetail `json:"details,omitempty"`
+}
+
+// connectEndStream is the JSON payload of the frame that ends a Connect streaming response
+type connectEndStream struct {
+	Error    *connectError       `json:"error,omitempty"`
+	Metadata map[string][]string `json:"metadata,omitempty"`
+}
+
+// newConnectError returns the Connect error of a gRPC status
+func newConnectError(st *status.Status) *connectError {
+	cErr := &connectError{Code: connectCodes[codes.Unknown].name, Message: st.Message()}
+	if c, ok := connectCodes[st.Code()]; ok {
+		cErr.Code = c.name
+	}
+	for _, detail := range st.Proto().GetDetails() {
+		cErr.Details = append(cErr.Details, connectErrorDetail{
+			Type:  detail.GetTypeUrl()[strings.LastIndex(detail.GetTypeUrl(), "/")+1:],
+			Value: base64.RawStdEncoding.EncodeToString(detail.GetValue()),
+		})
+	}
+	return cErr
+}
+
+// serveConnectUnary serves a Connect unary request, whose body is the bare request message in the proto or json codec
+func (h *WebHandler) serveConnectUnary(w http.ResponseWriter, r *http.Request, method protoreflect.MethodDescriptor, codec string) {
+	if method.IsStreamingClient() || method.IsStreamingServer() {
+		writeConnectError(w, status.Newf(codes.Unimplemented, "%s is a streaming method, use application/connect+%s", method.FullName(), codec))
+		return
+	}
+	if enc := r.Header.Get("Content-Encoding"); enc != "" && enc != "identity" {
+		writeConnectError(w, status.Newf(codes.Unimplemented, "unsupported content encoding %s", enc))
+		return
+	}
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, gatewayMaxBodyBytes))
+	if err != nil {
+		writeConnectError(w, status.Newf(codes.InvalidArgument, "invalid request body: %v", err))
+		return
+	}
+	msg, err := toProtoBytes(method.Input(), codec, data)
+	if err != nil {
+		writeConnectError(w, status.Newf(codes.InvalidArgument, "invalid request body: %v", err))
+		return
+	}
+	var res []byte
+	rec := newGRPCRecorder(func(http.Header) {}, func(flags byte, m []byte) error {
+		res = append([]byte(nil), m...)
+		return nil
+	})
+	h.grpcServer.ServeHTTP(rec, connectRequest(r, strings.NewReader(string(envelope(0, msg)))))
+	rec.sendHeader()
+	copyGRPCHeaders(w.Header(), rec.sent)
+	st, trailer := rec.result()
+	for k, vv := range trailer {
+		w.Header()["Trailer-"+k] = vv
+	}
+	if st.Code() != codes.OK {
+		writeConnectError(w, st)
+		return
+	}
+	out, err := fromProtoBytes(method.Output(), codec, res)
+	if err != nil {
+		h.log.WithContext(r.Context()).Errorf("WebHandler.serveConnectUnary: %v", err)
+		writeConnectError(w, status.New(codes.Internal, "failed to encode the response"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/"+codec)
+	_, _ = w.Write(out)
+}
+
+// serveConnectStream serves a Connect streaming request, whose request and response messages are framed like gRPC and
+// whose response ends with a frame holding the error and trailers
+func (h *WebHandler) serveConnectStream(w http.ResponseWriter, r *http.Request, method protoreflect.MethodDescriptor, codec string) {
+	contentType := "application/connect+" + codec
+	endStream := func(end *connectEndStream) {
+		payload, _ := json.Marshal(end)
+		_, _ = w.Write(envelope(connectEndStreamFlag, payload))
+	}
+	fail := func(st *status.Status) {
+		w.Header().Set("Content-Type", contentType)
+		endStream(&connectEndStream{Error: newConnectError(st)})
+	}
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, gatewayMaxBodyBytes))
+	if err != nil {
+		fail(status.Newf(codes.InvalidArgument, "invalid request body: %v", err))
+		return
+	}
+	flags, msgs, err := readEnvelopes(data)
+	if err != nil {
+		fail(status.Newf(codes.InvalidArgument, "invalid request body: %v", err))
+		return
+	}
+	var body []byte
+	for i, m := range msgs {
+		if flags[i]&connectCompressedFlag != 0 {
+			fail(status.New(codes.Unimplemented, "compressed messages are not supported"))
+			return
+		}
+		if m, err = toProtoBytes(method.Input(), codec, m); err != nil {
+			fail(status.Newf(codes.InvalidArgument, "invalid request message: %v", err))
+			return
+		}
+		body = append(body, envelope(0, m)...)
+	}
+	flusher, _ := w.(http.Flusher)
+	rec := newGRPCRecorder(
+		func(header http.Header) {
+			copyGRPCHeaders(w.Header(), header)
+			w.Header().Set("Content-Type", contentType)
+			w.WriteHeader(http.StatusOK)
+		},
+		func(flags byte, m []byte) error {
+			out, err := fromProtoBytes(method.Output(), codec, m)
+			if err != nil {
+				return err
+			}
+			_, _ = w.Write(envelope(0, out))
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return nil
+		},
+	)
+	h.grpcServer.ServeHTTP(rec, connectRequest(r, strings.NewReader(string(body))))
+	rec.sendHeader()
+	st, trailer := rec.result()
+	end := &connectEndStream{Metadata: trailer}
+	if st.Code() != codes.OK {
+		end.Error = newConnectError(st)
+	}
+	endStream(end)
+	if flusher != nil {
+		flusher.Flush()
+	}
+}
+
+// connectRequest returns the gRPC request of a Connect request, passing its timeout on as the gRPC timeout
+func connectRequest(r *http.Request, body io.Reader) *http.Request {
+	req := grpcRequest(r, "application/grpc+proto", body)
+	if ms, err := strconv.ParseInt(r.Header.Get("Connect-Timeout-Ms"), 10, 64); err == nil && ms > 0 {
+		req.Header.Set("Grpc-Timeout", strconv.FormatInt(ms, 10)+"m")
+	}
+	req.Header.Del("Connect-Timeout-Ms")
+	req.Header.Del("Connect-Protocol-Version")
+	return req
+}
+
+// writeConnectError writes a failed Connect unary response
+func writeConnectError(w http.ResponseWriter, st *status.Status) {
+	httpStatus := http.StatusInternalServerError
+	if c, ok := connectCodes[st.Code()]; ok {
+		httpStatus = c.httpStatus
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(newConnectError(st))
+}
+
+// toProtoBytes returns the protobuf encoding of a message of type md sent in the proto or json codec
+func toProtoBytes(md protoreflect.MessageDescriptor, codec string, data []byte) ([]byte, error) {
+	if codec == "proto" {
+		return data, nil
+	}
+	msg := dynamicpb.NewMessage(md)
+	if len(data) > 0 {
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, msg); err != nil {
+			return nil, err
+		}
+	}
+	return proto.Marshal(msg)
+}
+
+// fromProtoBytes returns a protobuf encoded message of type md in the proto or json codec
+func fromProtoBytes(md protoreflect.MessageDescriptor, codec string, data []byte) ([]byte, error) {
+	if codec == "proto" {
+		return data, nil
+	}
+	msg := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", md.FullName(), err)
+	}
+	return protojson.Marshal(msg)
+}
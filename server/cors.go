@@ -0,0 +1,99 @@
+package server
+
+import (
+	"github.com/JECSand/go-grpc-server-boilerplate/config"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// corsAllowedHeaders are the request headers the REST, gRPC-Web, and Connect clients send
+var corsAllowedHeaders = []string{
+	"Authorization",
+	"Content-Type",
+	"Connect-Protocol-Version",
+	"Connect-Timeout-Ms",
+	"Grpc-Timeout",
+	"X-Grpc-Web",
+	"X-User-Agent",
+	utilities.RequestIDHeader,
+}
+
+// corsExposedHeaders are the response headers the REST, gRPC-Web, and Connect clients read
+var corsExposedHeaders = []string{
+	"Grpc-Status",
+	"Grpc-Message",
+	"Grpc-Status-Details-Bin",
+	utilities.RequestIDHeader,
+	utilities.RetryAfterHeader,
+}
+
+// CORSHandler is an http.Handler that applies the CORS settings of the configuration to the requests of the gateway
+// port and answers their preflight requests
+type CORSHandler struct {
+	cfg  *config.Store
+	next http.Handler
+}
+
+// NewCORSHandler constructs a CORSHandler in front of next
+func NewCORSHandler(cfg *config.Store, next http.Handler) *CORSHandler {
+	return &CORSHandler{cfg: cfg, next: next}
+}
+
+// ServeHTTP adds the CORS headers of an allowed origin to the response, then answers a preflight request or passes the
+// request on
+func (c *CORSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		c.next.ServeHTTP(w, r)
+		return
+	}
+	conf := c.cfg.Get().Gateway.CORS
+	w.Header().Add("Vary", "Origin")
+	preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+	if !corsOriginAllowed(conf, origin) {
+		if preflight {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		c.next.ServeHTTP(w, r)
+		return
+	}
+	if oneOfFold("*", conf.AllowedOrigins) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+	} else {
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+	}
+	if conf.AllowCredentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+	if !preflight {
+		w.Header().Set("Access-Control-Expose-Headers", strings.Join(corsExposedHeaders, ", "))
+		c.next.ServeHTTP(w, r)
+		return
+	}
+	w.Header().Add("Vary", "Access-Control-Request-Method")
+	w.Header().Add("Vary", "Access-Control-Request-Headers")
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", strings.Join(append(append([]string(nil), corsAllowedHeaders...), conf.AllowedHeaders...), ", "))
+	if conf.MaxAge > 0 {
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(conf.MaxAge))
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// corsOriginAllowed returns whether the CORS settings allow requests from origin
+func corsOriginAllowed(conf config.CORSConfig, origin string) bool {
+	return oneOfFold("*", conf.AllowedOrigins) || oneOfFold(origin, conf.AllowedOrigins)
+}
+
+// oneOfFold returns whether value is one of the allowed values, ignoring case
+func oneOfFold(value string, allowed []string) bool {
+	for _, a := range allowed {
+		if strings.EqualFold(value, a) {
+			return true
+		}
+	}
+	return false
+}
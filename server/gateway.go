@@ -0,0 +1,447 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	authsService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
+	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	usersService "github.com/JECSand/go-grpc-server-boilerplate/protos/user"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// gatewayMaxBodyBytes is the largest JSON request body the gateway accepts
+const gatewayMaxBodyBytes = 1 << 20
+
+// OpenAPIPath is the gateway path that serves the OpenAPI document of its routes
+const OpenAPIPath = "/openapi.json"
+
+// gatewayRoute maps an HTTP method and path to a unary gRPC method. Path segments such as {Id} are bound to the request
+// field of that name; the rest of the request is decoded from the JSON body, or from the query when body is false.
+type gatewayRoute struct {
+	method   string
+	pattern  string
+	rpc      string
+	summary  string
+	body     bool
+	req      proto.Message
+	res      proto.Message
+	segments []string
+}
+
+// gatewayRoutes declares the REST routes of the auth, user, group, and task services
+func gatewayRoutes() []*gatewayRoute {
+	const authServicePath = "/authService.AuthService/"
+	const userServicePath = "/usersService.UserService/"
+	const groupServicePath = "/groupsService.GroupService/"
+	const taskServicePath = "/tasksService.TaskService/"
+	return []*gatewayRoute{
+		{method: http.MethodPost, pattern: "/v1/auth/register", rpc: authServicePath + "Register", summary: "Register a new user", body: true,
+			req: &authsService.RegisterReq{}, res: &authsService.RegisterRes{}},
+		{method: http.MethodPost, pattern: "/v1/auth/login", rpc: authServicePath + "Login", summary: "Log in and receive an access token", body: true,
+			req: &authsService.LoginReq{}, res: &authsService.LoginRes{}},
+		{method: http.MethodPost, pattern: "/v1/auth/logout", rpc: authServicePath + "Logout", summary: "Revoke the access token",
+			req: &authsService.Empty{}, res: &authsService.LogoutRes{}},
+		{method: http.MethodPost, pattern: "/v1/auth/refresh", rpc: authServicePath + "Refresh", summary: "Exchange the access token for a new one",
+			req: &authsService.Empty{}, res: &authsService.RefreshRes{}},
+		{method: http.MethodPost, pattern: "/v1/auth/keys", rpc: authServicePath + "GenerateKey", summary: "Generate an API key",
+			req: &authsService.Empty{}, res: &authsService.GenerateKeyRes{}},
+		{method: http.MethodPut, pattern: "/v1/auth/password", rpc: authServicePath + "UpdatePassword", summary: "Change the password of the user", body: true,
+			req: &authsService.UpdatePasswordReq{}, res: &authsService.UpdatePasswordRes{}},
+		{method: http.MethodPost, pattern: "/v1/auth/group", rpc: authServicePath + "SwitchGroup", summary: "Switch the active group of the session", body: true,
+			req: &authsService.SwitchGroupReq{}, res: &authsService.SwitchGroupRes{}},
+		{method: http.MethodPost, pattern: "/v1/auth/invites", rpc: authServicePath + "AcceptInvite", summary: "Join a group with an invite code", body: true,
+			req: &authsService.AcceptInviteReq{}, res: &authsService.AcceptInviteRes{}},
+		{method: http.MethodPost, pattern: "/v1/users", rpc: userServicePath + "Create", summary: "Create a user", body: true,
+			req: &usersService.CreateReq{}, res: &usersService.CreateRes{}},
+		{method: http.MethodGet, pattern: "/v1/users", rpc: userServicePath + "Find", summary: "Find users",
+			req: &usersService.FindReq{}, res: &usersService.FindRes{}},
+		{method: http.MethodGet, pattern: "/v1/users/{Id}", rpc: userServicePath + "Get", summary: "Get a user",
+			req: &usersService.GetReq{}, res: &usersService.GetRes{}},
+		{method: http.MethodPatch, pattern: "/v1/users/{Id}", rpc: userServicePath + "Update", summary: "Update a user", body: true,
+			req: &usersService.UpdateReq{}, res: &usersService.UpdateRes{}},
+		{method: http.MethodDelete, pattern: "/v1/users/{Id}", rpc: userServicePath + "Delete", summary: "Delete a user",
+			req: &usersService.DeleteReq{}, res: &usersService.DeleteRes{}},
+		{method: http.MethodPost, pattern: "/v1/users/{Id}/move", rpc: userServicePath + "MoveUser", summary: "Move a user to another group", body: true,
+			req: &usersService.MoveUserReq{}, res: &usersService.MoveUserRes{}},
+		{method: http.MethodGet, pattern: "/v1/groups/{GroupId}/users", rpc: userServicePath + "GetGroupUsers", summary: "List the users of a group",
+			req: &usersService.GetGroupUsersReq{}, res: &usersService.GetGroupUsersRes{}},
+		{method: http.MethodPost, pattern: "/v1/groups", rpc: groupServicePath + "Create", summary: "Create a group", body: true,
+			req: &groupsService.CreateReq{}, res: &groupsService.CreateRes{}},
+		{method: http.MethodGet, pattern: "/v1/groups", rpc: groupServicePath + "Find", summary: "Find groups",
+			req: &groupsService.FindReq{}, res: &groupsService.FindRes{}},
+		{method: http.MethodGet, pattern: "/v1/groups/{Id}", rpc: groupServicePath + "Get", summary: "Get a group",
+			req: &groupsService.GetReq{}, res: &groupsService.GetRes{}},
+		{method: http.MethodPatch, pattern: "/v1/groups/{Id}", rpc: groupServicePath + "Update", summary: "Update a group", body: true,
+			req: &groupsService.UpdateReq{}, res: &groupsService.UpdateRes{}},
+		{method: http.MethodDelete, pattern: "/v1/groups/{Id}", rpc: groupServicePath + "Delete", summary: "Delete a group",
+			req: &groupsService.DeleteReq{}, res: &groupsService.DeleteRes{}},
+		{method: http.MethodGet, pattern: "/v1/groups/{Id}/settings", rpc: groupServicePath + "GetSettings", summary: "Get the settings of a group",
+			req: &groupsService.GetSettingsReq{}, res: &groupsService.GetSettingsRes{}},
+		{method: http.MethodPut, pattern: "/v1/groups/{Id}/settings", rpc: groupServicePath + "UpdateSettings", summary: "Replace the settings of a group", body: true,
+			req: &groupsService.UpdateSettingsReq{}, res: &groupsService.UpdateSettingsRes{}},
+		{method: http.MethodPost, pattern: "/v1/groups/{Id}/move", rpc: groupServicePath + "Move", summary: "Move a group under another parent group", body: true,
+			req: &groupsService.MoveReq{}, res: &groupsService.MoveRes{}},
+		{method: http.MethodGet, pattern: "/v1/groups/{Id}/descendants", rpc: groupServicePath + "ListDescendants", summary: "List the groups nested within a group",
+			req: &groupsService.ListDescendantsReq{}, res: &groupsService.ListDescendantsRes{}},
+		{method: http.MethodGet, pattern: "/v1/groups/{GroupId}/members", rpc: groupServicePath + "ListMembers", summary: "List the members of a group",
+			req: &groupsService.ListMembersReq{}, res: &groupsService.ListMembersRes{}},
+		{method: http.MethodPost, pattern: "/v1/groups/{GroupId}/members", rpc: groupServicePath + "AddMember", summary: "Add a user to a group", body: true,
+			req: &groupsService.AddMemberReq{}, res: &groupsService.AddMemberRes{}},
+		{method: http.MethodDelete, pattern: "/v1/groups/{GroupId}/members/{UserId}", rpc: groupServicePath + "RemoveMember", summary: "Remove a user from a group",
+			req: &groupsService.RemoveMemberReq{}, res: &groupsService.RemoveMemberRes{}},
+		{method: http.MethodPost, pattern: "/v1/groups/{GroupId}/admin", rpc: groupServicePath + "TransferAdmin", summary: "Transfer the admin role of a group", body: true,
+			req: &groupsService.TransferAdminReq{}, res: &groupsService.TransferAdminRes{}},
+		{method: http.MethodGet, pattern: "/v1/groups/{GroupId}/invites", rpc: groupServicePath + "ListInvites", summary: "List the invites of a group",
+			req: &groupsService.ListInvitesReq{}, res: &groupsService.ListInvitesRes{}},
+		{method: http.MethodPost, pattern: "/v1/groups/{GroupId}/invites", rpc: groupServicePath + "CreateInvite", summary: "Invite users to a group", body: true,
+			req: &groupsService.CreateInviteReq{}, res: &groupsService.CreateInviteRes{}},
+		{method: http.MethodDelete, pattern: "/v1/groups/{GroupId}/invites/{Id}", rpc: groupServicePath + "RevokeInvite", summary: "Revoke an invite",
+			req: &groupsService.RevokeInviteReq{}, res: &groupsService.RevokeInviteRes{}},
+		{method: http.MethodPost, pattern: "/v1/tasks", rpc: taskServicePath + "Create", summary: "Create a task", body: true,
+			req: &tasksService.CreateReq{}, res: &tasksService.CreateRes{}},
+		{method: http.MethodGet, pattern: "/v1/tasks", rpc: taskServicePath + "Find", summary: "Find tasks",
+			req: &tasksService.FindReq{}, res: &tasksService.FindRes{}},
+		{method: http.MethodGet, pattern: "/v1/tasks/{Id}", rpc: taskServicePath + "Get", summary: "Get a task",
+			req: &tasksService.GetReq{}, res: &tasksService.GetRes{}},
+		{method: http.MethodPatch, pattern: "/v1/tasks/{Id}", rpc: taskServicePath + "Update", summary: "Update a task", body: true,
+			req: &tasksService.UpdateReq{}, res: &tasksService.UpdateRes{}},
+		{method: http.MethodDelete, pattern: "/v1/tasks/{Id}", rpc: taskServicePath + "Delete", summary: "Delete a task",
+			req: &tasksService.DeleteReq{}, res: &tasksService.DeleteRes{}},
+		{method: http.MethodGet, pattern: "/v1/users/{UserId}/tasks", rpc: taskServicePath + "GetUserTasks", summary: "List the tasks of a user",
+			req: &tasksService.GetUserTasksReq{}, res: &tasksService.GetUserTasksRes{}},
+		{method: http.MethodGet, pattern: "/v1/groups/{GroupId}/tasks", rpc: taskServicePath + "GetGroupTasks", summary: "List the tasks of a group",
+			req: &tasksService.GetGroupTasksReq{}, res: &tasksService.GetGroupTasksRes{}},
+		{method: http.MethodPost, pattern: "/v1/tasks/{TaskId}/comments", rpc: taskServicePath + "AddComment", summary: "Comment on a task", body: true,
+			req: &tasksService.AddCommentReq{}, res: &tasksService.AddCommentRes{}},
+		{method: http.MethodGet, pattern: "/v1/tasks/{TaskId}/comments", rpc: taskServicePath + "ListComments", summary: "List the comments of a task",
+			req: &tasksService.ListCommentsReq{}, res: &tasksService.ListCommentsRes{}},
+		{method: http.MethodPatch, pattern: "/v1/comments/{Id}", rpc: taskServicePath + "UpdateComment", summary: "Edit a comment", body: true,
+			req: &tasksService.UpdateCommentReq{}, res: &tasksService.UpdateCommentRes{}},
+		{method: http.MethodDelete, pattern: "/v1/comments/{Id}", rpc: taskServicePath + "DeleteComment", summary: "Delete a comment",
+			req: &tasksService.DeleteCommentReq{}, res: &tasksService.DeleteCommentRes{}},
+		{method: http.MethodGet, pattern: "/v1/tasks/{TaskId}/activity", rpc: taskServicePath + "ListActivity", summary: "List the activity of a task",
+			req: &tasksService.ListActivityReq{}, res: &tasksService.ListActivityRes{}},
+		{method: http.MethodGet, pattern: "/v1/tasks/{TaskId}/attachments", rpc: taskServicePath + "ListAttachments", summary: "List the attachments of a task",
+			req: &tasksService.ListAttachmentsReq{}, res: &tasksService.ListAttachmentsRes{}},
+		{method: http.MethodDelete, pattern: "/v1/tasks/{TaskId}/attachments/{Id}", rpc: taskServicePath + "DeleteAttachment", summary: "Delete an attachment",
+			req: &tasksService.DeleteAttachmentReq{}, res: &tasksService.DeleteAttachmentRes{}},
+	}
+}
+
+// match returns the path parameters of path when it matches the pattern of the route
+func (rt *gatewayRoute) match(path string) (map[string]string, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) != len(rt.segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, seg := range rt.segments {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			v, err := url.PathUnescape(parts[i])
+			if err != nil || v == "" {
+				return nil, false
+			}
+			params[seg[1:len(seg)-1]] = v
+		} else if seg != parts[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+// Gateway is an http.Handler that transcodes REST/JSON requests to the unary gRPC methods of the auth, user, group, and
+// task services, and serves their OpenAPI document
+type Gateway struct {
+	log     utilities.Logger
+	conn    grpc.ClientConnInterface
+	routes  []*gatewayRoute
+	openAPI []byte
+}
+
+// NewGateway constructs a Gateway that calls the gRPC services over conn
+func NewGateway(log utilities.Logger, conn grpc.ClientConnInterface) *Gateway {
+	routes := gatewayRoutes()
+	for _, rt := range routes {
+		rt.segments = strings.Split(strings.Trim(rt.pattern, "/"), "/")
+		for _, seg := range rt.segments {
+			if strings.HasPrefix(seg, "{") && findField(rt.req.ProtoReflect().Descriptor(), strings.Trim(seg, "{}")) == nil {
+				log.Fatalf("NewGateway: %s has no field %s", rt.req.ProtoReflect().Descriptor().FullName(), seg)
+			}
+		}
+	}
+	doc, err := openAPIDocument(routes, accessibleRoles())
+	if err != nil {
+		log.Fatalf("NewGateway: %v", err)
+	}
+	return &Gateway{log: log, conn: conn, routes: routes, openAPI: doc}
+}
+
+// ServeHTTP routes a request to its gRPC method
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == OpenAPIPath && r.Method == http.MethodGet {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(g.openAPI)
+		return
+	}
+	var allowed []string
+	for _, rt := range g.routes {
+		params, ok := rt.match(r.URL.EscapedPath())
+		if !ok {
+			continue
+		}
+		if rt.method == r.Method {
+			g.serveRoute(w, r, rt, params)
+			return
+		}
+		allowed = append(allowed, rt.method)
+	}
+	if len(allowed) > 0 {
+		w.Header().Set("Allow", strings.Join(allowed, ", "))
+		g.writeError(w, status.Errorf(codes.Unimplemented, "method %s is not allowed on %s", r.Method, r.URL.Path), http.StatusMethodNotAllowed)
+		return
+	}
+	g.writeError(w, status.Errorf(codes.NotFound, "no route for %s %s", r.Method, r.URL.Path), 0)
+}
+
+// serveRoute decodes the request message of a route, invokes its gRPC method, and writes the response message
+func (g *Gateway) serveRoute(w http.ResponseWriter, r *http.Request, rt *gatewayRoute, params map[string]string) {
+	req := rt.req.ProtoReflect().New().Interface()
+	r.Body = http.MaxBytesReader(w, r.Body, gatewayMaxBodyBytes)
+	if err := bindRequest(r, rt, req, params); err != nil {
+		g.writeError(w, err, 0)
+		return
+	}
+	md := metadata.MD{}
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		md.Set("authorization", bearerToken(auth))
+	}
+	if requestID := r.Header.Get(utilities.RequestIDHeader); requestID != "" {
+		md.Set(utilities.RequestIDHeader, requestID)
+	}
+	md.Set(utilities.ForwardedForHeader, clientIP(r))
+	var header, trailer metadata.MD
+	res := rt.res.ProtoReflect().New().Interface()
+	err := g.conn.Invoke(metadata.NewOutgoingContext(r.Context(), md), rt.rpc, req, res, grpc.Header(&header), grpc.Trailer(&trailer))
+	for _, key := range []string{utilities.RequestIDHeader, utilities.RetryAfterHeader} {
+		values := header.Get(key)
+		if len(values) == 0 {
+			values = trailer.Get(key)
+		}
+		if len(values) > 0 {
+			w.Header().Set(key, values[0])
+		}
+	}
+	if err != nil {
+		g.writeError(w, err, 0)
+		return
+	}
+	body, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(res)
+	if err != nil {
+		g.log.WithContext(r.Context()).Errorf("Gateway.serveRoute: %v", err)
+		g.writeError(w, status.Error(codes.Internal, "failed to encode the response"), 0)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// writeError writes err as a utilities.JsonErr with the HTTP status of its gRPC code, unless httpStatus overrides it
+func (g *Gateway) writeError(w http.ResponseWriter, err error, httpStatus int) {
+	st := status.Convert(err)
+	if httpStatus == 0 {
+		httpStatus = utilities.MapGRPCErrCodeToHttpStatus(st.Code())
+	}
+	jErr := utilities.JsonErr{Code: httpStatus, Text: st.Message()}
+	for _, detail := range st.Proto().GetDetails() {
+		if raw, mErr := protojson.Marshal(detail); mErr == nil {
+			jErr.Details = append(jErr.Details, raw)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(jErr)
+}
+
+// bindRequest decodes the JSON body or the query of a request into req, then sets the path parameters over it
+func bindRequest(r *http.Request, rt *gatewayRoute, req proto.Message, params map[string]string) error {
+	if rt.body {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+		}
+		if len(data) > 0 {
+			if err = protojson.Unmarshal(data, req); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+			}
+		}
+	} else {
+		query := r.URL.Query()
+		keys := make([]string, 0, len(query))
+		for key := range query {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := setField(req.ProtoReflect(), key, query[key]); err != nil {
+				return err
+			}
+		}
+	}
+	for name, value := range params {
+		if err := setField(req.ProtoReflect(), name, []string{value}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findField returns the field of a message by its JSON or proto name
+func findField(md protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if fd := md.Fields().ByJSONName(name); fd != nil {
+		return fd
+	}
+	return md.Fields().ByName(protoreflect.Name(name))
+}
+
+// setField sets the field at a dotted path of msg, such as User.GroupId, from its string values
+func setField(msg protoreflect.Message, path string, values []string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := findField(msg.Descriptor(), name)
+		if fd == nil {
+			return utilities.InvalidField(path, "is not a field of "+string(msg.Descriptor().Name()))
+		}
+		if i < len(names)-1 {
+			if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || isTimestamp(fd) {
+				return utilities.InvalidField(path, "is not a field of "+string(msg.Descriptor().Name()))
+			}
+			msg = msg.Mutable(fd).Message()
+			continue
+		}
+		if fd.IsMap() || (fd.Kind() == protoreflect.MessageKind && !isTimestamp(fd)) {
+			return utilities.InvalidField(path, "cannot be set from the query")
+		}
+		if fd.IsList() {
+			list := msg.Mutable(fd).List()
+			for _, s := range values {
+				v, err := parseFieldValue(fd, s)
+				if err != nil {
+					return utilities.InvalidField(path, err.Error())
+				}
+				list.Append(v)
+			}
+			return nil
+		}
+		v, err := parseFieldValue(fd, values[len(values)-1])
+		if err != nil {
+			return utilities.InvalidField(path, err.Error())
+		}
+		msg.Set(fd, v)
+	}
+	return nil
+}
+
+// isTimestamp returns whether a field is a google.protobuf.Timestamp
+func isTimestamp(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() == "google.protobuf.Timestamp"
+}
+
+// parseFieldValue parses the string form of a scalar, enum, or timestamp field value
+func parseFieldValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	invalid := func(kind string) (protoreflect.Value, error) {
+		return protoreflect.Value{}, fmt.Errorf("must be %s", kind)
+	}
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return invalid("a boolean")
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return invalid("an integer")
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return invalid("an integer")
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return invalid("a non negative integer")
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return invalid("a non negative integer")
+		}
+		return protoreflect.ValueOfUint64(n), nil
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return invalid("a number")
+		}
+		if fd.Kind() == protoreflect.FloatKind {
+			return protoreflect.ValueOfFloat32(float32(f)), nil
+		}
+		return protoreflect.ValueOfFloat64(f), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return invalid("a " + string(fd.Enum().Name()) + " name or number")
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	case protoreflect.MessageKind:
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return invalid("an RFC 3339 timestamp")
+		}
+		return protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()), nil
+	}
+	return invalid("a supported query value")
+}
+
+// bearerToken returns the token of an Authorization header, with or without its Bearer scheme
+func bearerToken(auth string) string {
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "bearer ") {
+		auth = auth[7:]
+	}
+	return strings.TrimSpace(auth)
+}
+
+// clientIP returns the IP address of the client of an HTTP request
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
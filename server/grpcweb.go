@@ -0,0 +1,312 @@
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// grpcTrailerPrefix is the header key prefix grpc.Server.ServeHTTP sets its undeclared trailers with
+const grpcTrailerPrefix = "Trailer:"
+
+// grpcWebTrailerFlag marks the gRPC-Web frame that carries the trailers of a response
+const grpcWebTrailerFlag = 0x80
+
+// WebHandler is an http.Handler that serves the gRPC-Web and Connect protocols of every service registered on a
+// grpc.Server. Requests are translated to gRPC and served by grpc.Server.ServeHTTP, so they pass through the same
+// interceptors as native gRPC requests.
+type WebHandler struct {
+	log        utilities.Logger
+	grpcServer *grpc.Server
+	methods    map[string]protoreflect.MethodDescriptor
+}
+
+// NewWebHandler constructs a WebHandler for the services registered on grpcServer
+func NewWebHandler(log utilities.Logger, grpcServer *grpc.Server) *WebHandler {
+	h := &WebHandler{log: log, grpcServer: grpcServer, methods: make(map[string]protoreflect.MethodDescriptor)}
+	for name := range grpcServer.GetServiceInfo() {
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			log.Fatalf("NewWebHandler: %v", err)
+		}
+		sd, ok := d.(protoreflect.ServiceDescriptor)
+		if !ok {
+			log.Fatalf("NewWebHandler: %s is not a service", name)
+		}
+		for i := 0; i < sd.Methods().Len(); i++ {
+			md := sd.Methods().Get(i)
+			h.methods["/"+name+"/"+string(md.Name())] = md
+		}
+	}
+	return h
+}
+
+// Handles returns whether r calls a gRPC method the WebHandler serves
+func (h *WebHandler) Handles(r *http.Request) bool {
+	_, ok := h.methods[r.URL.Path]
+	return ok
+}
+
+// ServeHTTP serves a gRPC-Web or Connect request by its content type
+func (h *WebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method, ok := h.methods[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	contentType := r.Header.Get("Content-Type")
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	switch {
+	case r.Method != http.MethodPost:
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	case strings.HasPrefix(contentType, "application/grpc-web"):
+		h.serveGRPCWeb(w, r, contentType)
+	case contentType == "application/connect+proto" || contentType == "application/connect+json":
+		h.serveConnectStream(w, r, method, strings.TrimPrefix(contentType, "application/connect+"))
+	case contentType == "application/proto" || contentType == "application/json":
+		h.serveConnectUnary(w, r, method, strings.TrimPrefix(contentType, "application/"))
+	default:
+		http.Error(w, "unsupported content type "+contentType, http.StatusUnsupportedMediaType)
+	}
+}
+
+// serveGRPCWeb serves a gRPC-Web request. The messages of gRPC-Web are framed like gRPC, but its trailers are sent as a
+// final frame of the body, and the grpc-web-text variants base64 encode the body.
+func (h *WebHandler) serveGRPCWeb(w http.ResponseWriter, r *http.Request, contentType string) {
+	text := strings.HasPrefix(contentType, "application/grpc-web-text")
+	subtype := "proto"
+	if i := strings.IndexByte(contentType, '+'); i >= 0 {
+		subtype = contentType[i+1:]
+	}
+	var body io.Reader = r.Body
+	if text {
+		body = base64.NewDecoder(base64.StdEncoding, r.Body)
+	}
+	write := func(p []byte) {
+		if text {
+			p = []byte(base64.StdEncoding.EncodeToString(p))
+		}
+		_, _ = w.Write(p)
+	}
+	flusher, _ := w.(http.Flusher)
+	rec := newGRPCRecorder(
+		func(header http.Header) {
+			copyGRPCHeaders(w.Header(), header)
+			w.Header().Set("Content-Type", contentType)
+			w.WriteHeader(http.StatusOK)
+		},
+		func(flags byte, msg []byte) error {
+			write(envelope(flags, msg))
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return nil
+		},
+	)
+	h.grpcServer.ServeHTTP(rec, grpcRequest(r, "application/grpc+"+subtype, body))
+	rec.sendHeader()
+	st, trailer := rec.result()
+	var frame bytes.Buffer
+	frame.WriteString("grpc-status: " + strconv.Itoa(int(st.Code())) + "\r\n")
+	if st.Message() != "" {
+		frame.WriteString("grpc-message: " + encodeGRPCMessage(st.Message()) + "\r\n")
+	}
+	if p := st.Proto(); len(p.GetDetails()) > 0 {
+		if b, err := proto.Marshal(p); err == nil {
+			frame.WriteString("grpc-status-details-bin: " + base64.RawStdEncoding.EncodeToString(b) + "\r\n")
+		}
+	}
+	for k, vv := range trailer {
+		for _, v := range vv {
+			frame.WriteString(strings.ToLower(k) + ": " + v + "\r\n")
+		}
+	}
+	write(envelope(grpcWebTrailerFlag, frame.Bytes()))
+	if flusher != nil {
+		flusher.Flush()
+	}
+}
+
+// grpcRequest returns a copy of r that grpc.Server.ServeHTTP accepts as a gRPC request with the given body
+func grpcRequest(r *http.Request, contentType string, body io.Reader) *http.Request {
+	req := r.Clone(r.Context())
+	req.Proto, req.ProtoMajor, req.ProtoMinor = "HTTP/2.0", 2, 0
+	req.Body = io.NopCloser(body)
+	req.ContentLength = -1
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Del("Content-Length")
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		req.Header.Set("Authorization", bearerToken(auth))
+	}
+	return req
+}
+
+// copyGRPCHeaders copies the response metadata among the headers grpc.Server.ServeHTTP wrote to dst
+func copyGRPCHeaders(dst http.Header, src http.Header) {
+	for k, vv := range src {
+		switch strings.ToLower(k) {
+		case "content-type", "trailer", "grpc-status", "grpc-message", "grpc-status-details-bin", "grpc-encoding":
+			continue
+		}
+		if strings.HasPrefix(k, grpcTrailerPrefix) {
+			continue
+		}
+		dst[k] = append(dst[k], vv...)
+	}
+}
+
+// envelope returns a length prefixed message frame as gRPC, gRPC-Web, and Connect streams frame their messages
+func envelope(flags byte, msg []byte) []byte {
+	frame := make([]byte, 5+len(msg))
+	frame[0] = flags
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(msg)))
+	copy(frame[5:], msg)
+	return frame
+}
+
+// readEnvelopes splits a body of length prefixed message frames
+func readEnvelopes(data []byte) (flags []byte, msgs [][]byte, err error) {
+	for len(data) > 0 {
+		if len(data) < 5 {
+			return nil, nil, fmt.Errorf("truncated message frame")
+		}
+		n := int(binary.BigEndian.Uint32(data[1:5]))
+		if len(data) < 5+n {
+			return nil, nil, fmt.Errorf("truncated message frame")
+		}
+		flags = append(flags, data[0])
+		msgs = append(msgs, data[5:5+n])
+		data = data[5+n:]
+	}
+	return flags, msgs, nil
+}
+
+// encodeGRPCMessage percent encodes a status message as the grpc-message header carries it
+func encodeGRPCMessage(msg string) string {
+	var b strings.Builder
+	for i := 0; i < len(msg); i++ {
+		if c := msg[i]; c >= ' ' && c <= '~' && c != '%' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// grpcRecorder is the http.ResponseWriter the web protocols hand to grpc.Server.ServeHTTP. It holds the headers back
+// until the first write, when onHeader receives them, and passes every message frame of the body to onMessage. The
+// status and trailers are read with result once ServeHTTP returns.
+type grpcRecorder struct {
+	header     http.Header
+	sent       http.Header
+	code       int
+	buf        []byte
+	raw        bytes.Buffer
+	err        error
+	onHeader   func(header http.Header)
+	onMessage  func(flags byte, msg []byte) error
+	headerSent bool
+}
+
+// newGRPCRecorder constructs a grpcRecorder
+func newGRPCRecorder(onHeader func(header http.Header), onMessage func(flags byte, msg []byte) error) *grpcRecorder {
+	return &grpcRecorder{header: make(http.Header), code: http.StatusOK, onHeader: onHeader, onMessage: onMessage}
+}
+
+// Header returns the headers, and after the body the trailers, grpc.Server.ServeHTTP sets
+func (rec *grpcRecorder) Header() http.Header {
+	return rec.header
+}
+
+// WriteHeader records the status code of the response
+func (rec *grpcRecorder) WriteHeader(code int) {
+	if !rec.headerSent {
+		rec.code = code
+	}
+	rec.sendHeader()
+}
+
+// Write splits the body into message frames
+func (rec *grpcRecorder) Write(p []byte) (int, error) {
+	rec.sendHeader()
+	if rec.code != http.StatusOK {
+		return rec.raw.Write(p) // ServeHTTP rejected the request with a plain text error
+	}
+	rec.buf = append(rec.buf, p...)
+	for rec.err == nil && len(rec.buf) >= 5 {
+		n := int(binary.BigEndian.Uint32(rec.buf[1:5]))
+		if len(rec.buf) < 5+n {
+			break
+		}
+		rec.err = rec.onMessage(rec.buf[0], rec.buf[5:5+n])
+		rec.buf = rec.buf[5+n:]
+	}
+	return len(p), rec.err
+}
+
+// Flush sends the headers; messages are flushed as onMessage receives them
+func (rec *grpcRecorder) Flush() {
+	rec.sendHeader()
+}
+
+// sendHeader passes the headers to onHeader once
+func (rec *grpcRecorder) sendHeader() {
+	if rec.headerSent {
+		return
+	}
+	rec.headerSent = true
+	rec.sent = rec.header.Clone()
+	rec.onHeader(rec.sent)
+}
+
+// result returns the status and trailers of the response
+func (rec *grpcRecorder) result() (*status.Status, http.Header) {
+	if rec.code != http.StatusOK {
+		return status.New(codes.Internal, strings.TrimSpace(rec.raw.String())), http.Header{}
+	}
+	if rec.err != nil {
+		return status.New(codes.Internal, rec.err.Error()), http.Header{}
+	}
+	trailer := make(http.Header)
+	for k, vv := range rec.header {
+		if strings.HasPrefix(k, grpcTrailerPrefix) {
+			trailer[http.CanonicalHeaderKey(strings.TrimPrefix(k, grpcTrailerPrefix))] = vv
+		}
+	}
+	if details := rec.header.Get("Grpc-Status-Details-Bin"); details != "" {
+		if b, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(details, "=")); err == nil {
+			p := &spb.Status{}
+			if err = proto.Unmarshal(b, p); err == nil {
+				return status.FromProto(p), trailer
+			}
+		}
+	}
+	code, err := strconv.Atoi(rec.header.Get("Grpc-Status"))
+	if err != nil {
+		return status.New(codes.Unknown, "missing grpc-status"), trailer
+	}
+	msg, err := url.PathUnescape(rec.header.Get("Grpc-Message"))
+	if err != nil {
+		msg = rec.header.Get("Grpc-Message")
+	}
+	return status.New(codes.Code(code), msg), trailer
+}
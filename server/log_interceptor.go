@@ -4,12 +4,16 @@ import (
 	"context"
 	"github.com/JECSand/go-grpc-server-boilerplate/config"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"time"
 )
 
-// LoggerInterceptor struct
+// LoggerInterceptor attaches a request ID and a request-scoped logger to gRPC requests and logs their outcome
 type LoggerInterceptor struct {
 	log utilities.Logger
 	cfg *config.Configuration
@@ -20,16 +24,63 @@ func NewLoggerInterceptor(logger utilities.Logger, cfg *config.Configuration) *L
 	return &LoggerInterceptor{log: logger, cfg: cfg}
 }
 
-// Logger Interceptor
-func (i *LoggerInterceptor) Logger(
-	ctx context.Context,
-	req interface{},
-	info *grpc.UnaryServerInfo,
-	handler grpc.UnaryHandler,
-) (resp interface{}, err error) {
-	start := time.Now()
-	md, _ := metadata.FromIncomingContext(ctx)
-	reply, err := handler(ctx, req)
-	i.log.Infof("Method: %s, Time: %v, Metadata: %v, Err: %v", info.FullMethod, time.Since(start), md, err)
-	return reply, err
+// startRequest resolves the request ID and returns the request-scoped context, logger, and response header
+func (i *LoggerInterceptor) startRequest(ctx context.Context, method string) (context.Context, utilities.Logger, metadata.MD) {
+	requestID, ok := utilities.GetRequestIDFromMetadata(ctx)
+	if !ok {
+		requestID = utilities.NewRequestID()
+	}
+	fields := []interface{}{"request_id", requestID, "method", method}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		fields = append(fields, "trace_id", sc.TraceID().String())
+	}
+	reqLog := i.log.With(fields...)
+	ctx = utilities.ContextWithRequestID(ctx, requestID)
+	ctx = utilities.ContextWithLogger(ctx, reqLog)
+	return ctx, reqLog, metadata.Pairs(utilities.RequestIDHeader, requestID)
+}
+
+// Unary creates and returns a gRPC unary server interceptor
+func (i *LoggerInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		ctx, reqLog, header := i.startRequest(ctx, info.FullMethod)
+		if err := grpc.SetHeader(ctx, header); err != nil {
+			reqLog.Warnf("grpc.SetHeader: %v", err)
+		}
+		if msg, ok := req.(proto.Message); ok {
+			reqLog.Debugf("Request: %v", utilities.RedactProto(msg))
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		reply, err := handler(ctx, req)
+		reqLog.Infof("Method: %s, Time: %v, Metadata: %v, Code: %s, Err: %v", info.FullMethod, time.Since(start), utilities.RedactMetadata(md), status.Code(err), err)
+		return reply, err
+	}
+}
+
+// Stream creates and returns a gRPC stream server interceptor
+func (i *LoggerInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		ctx, reqLog, header := i.startRequest(stream.Context(), info.FullMethod)
+		if err := stream.SetHeader(header); err != nil {
+			reqLog.Warnf("stream.SetHeader: %v", err)
+		}
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+		md, _ := metadata.FromIncomingContext(ctx)
+		err := handler(srv, wrapped)
+		reqLog.Infof("Stream: %s, Time: %v, Metadata: %v, Code: %s, Err: %v", info.FullMethod, time.Since(start), utilities.RedactMetadata(md), status.Code(err), err)
+		return err
+	}
 }
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"encoding/json"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+// openAPIVersion is the version of the API described by the OpenAPI document
+const openAPIVersion = "1.0.0"
+
+// openAPIBuilder collects the component schemas of the messages the OpenAPI document refers to
+type openAPIBuilder struct {
+	schemas map[string]interface{}
+}
+
+// openAPIDocument returns the OpenAPI 3 document of the gateway routes, generated from their request and response
+// messages. Routes whose gRPC method has accessible roles require a bearer token.
+func openAPIDocument(routes []*gatewayRoute, roles map[string][]string) ([]byte, error) {
+	b := &openAPIBuilder{schemas: map[string]interface{}{
+		"Error": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"code":    map[string]interface{}{"type": "integer", "description": "HTTP status code"},
+				"text":    map[string]interface{}{"type": "string"},
+				"details": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "object"}},
+			},
+		},
+	}}
+	paths := make(map[string]map[string]interface{})
+	for _, rt := range routes {
+		if paths[rt.pattern] == nil {
+			paths[rt.pattern] = make(map[string]interface{})
+		}
+		paths[rt.pattern][strings.ToLower(rt.method)] = b.operation(rt, roles)
+	}
+	doc := map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   "go-grpc-server-boilerplate",
+			"version": openAPIVersion,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": b.schemas,
+			"securitySchemes": map[string]interface{}{
+				"bearerAuth": map[string]interface{}{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+	}
+	return json.Marshal(doc)
+}
+
+// operation returns the OpenAPI operation of a route
+func (b *openAPIBuilder) operation(rt *gatewayRoute, roles map[string][]string) map[string]interface{} {
+	service := strings.Split(strings.TrimPrefix(rt.rpc, "/"), "/")
+	tag := service[0][strings.LastIndex(service[0], ".")+1:]
+	reqDesc := rt.req.ProtoReflect().Descriptor()
+	var params []interface{}
+	pathParams := make(map[string]bool)
+	for _, seg := range rt.segments {
+		if strings.HasPrefix(seg, "{") {
+			name := strings.Trim(seg, "{}")
+			pathParams[name] = true
+			params = append(params, map[string]interface{}{
+				"name":     name,
+				"in":       "path",
+				"required": true,
+				"schema":   b.fieldSchema(findField(reqDesc, name)),
+			})
+		}
+	}
+	if !rt.body {
+		params = append(params, b.queryParams(reqDesc, "", pathParams)...)
+	}
+	op := map[string]interface{}{
+		"operationId": tag + "_" + service[1],
+		"summary":     rt.summary,
+		"tags":        []string{tag},
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "OK",
+				"content":     jsonContent(b.ref(rt.res.ProtoReflect().Descriptor())),
+			},
+			"default": map[string]interface{}{
+				"description": "Error",
+				"content":     jsonContent(map[string]interface{}{"$ref": "#/components/schemas/Error"}),
+			},
+		},
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	if rt.body {
+		op["requestBody"] = map[string]interface{}{"required": true, "content": jsonContent(b.ref(reqDesc))}
+	}
+	if _, ok := roles[rt.rpc]; ok {
+		op["security"] = []interface{}{map[string]interface{}{"bearerAuth": []string{}}}
+	}
+	return op
+}
+
+// queryParams returns the query parameters of the fields of a request message, with nested message fields flattened
+// to dotted names
+func (b *openAPIBuilder) queryParams(md protoreflect.MessageDescriptor, prefix string, skip map[string]bool) []interface{} {
+	var params []interface{}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		name := prefix + fd.JSONName()
+		if skip[name] || fd.IsMap() {
+			continue
+		}
+		if fd.Kind() == protoreflect.MessageKind && !isTimestamp(fd) {
+			if !fd.IsList() && prefix == "" {
+				params = append(params, b.queryParams(fd.Message(), name+".", skip)...)
+			}
+			continue
+		}
+		params = append(params, map[string]interface{}{
+			"name":   name,
+			"in":     "query",
+			"schema": b.fieldSchema(fd),
+		})
+	}
+	return params
+}
+
+// ref returns a reference to the component schema of a message, adding the schema when it is new
+func (b *openAPIBuilder) ref(md protoreflect.MessageDescriptor) map[string]interface{} {
+	name := string(md.FullName())
+	if _, ok := b.schemas[name]; !ok {
+		b.schemas[name] = nil // reserved first so recursive messages terminate
+		props := make(map[string]interface{})
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			props[fields.Get(i).JSONName()] = b.fieldSchema(fields.Get(i))
+		}
+		b.schemas[name] = map[string]interface{}{"type": "object", "properties": props}
+	}
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+// fieldSchema returns the schema of a field as protojson encodes it
+func (b *openAPIBuilder) fieldSchema(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	if fd.IsMap() {
+		return map[string]interface{}{"type": "object", "additionalProperties": b.kindSchema(fd.MapValue())}
+	}
+	if fd.IsList() {
+		return map[string]interface{}{"type": "array", "items": b.kindSchema(fd)}
+	}
+	return b.kindSchema(fd)
+}
+
+// kindSchema returns the schema of a single value of a field
+func (b *openAPIBuilder) kindSchema(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return map[string]interface{}{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if isTimestamp(fd) {
+			return map[string]interface{}{"type": "string", "format": "date-time"}
+		}
+		return b.ref(fd.Message())
+	}
+	return map[string]interface{}{"type": "string"}
+}
+
+// jsonContent returns the application/json content of a request or response with the given schema
+func jsonContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"application/json": map[string]interface{}{"schema": schema}}
+}
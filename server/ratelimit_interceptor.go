@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/JECSand/go-grpc-server-boilerplate/config"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"math"
+	"strconv"
+)
+
+// RateLimitInterceptor enforces the token bucket rate limits of the configuration on gRPC requests
+type RateLimitInterceptor struct {
+	log   utilities.Logger
+	cfg   *config.Store
+	store utilities.RateLimitStore
+}
+
+// NewRateLimitInterceptor constructs a RateLimitInterceptor
+func NewRateLimitInterceptor(log utilities.Logger, cfg *config.Store, store utilities.RateLimitStore) *RateLimitInterceptor {
+	return &RateLimitInterceptor{log: log, cfg: cfg, store: store}
+}
+
+// Unary creates and returns a gRPC unary server interceptor
+func (i *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		retryAfter, err := i.limit(ctx, info.FullMethod)
+		if err != nil {
+			if hErr := grpc.SetHeader(ctx, retryAfter); hErr != nil {
+				i.log.WithContext(ctx).Warnf("grpc.SetHeader: %v", hErr)
+			}
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream creates and returns a gRPC stream server interceptor
+func (i *RateLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		retryAfter, err := i.limit(stream.Context(), info.FullMethod)
+		if err != nil {
+			if hErr := stream.SetHeader(retryAfter); hErr != nil {
+				i.log.WithContext(stream.Context()).Warnf("stream.SetHeader: %v", hErr)
+			}
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// limit takes a token for the request from the bucket of the rule that applies to method. When the bucket is empty it
+// returns a ResourceExhausted error together with the retry-after header. Requests are let through when the store fails.
+func (i *RateLimitInterceptor) limit(ctx context.Context, method string) (metadata.MD, error) {
+	conf := i.cfg.Get().RateLimit
+	rule, scope := conf.Default, "*"
+	if r, ok := conf.Methods[method]; ok {
+		rule, scope = r, method
+	}
+	if rule.Rate <= 0 {
+		return nil, nil
+	}
+	allowed, retryAfter, err := i.store.Take(ctx, rateLimitIdentity(ctx, rule.Key)+"|"+scope, rule.Rate, rule.Burst)
+	if err != nil {
+		i.log.WithContext(ctx).Warnf("RateLimitInterceptor.limit: %v", err)
+		return nil, nil
+	}
+	if allowed {
+		return nil, nil
+	}
+	i.log.WithContext(ctx).Infof("rate limit exceeded for %s, retry after %v", method, retryAfter)
+	st, err := status.New(codes.ResourceExhausted, "rate limit exceeded").WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	})
+	if err != nil {
+		return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	return metadata.Pairs(utilities.RetryAfterHeader, strconv.Itoa(seconds)), st.Err()
+}
+
+// rateLimitIdentity returns the identity a request is limited by for the given rule key. Requests without the identity,
+// such as unauthenticated requests under a user rule, are limited by their peer IP.
+func rateLimitIdentity(ctx context.Context, key string) string {
+	switch key {
+	case "user", "group":
+		if tokenData, err := models.LoadTokenFromContext(ctx); err == nil {
+			if key == "user" {
+				return "user:" + tokenData.UserId
+			}
+			return "group:" + tokenData.GroupId
+		}
+	case "api_key":
+		if token, err := utilities.GetTokenFromContext(ctx); err == nil {
+			sum := sha256.Sum256([]byte(token))
+			return "api_key:" + hex.EncodeToString(sum[:16])
+		}
+	}
+	if ip := utilities.PeerIP(ctx); ip != "" {
+		return "ip:" + ip
+	}
+	return "ip:unknown"
+}
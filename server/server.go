@@ -4,16 +4,19 @@ import (
 	"context"
 	"crypto/tls"
 	"github.com/JECSand/go-grpc-server-boilerplate/config"
+	adminsService "github.com/JECSand/go-grpc-server-boilerplate/protos/admin"
+	auditsService "github.com/JECSand/go-grpc-server-boilerplate/protos/audit"
 	authsService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
 	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
 	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
 	usersService "github.com/JECSand/go-grpc-server-boilerplate/protos/user"
+	webhooksService "github.com/JECSand/go-grpc-server-boilerplate/protos/webhook"
 	"github.com/JECSand/go-grpc-server-boilerplate/services"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
-	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
@@ -21,65 +24,130 @@ import (
 	"google.golang.org/grpc/test/bufconn"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 )
 
+// configPollInterval is how often the configuration file is checked for modifications
+const configPollInterval = 5 * time.Second
+
+// gatewayBufferSize is the buffer size of the in-process listener the HTTP gateway reaches the gRPC server through
+const gatewayBufferSize = 1024 * 1024
+
 func accessibleRoles() map[string][]string {
 	const authServicePath = "/authService.AuthService/"
 	const userServicePath = "/usersService.UserService/"
 	const groupServicePath = "/groupsService.GroupService/"
 	const taskServicePath = "/tasksService.TaskService/"
+	const auditServicePath = "/auditsService.AuditService/"
+	const webhookServicePath = "/webhooksService.WebhookService/"
+	const adminServicePath = "/adminsService.AdminService/"
 	return map[string][]string{
-		authServicePath + "Logout":         {"Member"},
-		authServicePath + "Refresh":        {"Member"},
-		authServicePath + "GenerateKey":    {"Member"},
-		authServicePath + "UpdatePassword": {"Member"},
-		userServicePath + "Create":         {"Admin"},
-		userServicePath + "Update":         {"Admin"},
-		userServicePath + "Get":            {"Member"},
-		userServicePath + "GetGroupUsers":  {"Member"},
-		userServicePath + "Find":           {"Member"},
-		userServicePath + "Delete":         {"Admin"},
-		groupServicePath + "Create":        {"Root"},
-		groupServicePath + "Update":        {"Admin"},
-		groupServicePath + "Get":           {"Member"},
-		groupServicePath + "Find":          {"Member"},
-		groupServicePath + "Delete":        {"Root"},
-		taskServicePath + "Create":         {"Member"},
-		taskServicePath + "Update":         {"Member"},
-		taskServicePath + "Get":            {"Member"},
-		taskServicePath + "GetGroupTasks":  {"Member"},
-		taskServicePath + "GetUserTasks":   {"Member"},
-		taskServicePath + "Find":           {"Member"},
-		taskServicePath + "Delete":         {"Member"},
+		authServicePath + "Logout":             {"Member"},
+		authServicePath + "Refresh":            {"Member"},
+		authServicePath + "GenerateKey":        {"Member"},
+		authServicePath + "UpdatePassword":     {"Member"},
+		authServicePath + "SwitchGroup":        {"Member"},
+		authServicePath + "AcceptInvite":       {"Member"},
+		userServicePath + "Create":             {"Admin"},
+		userServicePath + "Update":             {"Admin"},
+		userServicePath + "Get":                {"Member"},
+		userServicePath + "GetGroupUsers":      {"Member"},
+		userServicePath + "Find":               {"Member"},
+		userServicePath + "Delete":             {"Admin"},
+		userServicePath + "MoveUser":           {"Member"},
+		groupServicePath + "Create":            {"Member"},
+		groupServicePath + "Update":            {"Admin"},
+		groupServicePath + "Get":               {"Member"},
+		groupServicePath + "Find":              {"Member"},
+		groupServicePath + "Delete":            {"Member"},
+		groupServicePath + "GetSettings":       {"Member"},
+		groupServicePath + "UpdateSettings":    {"Member"},
+		groupServicePath + "Move":              {"Member"},
+		groupServicePath + "ListDescendants":   {"Member"},
+		groupServicePath + "AddMember":         {"Member"},
+		groupServicePath + "RemoveMember":      {"Member"},
+		groupServicePath + "ListMembers":       {"Member"},
+		groupServicePath + "TransferAdmin":     {"Member"},
+		groupServicePath + "CreateInvite":      {"Member"},
+		groupServicePath + "ListInvites":       {"Member"},
+		groupServicePath + "RevokeInvite":      {"Member"},
+		taskServicePath + "Create":             {"Member"},
+		taskServicePath + "Update":             {"Member"},
+		taskServicePath + "Get":                {"Member"},
+		taskServicePath + "GetGroupTasks":      {"Member"},
+		taskServicePath + "GetUserTasks":       {"Member"},
+		taskServicePath + "Find":               {"Member"},
+		taskServicePath + "Delete":             {"Member"},
+		taskServicePath + "WatchTasks":         {"Member"},
+		taskServicePath + "AddComment":         {"Member"},
+		taskServicePath + "UpdateComment":      {"Member"},
+		taskServicePath + "DeleteComment":      {"Member"},
+		taskServicePath + "ListComments":       {"Member"},
+		taskServicePath + "ListActivity":       {"Member"},
+		taskServicePath + "AddAttachment":      {"Member"},
+		taskServicePath + "ListAttachments":    {"Member"},
+		taskServicePath + "DownloadAttachment": {"Member"},
+		taskServicePath + "DeleteAttachment":   {"Member"},
+		auditServicePath + "Find":              {"Admin"},
+		webhookServicePath + "Create":          {"Admin"},
+		webhookServicePath + "Update":          {"Admin"},
+		webhookServicePath + "Get":             {"Admin"},
+		webhookServicePath + "Find":            {"Admin"},
+		webhookServicePath + "Delete":          {"Admin"},
+		webhookServicePath + "GetDeliveries":   {"Admin"},
+		webhookServicePath + "GetDeadLetters":  {"Admin"},
+		webhookServicePath + "Redeliver":       {"Admin"},
+		adminServicePath + "GetConfig":         {"Root"},
+		adminServicePath + "ReloadConfig":      {"Root"},
 	}
 }
 
 // Server is a struct that stores the API Apps high level attributes such as the router, config, and services
 type Server struct {
-	log              utilities.Logger
-	cfg              *config.Configuration
-	TokenService     *services.TokenService
-	UserDataService  services.UserDataService
-	GroupDataService services.GroupDataService
-	TaskDataService  services.TaskDataService
-	FileDataService  services.FileDataService
+	log                   utilities.Logger
+	cfg                   *config.Store
+	TokenService          *services.TokenService
+	UserDataService       services.UserDataService
+	GroupDataService      services.GroupDataService
+	TaskDataService       services.TaskDataService
+	FileDataService       services.FileDataService
+	AuditDataService      services.AuditDataService
+	WebhookDataService    services.WebhookDataService
+	MembershipDataService services.MembershipDataService
+	InviteDataService     services.InviteDataService
+	CommentDataService    services.CommentDataService
+	EventBus              *services.EventBus
+	WebhookDispatcher     *services.WebhookDispatcher
+	UnitOfWork            services.UnitOfWork
+	RateLimitStore        utilities.RateLimitStore
 }
 
 // NewServer is a function used to initialize a new Server struct
-func NewServer(log utilities.Logger, cfg *config.Configuration, u services.UserDataService, g services.GroupDataService,
-	t services.TaskDataService, f services.FileDataService, ts *services.TokenService) *Server {
+func NewServer(log utilities.Logger, cfg *config.Store, u services.UserDataService, g services.GroupDataService,
+	t services.TaskDataService, f services.FileDataService, a services.AuditDataService, w services.WebhookDataService,
+	m services.MembershipDataService, i services.InviteDataService, c services.CommentDataService, bus *services.EventBus, wd *services.WebhookDispatcher, uow services.UnitOfWork,
+	ts *services.TokenService, rl utilities.RateLimitStore) *Server {
 	return &Server{
-		log:              log,
-		cfg:              cfg,
-		TokenService:     ts,
-		UserDataService:  u,
-		GroupDataService: g,
-		TaskDataService:  t,
-		FileDataService:  f,
+		log:                   log,
+		cfg:                   cfg,
+		TokenService:          ts,
+		UserDataService:       u,
+		GroupDataService:      g,
+		TaskDataService:       t,
+		FileDataService:       f,
+		AuditDataService:      a,
+		WebhookDataService:    w,
+		MembershipDataService: m,
+		InviteDataService:     i,
+		CommentDataService:    c,
+		EventBus:              bus,
+		WebhookDispatcher:     wd,
+		UnitOfWork:            uow,
+		RateLimitStore:        rl,
 	}
 }
 
@@ -87,46 +155,33 @@ func NewServer(log utilities.Logger, cfg *config.Configuration, u services.UserD
 func (s *Server) Start() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
-	li := NewLoggerInterceptor(s.log, s.cfg)
-	ai := NewAuthInterceptor(s.log, s.TokenService, accessibleRoles())
-	l, err := net.Listen("tcp", os.Getenv("PORT"))
+	conf := s.cfg.Get()
+	l, err := net.Listen("tcp", conf.Server.Port)
 	if err != nil {
 		return errors.Wrap(err, "net.Listen")
 	}
 	defer l.Close()
-	cert, err := tls.LoadX509KeyPair(s.cfg.Cert, s.cfg.Key)
+	cert, err := tls.LoadX509KeyPair(conf.Cert, conf.Key)
 	if err != nil {
 		s.log.Fatalf("failed to load key pair: %s", err)
 	}
-	grpcServer := grpc.NewServer(
-		grpc.Creds(credentials.NewServerTLSFromCert(&cert)),
-		grpc.KeepaliveParams(keepalive.ServerParameters{
-			MaxConnectionIdle: s.cfg.Server.MaxConnectionIdle * time.Minute,
-			Timeout:           s.cfg.Server.Timeout * time.Second,
-			MaxConnectionAge:  s.cfg.Server.MaxConnectionAge * time.Minute,
-			Time:              s.cfg.Server.Timeout * time.Minute,
-		}),
-		grpc.ChainUnaryInterceptor(
-			grpc_ctxtags.UnaryServerInterceptor(),
-			grpc_opentracing.UnaryServerInterceptor(),
-			grpcrecovery.UnaryServerInterceptor(),
-			ai.Unary(),
-			li.Logger,
-		),
-		grpc.StreamInterceptor(ai.Stream()),
-	)
-	userService := services.NewUserService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.TaskDataService, s.FileDataService)
-	usersService.RegisterUserServiceServer(grpcServer, userService)
-	groupService := services.NewGroupService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.TaskDataService, s.FileDataService)
-	groupsService.RegisterGroupServiceServer(grpcServer, groupService)
-	taskService := services.NewTaskService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.TaskDataService, s.FileDataService)
-	tasksService.RegisterTaskServiceServer(grpcServer, taskService)
-	authService := services.NewAuthService(s.log, s.TokenService, s.UserDataService, s.GroupDataService)
-	authsService.RegisterAuthServiceServer(grpcServer, authService)
+	grpcServer := s.newGRPCServer(conf, grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+	go services.RunAuditRetention(ctx, s.log, s.AuditDataService,
+		time.Duration(conf.Audit.RetentionDays)*24*time.Hour, conf.Audit.PurgeInterval*time.Minute)
+	go s.EventBus.Run(ctx, conf.Events.RelayInterval*time.Second)
+	go s.WebhookDispatcher.Run(ctx, conf.Events.RelayInterval*time.Second)
+	go s.watchConfig(ctx)
 	go func() {
-		s.log.Infof("GRPC Server is listening on port: %s", s.cfg.Server.Port)
+		s.log.Infof("GRPC Server is listening on port: %s", conf.Server.Port)
 		s.log.Fatal(grpcServer.Serve(l))
 	}()
+	var httpServer *http.Server
+	if conf.Gateway.Port != "" {
+		httpServer, err = s.startGateway(ctx, conf, grpcServer)
+		if err != nil {
+			return err
+		}
+	}
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
 	select {
@@ -135,51 +190,134 @@ func (s *Server) Start() error {
 	case done := <-ctx.Done():
 		s.log.Errorf("ctx.Done: %v", done)
 	}
+	if httpServer != nil {
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), conf.Server.Timeout*time.Second)
+		defer shutdownCancel()
+		if err = httpServer.Shutdown(shutdownCtx); err != nil {
+			s.log.Errorf("httpServer.Shutdown: %v", err)
+		}
+	}
 	grpcServer.GracefulStop()
 	s.log.Info("Server Exited Properly")
 	return nil
 }
 
-// StartTest starts the initialized Server in a test state
-func (s *Server) StartTest(ctx context.Context) (*grpc.ClientConn, func()) {
-	buffer := 101024 * 1024
-	l := bufconn.Listen(buffer)
-	li := NewLoggerInterceptor(s.log, s.cfg)
+// startGateway serves the HTTP/JSON gateway on the gateway port. The gateway reaches grpcServer through an in-process
+// listener, so its calls pass through every interceptor without a TLS round trip.
+func (s *Server) startGateway(ctx context.Context, conf *config.Configuration, grpcServer *grpc.Server) (*http.Server, error) {
+	gl := bufconn.Listen(gatewayBufferSize)
+	go func() {
+		if err := grpcServer.Serve(gl); err != nil {
+			s.log.Errorf("grpcServer.Serve: %v", err)
+		}
+	}()
+	conn, err := dialBufconn(ctx, gl)
+	if err != nil {
+		return nil, errors.Wrap(err, "grpc.DialContext")
+	}
+	httpServer := &http.Server{
+		Addr:        conf.Gateway.Port,
+		Handler:     s.newHTTPHandler(conf, grpcServer, conn),
+		ReadTimeout: conf.Server.ReadTimeout * time.Second,
+	}
+	if !conf.Gateway.GRPCWeb { // a write timeout would cut the server streams of gRPC-Web and Connect clients short
+		httpServer.WriteTimeout = conf.Server.WriteTimeout * time.Second
+	}
+	go func() {
+		s.log.Infof("HTTP Gateway is listening on port: %s", conf.Gateway.Port)
+		serve := httpServer.ListenAndServe
+		if conf.Server.SSL == "true" {
+			serve = func() error { return httpServer.ListenAndServeTLS(conf.Cert, conf.Key) }
+		}
+		if err := serve(); err != http.ErrServerClosed {
+			s.log.Fatal(err)
+		}
+	}()
+	return httpServer, nil
+}
+
+// newHTTPHandler returns the handler of the gateway port: the REST/JSON gateway calling the gRPC services over conn and,
+// when enabled, the gRPC-Web and Connect protocols served by grpcServer, behind the CORS settings
+func (s *Server) newHTTPHandler(conf *config.Configuration, grpcServer *grpc.Server, conn grpc.ClientConnInterface) http.Handler {
+	gateway := NewGateway(s.log, conn)
+	if !conf.Gateway.GRPCWeb {
+		return NewCORSHandler(s.cfg, gateway)
+	}
+	web := NewWebHandler(s.log, grpcServer)
+	return NewCORSHandler(s.cfg, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if web.Handles(r) {
+			web.ServeHTTP(w, r)
+			return
+		}
+		gateway.ServeHTTP(w, r)
+	}))
+}
+
+// newGRPCServer creates a grpc.Server with the interceptor chain of the Server and every service registered on it
+func (s *Server) newGRPCServer(conf *config.Configuration, opts ...grpc.ServerOption) *grpc.Server {
+	li := NewLoggerInterceptor(s.log, conf)
+	ri := NewRateLimitInterceptor(s.log, s.cfg, s.RateLimitStore)
 	ai := NewAuthInterceptor(s.log, s.TokenService, accessibleRoles())
-	grpcServer := grpc.NewServer(
-		grpc.KeepaliveParams(keepalive.ServerParameters{
-			MaxConnectionIdle: s.cfg.Server.MaxConnectionIdle * time.Minute,
-			Timeout:           s.cfg.Server.Timeout * time.Second,
-			MaxConnectionAge:  s.cfg.Server.MaxConnectionAge * time.Minute,
-			Time:              s.cfg.Server.Timeout * time.Minute,
-		}),
+	vi := NewValidationInterceptor(s.log, requestRules())
+	grpcServer := grpc.NewServer(append(opts,
+		grpc.KeepaliveParams(keepaliveParams(conf)),
 		grpc.ChainUnaryInterceptor(
 			grpc_ctxtags.UnaryServerInterceptor(),
-			grpc_opentracing.UnaryServerInterceptor(),
+			otelgrpc.UnaryServerInterceptor(),
 			grpcrecovery.UnaryServerInterceptor(),
+			li.Unary(),
+			ri.Unary(),
 			ai.Unary(),
-			li.Logger,
+			vi.Unary(),
+		),
+		grpc.ChainStreamInterceptor(
+			otelgrpc.StreamServerInterceptor(),
+			grpcrecovery.StreamServerInterceptor(),
+			li.Stream(),
+			ri.Stream(),
+			ai.Stream(),
+			vi.Stream(),
 		),
-		grpc.StreamInterceptor(ai.Stream()),
-	)
-	userService := services.NewUserService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.TaskDataService, s.FileDataService)
+	)...)
+	quota := services.NewQuotaChecker(s.cfg, s.UserDataService, s.GroupDataService, s.MembershipDataService, s.TaskDataService)
+	userService := services.NewUserService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.MembershipDataService, s.TaskDataService, s.FileDataService, s.CommentDataService, s.AuditDataService, s.EventBus, s.UnitOfWork, quota)
 	usersService.RegisterUserServiceServer(grpcServer, userService)
-	groupService := services.NewGroupService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.TaskDataService, s.FileDataService)
+	groupService := services.NewGroupService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.MembershipDataService, s.InviteDataService, s.TaskDataService, s.FileDataService, s.CommentDataService, s.AuditDataService, s.EventBus, s.UnitOfWork, quota)
 	groupsService.RegisterGroupServiceServer(grpcServer, groupService)
-	taskService := services.NewTaskService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.TaskDataService, s.FileDataService)
+	taskService := services.NewTaskService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.MembershipDataService, s.TaskDataService, s.FileDataService, s.CommentDataService, s.EventBus, s.UnitOfWork, quota)
 	tasksService.RegisterTaskServiceServer(grpcServer, taskService)
-	authService := services.NewAuthService(s.log, s.TokenService, s.UserDataService, s.GroupDataService)
+	authService := services.NewAuthService(s.log, s.TokenService, s.UserDataService, s.GroupDataService, s.MembershipDataService, s.InviteDataService, s.AuditDataService, s.EventBus, s.UnitOfWork, s.cfg, quota)
 	authsService.RegisterAuthServiceServer(grpcServer, authService)
+	adminService := services.NewAdminService(s.log, s.cfg, s.AuditDataService)
+	adminsService.RegisterAdminServiceServer(grpcServer, adminService)
+	auditService := services.NewAuditService(s.log, s.AuditDataService)
+	auditsService.RegisterAuditServiceServer(grpcServer, auditService)
+	webhookService := services.NewWebhookService(s.log, s.WebhookDataService)
+	webhooksService.RegisterWebhookServiceServer(grpcServer, webhookService)
+	return grpcServer
+}
+
+// dialBufconn connects a gRPC client to the server serving an in-memory listener
+func dialBufconn(ctx context.Context, l *bufconn.Listener) (*grpc.ClientConn, error) {
+	return grpc.DialContext(ctx, "",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return l.Dial()
+		}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+}
+
+// StartTest starts the initialized Server in a test state
+func (s *Server) StartTest(ctx context.Context) (*grpc.ClientConn, func()) {
+	conf := s.cfg.Get()
+	buffer := 101024 * 1024
+	l := bufconn.Listen(buffer)
+	grpcServer := s.newGRPCServer(conf)
 	go func() {
 		s.log.Infof("GRPC Test Server is starting...")
 		if err := grpcServer.Serve(l); err != nil {
 			s.log.Fatal("error serving GRPC Test Server: %v", err)
 		}
 	}()
-	conn, err := grpc.DialContext(ctx, "",
-		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
-			return l.Dial()
-		}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := dialBufconn(ctx, l)
 	if err != nil {
 		log.Printf("error connecting to server: %v", err)
 	}
@@ -192,3 +330,57 @@ func (s *Server) StartTest(ctx context.Context) (*grpc.ClientConn, func()) {
 	}
 	return conn, closer
 }
+
+// StartTestHTTP starts the initialized Server in a test state and returns the handler of its gateway port
+func (s *Server) StartTestHTTP(ctx context.Context) (http.Handler, func()) {
+	conf := s.cfg.Get()
+	l := bufconn.Listen(gatewayBufferSize)
+	grpcServer := s.newGRPCServer(conf)
+	go func() {
+		if err := grpcServer.Serve(l); err != nil {
+			s.log.Errorf("error serving GRPC Test Server: %v", err)
+		}
+	}()
+	conn, err := dialBufconn(ctx, l)
+	if err != nil {
+		log.Printf("error connecting to server: %v", err)
+	}
+	closer := func() {
+		_ = conn.Close()
+		grpcServer.Stop()
+	}
+	return s.newHTTPHandler(conf, grpcServer, conn), closer
+}
+
+// keepaliveParams returns the gRPC keepalive parameters of a Configuration, which are fixed once the server is created
+func keepaliveParams(conf *config.Configuration) keepalive.ServerParameters {
+	return keepalive.ServerParameters{
+		MaxConnectionIdle: conf.Server.MaxConnectionIdle * time.Minute,
+		Timeout:           conf.Server.Timeout * time.Second,
+		MaxConnectionAge:  conf.Server.MaxConnectionAge * time.Minute,
+		Time:              conf.Server.Timeout * time.Minute,
+	}
+}
+
+// watchConfig reloads the configuration on SIGHUP and whenever its file is modified, until ctx is cancelled
+func (s *Server) watchConfig(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	ticker := time.NewTicker(configPollInterval)
+	defer ticker.Stop()
+	modTime := s.cfg.FileModTime()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			_, _ = services.ReloadConfig(s.log, s.cfg, "SIGHUP")
+		case <-ticker.C:
+			if m := s.cfg.FileModTime(); !m.Equal(modTime) {
+				modTime = m
+				_, _ = services.ReloadConfig(s.log, s.cfg, "file change")
+			}
+		}
+	}
+}
@@ -0,0 +1,361 @@
+package server
+
+import (
+	"context"
+	adminsService "github.com/JECSand/go-grpc-server-boilerplate/protos/admin"
+	auditsService "github.com/JECSand/go-grpc-server-boilerplate/protos/audit"
+	authsService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
+	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
+	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
+	usersService "github.com/JECSand/go-grpc-server-boilerplate/protos/user"
+	webhooksService "github.com/JECSand/go-grpc-server-boilerplate/protos/webhook"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// requestRules declares the validation rules of every request message, by the dotted paths of its fields
+func requestRules() map[proto.Message]utilities.MessageRules {
+	required, objectID, email := utilities.Required(), utilities.ObjectID(), utilities.Email()
+	name, username, password := utilities.Length(1, 64), utilities.Length(3, 64), utilities.Length(6, 128)
+	page, size := utilities.NonNegative(), utilities.NonNegative()
+	roles, inviteCode := utilities.OneOf("member", "admin"), utilities.Length(1, 128)
+	return map[proto.Message]utilities.MessageRules{
+		&adminsService.GetConfigReq{}:    {},
+		&adminsService.ReloadConfigReq{}: {},
+		&auditsService.FindReq{}: {
+			"ActorId": {objectID},
+			"GroupId": {objectID},
+			"Since":   {utilities.Timestamp()},
+			"Until":   {utilities.Timestamp()},
+			"Page":    {page},
+			"Size":    {size},
+		},
+		&authsService.Empty{}: {},
+		&authsService.RegisterReq{}: {
+			"FirstName":  {name},
+			"LastName":   {name},
+			"Email":      {required, email},
+			"Username":   {required, username},
+			"Password":   {required, password},
+			"InviteCode": {inviteCode},
+		},
+		&authsService.LoginReq{}: {
+			"Email":    {required, email},
+			"Password": {required},
+		},
+		&authsService.UpdatePasswordReq{}: {
+			"CurrentPassword": {required},
+			"NewPassword":     {required, password},
+		},
+		&authsService.SwitchGroupReq{}: {
+			"GroupId": {required, objectID},
+		},
+		&authsService.AcceptInviteReq{}: {
+			"Code": {required, inviteCode},
+		},
+		&usersService.CreateReq{}: {
+			"Username":  {required, username},
+			"Password":  {required, password},
+			"FirstName": {name},
+			"LastName":  {name},
+			"Email":     {required, email},
+			"Role":      {roles},
+			"GroupId":   {objectID},
+		},
+		&usersService.UpdateReq{}: {
+			"Id":        {required, objectID},
+			"Username":  {username},
+			"Password":  {password},
+			"FirstName": {name},
+			"LastName":  {name},
+			"Email":     {email},
+			"Role":      {roles},
+			"GroupId":   {objectID},
+		},
+		&usersService.GetReq{}: {
+			"Id": {required, objectID},
+		},
+		&usersService.GetGroupUsersReq{}: {
+			"GroupId": {required, objectID},
+			"Page":    {page},
+			"Size":    {size},
+		},
+		&usersService.FindReq{}: {
+			"User.Id":      {objectID},
+			"User.GroupId": {objectID},
+			"Page":         {page},
+			"Size":         {size},
+		},
+		&usersService.DeleteReq{}: {
+			"Id": {required, objectID},
+		},
+		&usersService.MoveUserReq{}: {
+			"Id":         {required, objectID},
+			"GroupId":    {required, objectID},
+			"Role":       {roles},
+			"AssigneeId": {objectID},
+		},
+		&groupsService.CreateReq{}: {
+			"Name":     {required, utilities.Length(1, 128)},
+			"ParentId": {objectID},
+		},
+		&groupsService.UpdateReq{}: {
+			"Id":   {required, objectID},
+			"Name": {utilities.Length(1, 128)},
+		},
+		&groupsService.GetReq{}: {
+			"Id": {required, objectID},
+		},
+		&groupsService.FindReq{}: {
+			"Group.Id": {objectID},
+			"Page":     {page},
+			"Size":     {size},
+		},
+		&groupsService.DeleteReq{}: {
+			"Id": {required, objectID},
+		},
+		&groupsService.GetSettingsReq{}: {
+			"Id": {required, objectID},
+		},
+		&groupsService.UpdateSettingsReq{}: {
+			"Id":                      {required, objectID},
+			"Settings.Timezone":       {utilities.Length(0, 64)},
+			"Settings.DefaultDueDays": {utilities.NonNegative()},
+			"Settings.MemberLimit":    {utilities.NonNegative()},
+		},
+		&groupsService.MoveReq{}: {
+			"Id":       {required, objectID},
+			"ParentId": {objectID},
+		},
+		&groupsService.ListDescendantsReq{}: {
+			"Id":   {required, objectID},
+			"Page": {page},
+			"Size": {size},
+		},
+		&groupsService.AddMemberReq{}: {
+			"GroupId": {required, objectID},
+			"UserId":  {required, objectID},
+			"Role":    {roles},
+		},
+		&groupsService.RemoveMemberReq{}: {
+			"GroupId": {required, objectID},
+			"UserId":  {required, objectID},
+		},
+		&groupsService.ListMembersReq{}: {
+			"GroupId": {required, objectID},
+			"Page":    {page},
+			"Size":    {size},
+		},
+		&groupsService.TransferAdminReq{}: {
+			"GroupId": {required, objectID},
+			"UserId":  {required, objectID},
+		},
+		&groupsService.CreateInviteReq{}: {
+			"GroupId":   {required, objectID},
+			"Email":     {email},
+			"Role":      {roles},
+			"MaxUses":   {utilities.NonNegative()},
+			"ExpiresAt": {utilities.Timestamp()},
+		},
+		&groupsService.ListInvitesReq{}: {
+			"GroupId": {required, objectID},
+			"Page":    {page},
+			"Size":    {size},
+		},
+		&groupsService.RevokeInviteReq{}: {
+			"GroupId": {required, objectID},
+			"Id":      {required, objectID},
+		},
+		&tasksService.CreateReq{}: {
+			"Name":        {required, utilities.Length(1, 128)},
+			"Due":         {utilities.DueDate()},
+			"Description": {utilities.Length(0, 2000)},
+			"UserId":      {objectID},
+			"GroupId":     {objectID},
+		},
+		&tasksService.UpdateReq{}: {
+			"Id":          {required, objectID},
+			"Name":        {utilities.Length(1, 128)},
+			"Status":      {utilities.Enum()},
+			"Due":         {utilities.DueDate()},
+			"Description": {utilities.Length(0, 2000)},
+			"UserId":      {objectID},
+			"GroupId":     {objectID},
+		},
+		&tasksService.GetReq{}: {
+			"Id": {required, objectID},
+		},
+		&tasksService.GetUserTasksReq{}: {
+			"UserId": {required, objectID},
+			"Page":   {page},
+			"Size":   {size},
+		},
+		&tasksService.GetGroupTasksReq{}: {
+			"GroupId": {required, objectID},
+			"Page":    {page},
+			"Size":    {size},
+		},
+		&tasksService.FindReq{}: {
+			"Task.Id":      {objectID},
+			"Task.UserId":  {objectID},
+			"Task.GroupId": {objectID},
+			"Page":         {page},
+			"Size":         {size},
+		},
+		&tasksService.DeleteReq{}: {
+			"Id": {required, objectID},
+		},
+		&tasksService.WatchTasksReq{}: {},
+		&tasksService.AddCommentReq{}: {
+			"TaskId": {required, objectID},
+			"Body":   {required, utilities.Length(1, 4096)},
+		},
+		&tasksService.UpdateCommentReq{}: {
+			"Id":   {required, objectID},
+			"Body": {required, utilities.Length(1, 4096)},
+		},
+		&tasksService.DeleteCommentReq{}: {
+			"Id": {required, objectID},
+		},
+		&tasksService.ListCommentsReq{}: {
+			"TaskId": {required, objectID},
+			"Page":   {page},
+			"Size":   {size},
+		},
+		&tasksService.ListActivityReq{}: {
+			"TaskId": {required, objectID},
+			"Page":   {page},
+			"Size":   {size},
+		},
+		&tasksService.AddAttachmentReq{}: { // only the first message of an upload names the task and the file
+			"TaskId":   {objectID},
+			"Name":     {utilities.Length(0, 255)},
+			"FileType": {utilities.Length(0, 128)},
+		},
+		&tasksService.ListAttachmentsReq{}: {
+			"TaskId": {required, objectID},
+			"Page":   {page},
+			"Size":   {size},
+		},
+		&tasksService.DownloadAttachmentReq{}: {
+			"TaskId": {required, objectID},
+			"Id":     {required, objectID},
+		},
+		&tasksService.DeleteAttachmentReq{}: {
+			"TaskId": {required, objectID},
+			"Id":     {required, objectID},
+		},
+		&webhooksService.CreateReq{}: {
+			"GroupId": {objectID},
+			"Url":     {required, utilities.HTTPURL()},
+		},
+		&webhooksService.UpdateReq{}: {
+			"Id":  {required, objectID},
+			"Url": {utilities.HTTPURL()},
+		},
+		&webhooksService.GetReq{}: {
+			"Id": {required, objectID},
+		},
+		&webhooksService.FindReq{}: {
+			"GroupId": {objectID},
+			"Page":    {page},
+			"Size":    {size},
+		},
+		&webhooksService.DeleteReq{}: {
+			"Id": {required, objectID},
+		},
+		&webhooksService.GetDeliveriesReq{}: {
+			"WebhookId": {required, objectID},
+			"Page":      {page},
+			"Size":      {size},
+		},
+		&webhooksService.GetDeadLettersReq{}: {
+			"GroupId": {objectID},
+			"Page":    {page},
+			"Size":    {size},
+		},
+		&webhooksService.RedeliverReq{}: {
+			"DeliveryId": {required, objectID},
+		},
+	}
+}
+
+// ValidationInterceptor rejects gRPC requests that break the validation rules of their message with InvalidArgument
+// and errdetails.BadRequest field violations
+type ValidationInterceptor struct {
+	log   utilities.Logger
+	rules map[protoreflect.FullName]utilities.MessageRules
+}
+
+// NewValidationInterceptor constructs a ValidationInterceptor; rules that refer to a missing field are a fatal error
+func NewValidationInterceptor(log utilities.Logger, rules map[proto.Message]utilities.MessageRules) *ValidationInterceptor {
+	i := &ValidationInterceptor{log: log, rules: make(map[protoreflect.FullName]utilities.MessageRules, len(rules))}
+	for msg, r := range rules {
+		if err := utilities.CheckRules(msg, r); err != nil {
+			log.Fatalf("NewValidationInterceptor: %v", err)
+		}
+		i.rules[msg.ProtoReflect().Descriptor().FullName()] = r
+	}
+	return i
+}
+
+// validate applies the rules of the request's message to it
+func (i *ValidationInterceptor) validate(ctx context.Context, req interface{}) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	rules, ok := i.rules[msg.ProtoReflect().Descriptor().FullName()]
+	if !ok {
+		i.log.WithContext(ctx).Warnf("ValidationInterceptor: no validation rules for %s", msg.ProtoReflect().Descriptor().FullName())
+		return nil
+	}
+	err := utilities.ValidateMessage(msg, rules)
+	if err != nil {
+		i.log.WithContext(ctx).Debugf("ValidationInterceptor: %v", err)
+	}
+	return err
+}
+
+// Unary creates and returns a gRPC unary server interceptor
+func (i *ValidationInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := i.validate(ctx, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream creates and returns a gRPC stream server interceptor that validates every message the client sends
+func (i *ValidationInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &validatedStream{ServerStream: stream, i: i})
+	}
+}
+
+// validatedStream is a grpc.ServerStream that validates the messages it receives
+type validatedStream struct {
+	grpc.ServerStream
+	i *ValidationInterceptor
+}
+
+// RecvMsg receives the next client message and validates it
+func (s *validatedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.i.validate(s.Context(), m)
+}
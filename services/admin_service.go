@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/JECSand/go-grpc-server-boilerplate/config"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	adminsService "github.com/JECSand/go-grpc-server-boilerplate/protos/admin"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
+)
+
+// AdminService gRPC Service
+type AdminService struct {
+	log     utilities.Logger
+	cfg     *config.Store
+	auditDB AuditDataService
+}
+
+// NewAdminService constructs an AdminService for controller gRPC service Admin requests
+func NewAdminService(log utilities.Logger, cfg *config.Store, a AuditDataService) *AdminService {
+	return &AdminService{
+		log:     log,
+		cfg:     cfg,
+		auditDB: a,
+	}
+}
+
+// GetConfig returns the effective configuration with its secrets redacted
+func (u *AdminService) GetConfig(ctx context.Context, req *adminsService.GetConfigReq) (*adminsService.GetConfigRes, error) {
+	conf, err := redactedConfigJSON(u.cfg.Get())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("AdminService.GetConfig: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &adminsService.GetConfigRes{
+		Config:     conf,
+		LoadedAt:   timestamppb.New(u.cfg.LoadedAt()),
+		Reloadable: config.ReloadableFields(),
+	}, nil
+}
+
+// ReloadConfig reloads the configuration, applying its reloadable settings, and returns the effective configuration
+func (u *AdminService) ReloadConfig(ctx context.Context, req *adminsService.ReloadConfigReq) (*adminsService.ReloadConfigRes, error) {
+	res, err := ReloadConfig(u.log.WithContext(ctx), u.cfg, "admin request")
+	if err != nil {
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	event := models.NewAuditEvent(ctx, models.AuditConfigReload, "config", "", "")
+	event.Metadata = map[string]string{"applied": strings.Join(res.Applied, ","), "ignored": strings.Join(res.Ignored, ",")}
+	recordAudit(ctx, u.log, u.auditDB, event)
+	conf, err := redactedConfigJSON(u.cfg.Get())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("AdminService.ReloadConfig: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &adminsService.ReloadConfigRes{
+		Applied:  res.Applied,
+		Ignored:  res.Ignored,
+		Config:   conf,
+		LoadedAt: timestamppb.New(u.cfg.LoadedAt()),
+	}, nil
+}
+
+// ReloadConfig reloads the configuration of a Store and logs the settings the reload applied or ignored
+func ReloadConfig(log utilities.Logger, cfg *config.Store, trigger string) (*config.ReloadResult, error) {
+	res, err := cfg.Reload()
+	if err != nil {
+		log.Errorf("config reload on %s rejected: %v", trigger, err)
+		return nil, err
+	}
+	if len(res.Applied) > 0 {
+		log.Infof("config reload on %s applied: %s", trigger, strings.Join(res.Applied, ", "))
+	}
+	if len(res.Ignored) > 0 {
+		log.Warnf("config reload on %s ignored settings that require a restart: %s", trigger, strings.Join(res.Ignored, ", "))
+	}
+	return res, nil
+}
+
+// redactedConfigJSON encodes a Configuration as json with its secrets redacted
+func redactedConfigJSON(conf *config.Configuration) (string, error) {
+	data, err := json.Marshal(conf.Redacted())
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	auditsService "github.com/JECSand/go-grpc-server-boilerplate/protos/audit"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"time"
+)
+
+// AuditService gRPC Service
+type AuditService struct {
+	log     utilities.Logger
+	auditDB AuditDataService
+}
+
+// NewAuditService constructs an AuditService for controller gRPC service Audit requests
+func NewAuditService(log utilities.Logger, a AuditDataService) *AuditService {
+	return &AuditService{
+		log:     log,
+		auditDB: a,
+	}
+}
+
+// Find AuditEvents from an input query, scoped to the requester's group unless they are a root admin
+func (u *AuditService) Find(ctx context.Context, req *auditsService.FindReq) (*auditsService.FindRes, error) {
+	tokenClaims, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	filter := models.LoadAuditFindProto(req)
+	filter.LoadScope(tokenClaims)
+	events, err := u.auditDB.AuditsQuery(ctx, filter, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("auditDB.AuditsQuery: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &auditsService.FindRes{
+		TotalCount: events.TotalCount,
+		TotalPages: events.TotalPages,
+		Page:       events.Page,
+		Size:       events.Size,
+		HasMore:    events.HasMore,
+		Events:     events.ToProto(),
+	}, nil
+}
+
+// recordAudit appends an AuditEvent, logging rather than failing the request when the write fails
+func recordAudit(ctx context.Context, log utilities.Logger, auditDB AuditDataService, e *models.AuditEvent) {
+	if _, err := auditDB.AuditCreate(ctx, e); err != nil {
+		log.WithContext(ctx).Errorf("auditDB.AuditCreate: %v", err)
+	}
+}
+
+// RunAuditRetention periodically purges audit events older than the retention period until ctx is cancelled
+func RunAuditRetention(ctx context.Context, log utilities.Logger, auditDB AuditDataService, retention time.Duration, interval time.Duration) {
+	if retention <= 0 || interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		purged, err := auditDB.AuditPurge(ctx, time.Now().UTC().Add(-retention))
+		if err != nil {
+			log.Errorf("auditDB.AuditPurge: %v", err)
+		} else if purged > 0 {
+			log.Infof("purged %d expired audit events", purged)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
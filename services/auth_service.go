@@ -2,11 +2,10 @@ package services
 
 import (
 	"context"
-	"errors"
+	"github.com/JECSand/go-grpc-server-boilerplate/config"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	authService "github.com/JECSand/go-grpc-server-boilerplate/protos/auth"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
-	"os"
 )
 
 // AuthService gRPC Service
@@ -15,54 +14,116 @@ type AuthService struct {
 	tokenService *TokenService
 	userDB       UserDataService
 	groupDB      GroupDataService
+	membershipDB MembershipDataService
+	inviteDB     InviteDataService
+	auditDB      AuditDataService
+	bus          *EventBus
+	uow          UnitOfWork
+	cfg          *config.Store
+	quota        *QuotaChecker
 }
 
 // NewAuthService constructs a UserService for controller gRPC service User requests
-func NewAuthService(log utilities.Logger, ts *TokenService, u UserDataService, g GroupDataService) *AuthService {
+func NewAuthService(log utilities.Logger, ts *TokenService, u UserDataService, g GroupDataService, m MembershipDataService, i InviteDataService, a AuditDataService, bus *EventBus, uow UnitOfWork, cfg *config.Store, quota *QuotaChecker) *AuthService {
 	return &AuthService{
 		log:          log,
 		tokenService: ts,
 		userDB:       u,
 		groupDB:      g,
+		membershipDB: m,
+		inviteDB:     i,
+		auditDB:      a,
+		bus:          bus,
+		uow:          uow,
+		cfg:          cfg,
+		quota:        quota,
 	}
 }
 
-// Register handler function that registers a new user
+// Register handler function that registers a new user, either as the admin of a new group or, with an invite code,
+// as a member of the inviting group
 func (u *AuthService) Register(ctx context.Context, req *authService.RegisterReq) (*authService.RegisterRes, error) {
-	if os.Getenv("REGISTRATION") == "OFF" {
-		err := errors.New("not found")
-		u.log.Errorf("AuthService.Register: %v", err)
+	registration := u.cfg.Get().Server.Registration
+	if registration == "OFF" {
+		err := utilities.FailedPrecondition("REGISTRATION_DISABLED", "registration is disabled")
+		u.log.WithContext(ctx).Errorf("AuthService.Register: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if registration == "INVITE" && req.GetInviteCode() == "" {
+		err := utilities.FailedPrecondition("INVITE_REQUIRED", "registration requires an invite code")
+		u.log.WithContext(ctx).Errorf("AuthService.Register: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	user := models.LoadRegisterProto(req)
 	err := user.Validate("register")
 	if err != nil {
-		u.log.Errorf("user.Validate: %v", err)
+		u.log.WithContext(ctx).Errorf("user.Validate: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	group := &models.Group{
-		Id:        utilities.GenerateObjectID(),
-		Name:      user.Email + "_group",
-		RootAdmin: false,
-	}
-	group, err = u.groupDB.GroupCreate(group)
-	if err != nil {
-		u.log.Errorf("groupDB.GroupCreate: %v", err)
-		return nil, utilities.ErrorResponse(err, err.Error())
+	var invite *models.Invite
+	if req.GetInviteCode() != "" {
+		err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+			invite, err = u.redeemInvite(ctx, req.GetInviteCode(), user.Email)
+			if err != nil {
+				return err
+			}
+			if err = u.quota.CheckUsers(ctx, invite.GroupId); err != nil {
+				u.log.WithContext(ctx).Errorf("quota.CheckUsers: %v", err)
+				return err
+			}
+			user.Role = invite.Role
+			user.GroupId = invite.GroupId
+			user, err = u.userDB.UserCreate(ctx, user)
+			if err != nil {
+				u.log.WithContext(ctx).Errorf("userDB.UserCreate: %v", err)
+				return err
+			}
+			if err = publishEvent(ctx, u.log, u.bus, models.EventUserCreated, "user", user.Id, user.GroupId, user.Public()); err != nil {
+				return err
+			}
+			return publishEvent(ctx, u.log, u.bus, models.EventUserJoinedGroup, "user", user.Id, user.GroupId, user.Public())
+		})
+	} else {
+		group := &models.Group{
+			Id:        utilities.GenerateObjectID(),
+			Name:      user.Email + "_group",
+			RootAdmin: false,
+		}
+		err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+			group, err = u.groupDB.GroupCreate(ctx, group)
+			if err != nil {
+				u.log.WithContext(ctx).Errorf("groupDB.GroupCreate: %v", err)
+				return err
+			}
+			if err = publishEvent(ctx, u.log, u.bus, models.EventGroupCreated, "group", group.Id, group.Id, group); err != nil {
+				return err
+			}
+			user.Role = "admin"
+			user.GroupId = group.Id
+			user, err = u.userDB.UserCreate(ctx, user)
+			if err != nil {
+				u.log.WithContext(ctx).Errorf("userDB.UserCreate: %v", err)
+				return err
+			}
+			return publishEvent(ctx, u.log, u.bus, models.EventUserCreated, "user", user.Id, user.GroupId, user.Public())
+		})
 	}
-	user.Role = "admin"
-	user.GroupId = group.Id
-	user, err = u.userDB.UserCreate(user)
 	if err != nil {
-		u.log.Errorf("userDB.UserCreate: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	newToken, err := u.tokenService.GenerateToken(user, "session")
 	if err != nil {
-		u.log.Errorf("tokenService.GenerateToken: %v", err)
+		u.log.WithContext(ctx).Errorf("tokenService.GenerateToken: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	user.Password = ""
+	event := models.NewAuditEvent(ctx, models.AuditRegister, "user", user.Id, user.GroupId)
+	event.ActorId, event.ActorGroupId = user.Id, user.GroupId
+	event.Changes = models.DiffAudit(nil, user)
+	if invite != nil {
+		event.Metadata = map[string]string{"invite_id": invite.Id}
+	}
+	recordAudit(ctx, u.log, u.auditDB, event)
 	return &authService.RegisterRes{User: user.ToAuthProto(), AccessToken: newToken}, nil
 }
 
@@ -71,20 +132,27 @@ func (u *AuthService) Login(ctx context.Context, req *authService.LoginReq) (*au
 	user := models.LoadLoginProto(req)
 	err := user.Validate("login")
 	if err != nil {
-		u.log.Errorf("AuthService.Login: %v", err)
+		u.log.WithContext(ctx).Errorf("AuthService.Login: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	user, err = u.userDB.AuthenticateUser(user)
+	email := user.Email
+	user, err = u.userDB.AuthenticateUser(ctx, user)
 	if err != nil {
-		u.log.Errorf("userDB.AuthenticateUser: %v", err)
+		u.log.WithContext(ctx).Errorf("userDB.AuthenticateUser: %v", err)
+		event := models.NewAuditEvent(ctx, models.AuditLoginFailed, "user", "", "")
+		event.Metadata = map[string]string{"email": email}
+		recordAudit(ctx, u.log, u.auditDB, event)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	sessionToken, err := u.tokenService.GenerateToken(user, "session")
 	if err != nil {
-		u.log.Errorf("tokenService.GenerateToken: %v", err)
+		u.log.WithContext(ctx).Errorf("tokenService.GenerateToken: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	user.Password = ""
+	event := models.NewAuditEvent(ctx, models.AuditLogin, "user", user.Id, user.GroupId)
+	event.ActorId, event.ActorGroupId = user.Id, user.GroupId
+	recordAudit(ctx, u.log, u.auditDB, event)
 	return &authService.LoginRes{User: user.ToAuthProto(), AccessToken: sessionToken}, nil
 }
 
@@ -92,14 +160,20 @@ func (u *AuthService) Login(ctx context.Context, req *authService.LoginReq) (*au
 func (u *AuthService) Logout(ctx context.Context, req *authService.Empty) (*authService.LogoutRes, error) {
 	accessToken, err := utilities.GetTokenFromContext(ctx)
 	if err != nil {
-		u.log.Errorf("utilities.GetTokenFromContext: %v", err)
+		u.log.WithContext(ctx).Errorf("utilities.GetTokenFromContext: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	err = u.tokenService.BlacklistAuthToken(accessToken)
+	tokenClaims, err := models.DecodeJWT(accessToken)
 	if err != nil {
-		u.log.Errorf("tokenService.BlacklistAuthToken: %v", err)
+		u.log.WithContext(ctx).Errorf("models.DecodeJWT: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
+	err = u.tokenService.BlacklistAuthToken(ctx, accessToken)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("tokenService.BlacklistAuthToken: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	recordAudit(ctx, u.log, u.auditDB, models.NewAuditEvent(ctx, models.AuditLogout, "user", tokenClaims.UserId, tokenClaims.GroupId))
 	return &authService.LogoutRes{Status: 200}, nil
 }
 
@@ -107,17 +181,17 @@ func (u *AuthService) Logout(ctx context.Context, req *authService.Empty) (*auth
 func (u *AuthService) Refresh(ctx context.Context, req *authService.Empty) (*authService.RefreshRes, error) {
 	tokenClaims, err := models.LoadTokenFromContext(ctx)
 	if err != nil {
-		u.log.Errorf("models.LoadTokenFromContext: %v", err)
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	user, err := u.userDB.UserFind(tokenClaims.ToUser())
+	user, err := u.userDB.UserFind(ctx, &models.User{Id: tokenClaims.UserId})
 	if err != nil {
-		u.log.Errorf("userDB.UserFind: %v", err)
+		u.log.WithContext(ctx).Errorf("userDB.UserFind: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	sessionToken, err := u.tokenService.GenerateToken(user, "session")
+	sessionToken, _, err := u.tokenService.GenerateGroupToken(ctx, user, tokenClaims.GroupId, "session")
 	if err != nil {
-		u.log.Errorf("tokenService.GenerateToken: %v", err)
+		u.log.WithContext(ctx).Errorf("tokenService.GenerateGroupToken: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &authService.RefreshRes{AccessToken: sessionToken}, nil
@@ -127,19 +201,20 @@ func (u *AuthService) Refresh(ctx context.Context, req *authService.Empty) (*aut
 func (u *AuthService) GenerateKey(ctx context.Context, req *authService.Empty) (*authService.GenerateKeyRes, error) {
 	tokenClaims, err := models.LoadTokenFromContext(ctx)
 	if err != nil {
-		u.log.Errorf("models.LoadTokenFromContext: %v", err)
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	user, err := u.userDB.UserFind(tokenClaims.ToUser())
+	user, err := u.userDB.UserFind(ctx, &models.User{Id: tokenClaims.UserId})
 	if err != nil {
-		u.log.Errorf("userDB.UserFind: %v", err)
+		u.log.WithContext(ctx).Errorf("userDB.UserFind: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	apiKey, err := u.tokenService.GenerateToken(user, "api")
+	apiKey, _, err := u.tokenService.GenerateGroupToken(ctx, user, tokenClaims.GroupId, "api")
 	if err != nil {
-		u.log.Errorf("tokenService.GenerateToken: %v", err)
+		u.log.WithContext(ctx).Errorf("tokenService.GenerateGroupToken: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
+	recordAudit(ctx, u.log, u.auditDB, models.NewAuditEvent(ctx, models.AuditAPIKeyGenerate, "user", user.Id, tokenClaims.GroupId))
 	return &authService.GenerateKeyRes{APIKey: apiKey}, nil
 }
 
@@ -147,20 +222,112 @@ func (u *AuthService) GenerateKey(ctx context.Context, req *authService.Empty) (
 func (u *AuthService) UpdatePassword(ctx context.Context, req *authService.UpdatePasswordReq) (*authService.UpdatePasswordRes, error) {
 	tokenClaims, err := models.LoadTokenFromContext(ctx)
 	if err != nil {
-		u.log.Errorf("models.LoadTokenFromContext: %v", err)
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	pw := models.LoadPasswordUpdateProto(req)
 	err = pw.Validate()
 	if err != nil {
-		u.log.Errorf("PasswordUpdate.Validate: %v", err)
+		u.log.WithContext(ctx).Errorf("PasswordUpdate.Validate: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	user := tokenClaims.ToUser()
-	_, err = u.userDB.UpdatePassword(user, pw.CurrentPassword, pw.NewPassword)
+	_, err = u.userDB.UpdatePassword(ctx, user, pw.CurrentPassword, pw.NewPassword)
 	if err != nil {
-		u.log.Errorf("userDB.UpdatePassword: %v", err)
+		u.log.WithContext(ctx).Errorf("userDB.UpdatePassword: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
+	recordAudit(ctx, u.log, u.auditDB, models.NewAuditEvent(ctx, models.AuditPasswordUpdate, "user", user.Id, user.GroupId))
 	return &authService.UpdatePasswordRes{Status: 200}, nil
 }
+
+// SwitchGroup is the handler function that issues a session token with another group of the user active
+func (u *AuthService) SwitchGroup(ctx context.Context, req *authService.SwitchGroupReq) (*authService.SwitchGroupRes, error) {
+	tokenClaims, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	user, err := u.userDB.UserFind(ctx, &models.User{Id: tokenClaims.UserId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("userDB.UserFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	sessionToken, role, err := u.tokenService.GenerateGroupToken(ctx, user, req.GetGroupId(), "session")
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("tokenService.GenerateGroupToken: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	event := models.NewAuditEvent(ctx, models.AuditGroupSwitch, "user", user.Id, req.GetGroupId())
+	event.Metadata = map[string]string{"from_group_id": tokenClaims.GroupId}
+	recordAudit(ctx, u.log, u.auditDB, event)
+	return &authService.SwitchGroupRes{AccessToken: sessionToken, GroupId: req.GetGroupId(), Role: role}, nil
+}
+
+// AcceptInvite adds the requesting user to the group of an invite and returns a token scoped to that group
+func (u *AuthService) AcceptInvite(ctx context.Context, req *authService.AcceptInviteReq) (*authService.AcceptInviteRes, error) {
+	tokenClaims, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	user, err := u.userDB.UserFind(ctx, &models.User{Id: tokenClaims.UserId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("userDB.UserFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	var invite *models.Invite
+	var membership *models.Membership
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		invite, err = u.redeemInvite(ctx, req.GetCode(), user.Email)
+		if err != nil {
+			return err
+		}
+		if invite.GroupId == user.GroupId {
+			err = utilities.Conflict("membership", user.Id, "user is already a member of the group")
+			u.log.WithContext(ctx).Errorf("AuthService.AcceptInvite: %v", err)
+			return err
+		}
+		if err = u.quota.CheckUsers(ctx, invite.GroupId); err != nil {
+			u.log.WithContext(ctx).Errorf("quota.CheckUsers: %v", err)
+			return err
+		}
+		membership, err = u.membershipDB.MembershipCreate(ctx, &models.Membership{UserId: user.Id, GroupId: invite.GroupId, Role: invite.Role})
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("membershipDB.MembershipCreate: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventUserJoinedGroup, "user", user.Id, membership.GroupId, membership)
+	})
+	if err != nil {
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	sessionToken, role, err := u.tokenService.GenerateGroupToken(ctx, user, membership.GroupId, "session")
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("tokenService.GenerateGroupToken: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	event := models.NewAuditEvent(ctx, models.AuditInviteAccept, "user", user.Id, membership.GroupId)
+	event.Metadata = map[string]string{"invite_id": invite.Id, "role": membership.Role}
+	recordAudit(ctx, u.log, u.auditDB, event)
+	return &authService.AcceptInviteRes{AccessToken: sessionToken, GroupId: membership.GroupId, Role: role}, nil
+}
+
+// redeemInvite looks up the invite of a code, checks that a user with an email address can accept it, and counts the
+// use; it must run in the unit of work that adds the user to the group
+func (u *AuthService) redeemInvite(ctx context.Context, code string, email string) (*models.Invite, error) {
+	invite, err := u.inviteDB.InviteFind(ctx, &models.Invite{Code: code})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("inviteDB.InviteFind: %v", err)
+		return nil, err
+	}
+	if err = invite.Redeemable(email); err != nil {
+		u.log.WithContext(ctx).Errorf("invite.Redeemable: %v", err)
+		return nil, err
+	}
+	invite, err = u.inviteDB.InviteUse(ctx, invite)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("inviteDB.InviteUse: %v", err)
+	}
+	return invite, err
+}
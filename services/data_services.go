@@ -5,60 +5,135 @@ import (
 	"context"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"time"
 )
 
+// UnitOfWork is an interface to the transactions of database.DBClient
+type UnitOfWork interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 // UserDataService is an interface to database.UserService
 type UserDataService interface {
-	AuthenticateUser(u *models.User) (*models.User, error)
-	UpdatePassword(u *models.User, CurrentPassword string, newPassword string) (*models.User, error)
-	UserCreate(u *models.User) (*models.User, error)
-	UserDelete(u *models.User) (*models.User, error)
-	UserDeleteMany(u *models.User) (*models.User, error)
-	UsersFind(u *models.User) ([]*models.User, error)
-	UserFind(u *models.User) (*models.User, error)
-	UserUpdate(u *models.User) (*models.User, error)
-	UserDocInsert(u *models.User) (*models.User, error)
+	AuthenticateUser(ctx context.Context, u *models.User) (*models.User, error)
+	UpdatePassword(ctx context.Context, u *models.User, CurrentPassword string, newPassword string) (*models.User, error)
+	UserCreate(ctx context.Context, u *models.User) (*models.User, error)
+	UserDelete(ctx context.Context, u *models.User) (*models.User, error)
+	UserDeleteMany(ctx context.Context, u *models.User) (*models.User, error)
+	UsersFind(ctx context.Context, u *models.User) ([]*models.User, error)
+	UserFind(ctx context.Context, u *models.User) (*models.User, error)
+	UserUpdate(ctx context.Context, u *models.User) (*models.User, error)
+	UserDocInsert(ctx context.Context, u *models.User) (*models.User, error)
 	UsersQuery(ctx context.Context, u *models.User, pagination *utilities.Pagination) (*models.UsersRes, error)
+	UsersCount(ctx context.Context, u *models.User) (int64, error)
 }
 
 // GroupDataService is an interface to database.GroupService
 type GroupDataService interface {
-	GroupCreate(g *models.Group) (*models.Group, error)
-	GroupFind(g *models.Group) (*models.Group, error)
-	GroupsFind(g *models.Group) ([]*models.Group, error)
-	GroupDelete(g *models.Group) (*models.Group, error)
-	GroupDeleteMany(g *models.Group) (*models.Group, error)
-	GroupUpdate(g *models.Group) (*models.Group, error)
-	GroupDocInsert(g *models.Group) (*models.Group, error)
+	GroupCreate(ctx context.Context, g *models.Group) (*models.Group, error)
+	GroupFind(ctx context.Context, g *models.Group) (*models.Group, error)
+	GroupsFind(ctx context.Context, g *models.Group) ([]*models.Group, error)
+	GroupDelete(ctx context.Context, g *models.Group) (*models.Group, error)
+	GroupDeleteMany(ctx context.Context, g *models.Group) (*models.Group, error)
+	GroupUpdate(ctx context.Context, g *models.Group) (*models.Group, error)
+	GroupUpdateSettings(ctx context.Context, g *models.Group) (*models.Group, error)
+	GroupMove(ctx context.Context, g *models.Group) (*models.Group, error)
+	GroupAncestors(ctx context.Context, g *models.Group) ([]*models.Group, error)
+	GroupDescendants(ctx context.Context, g *models.Group) ([]*models.Group, error)
+	GroupDocInsert(ctx context.Context, g *models.Group) (*models.Group, error)
 	GroupsQuery(ctx context.Context, g *models.Group, pagination *utilities.Pagination) (*models.GroupsRes, error)
 }
 
+// MembershipDataService is an interface to database.MembershipService
+type MembershipDataService interface {
+	MembershipCreate(ctx context.Context, g *models.Membership) (*models.Membership, error)
+	MembershipFind(ctx context.Context, g *models.Membership) (*models.Membership, error)
+	MembershipsFind(ctx context.Context, g *models.Membership) ([]*models.Membership, error)
+	MembershipUpdate(ctx context.Context, g *models.Membership) (*models.Membership, error)
+	MembershipDelete(ctx context.Context, g *models.Membership) (*models.Membership, error)
+	MembershipDeleteMany(ctx context.Context, g *models.Membership) (*models.Membership, error)
+}
+
+// InviteDataService is an interface to database.InviteService
+type InviteDataService interface {
+	InviteCreate(ctx context.Context, g *models.Invite) (*models.Invite, error)
+	InviteFind(ctx context.Context, g *models.Invite) (*models.Invite, error)
+	InviteUse(ctx context.Context, g *models.Invite) (*models.Invite, error)
+	InviteDelete(ctx context.Context, g *models.Invite) (*models.Invite, error)
+	InviteDeleteMany(ctx context.Context, g *models.Invite) (*models.Invite, error)
+	InvitesQuery(ctx context.Context, g *models.Invite, pagination *utilities.Pagination) (*models.InvitesRes, error)
+}
+
 // TaskDataService is an interface to database.TaskService
 type TaskDataService interface {
-	TaskCreate(g *models.Task) (*models.Task, error)
-	TaskFind(g *models.Task) (*models.Task, error)
-	TasksFind(g *models.Task) ([]*models.Task, error)
-	TaskDelete(g *models.Task) (*models.Task, error)
-	TaskDeleteMany(g *models.Task) (*models.Task, error)
-	TaskUpdate(g *models.Task) (*models.Task, error)
-	TaskDocInsert(g *models.Task) (*models.Task, error)
+	TaskCreate(ctx context.Context, g *models.Task) (*models.Task, error)
+	TaskFind(ctx context.Context, g *models.Task) (*models.Task, error)
+	TasksFind(ctx context.Context, g *models.Task) ([]*models.Task, error)
+	TaskDelete(ctx context.Context, g *models.Task) (*models.Task, error)
+	TaskDeleteMany(ctx context.Context, g *models.Task) (*models.Task, error)
+	TaskUpdate(ctx context.Context, g *models.Task) (*models.Task, error)
+	TaskDocInsert(ctx context.Context, g *models.Task) (*models.Task, error)
 	TasksQuery(ctx context.Context, g *models.Task, pagination *utilities.Pagination) (*models.TasksRes, error)
+	TasksCount(ctx context.Context, g *models.Task) (int64, error)
+	TasksWatch(ctx context.Context, resumeToken string) (<-chan *models.TaskChange, <-chan error, error)
+}
+
+// CommentDataService is an interface to database.CommentService
+type CommentDataService interface {
+	CommentCreate(ctx context.Context, g *models.Comment) (*models.Comment, error)
+	CommentFind(ctx context.Context, g *models.Comment) (*models.Comment, error)
+	CommentUpdate(ctx context.Context, g *models.Comment) (*models.Comment, error)
+	CommentDelete(ctx context.Context, g *models.Comment) (*models.Comment, error)
+	CommentDeleteMany(ctx context.Context, g *models.Comment) (*models.Comment, error)
+	CommentsQuery(ctx context.Context, g *models.Comment, pagination *utilities.Pagination) (*models.CommentsRes, error)
+	ActivityCreate(ctx context.Context, a *models.Activity) (*models.Activity, error)
+	ActivityDeleteMany(ctx context.Context, a *models.Activity) (*models.Activity, error)
+	ActivitiesQuery(ctx context.Context, a *models.Activity, pagination *utilities.Pagination) (*models.ActivitiesRes, error)
 }
 
 // FileDataService is an interface to database.FileService
 type FileDataService interface {
-	FileCreate(g *models.File, content []byte) (*models.File, error)
-	FileFind(g *models.File) (*models.File, error)
-	FilesFind(g *models.File) ([]*models.File, error)
-	FileDelete(g *models.File) (*models.File, error)
-	FileDeleteMany(g []*models.File) error
-	FileUpdate(g *models.File, content []byte) (*models.File, error)
-	RetrieveFile(g *models.File) (*bytes.Buffer, error)
+	FileCreate(ctx context.Context, g *models.File, content []byte) (*models.File, error)
+	FileFind(ctx context.Context, g *models.File) (*models.File, error)
+	FilesFind(ctx context.Context, g *models.File) ([]*models.File, error)
+	FileDelete(ctx context.Context, g *models.File) (*models.File, error)
+	FileDeleteMany(ctx context.Context, g []*models.File) error
+	FileUpdate(ctx context.Context, g *models.File, content []byte) (*models.File, error)
+	RetrieveFile(ctx context.Context, g *models.File) (*bytes.Buffer, error)
 	FilesQuery(ctx context.Context, g *models.File, pagination *utilities.Pagination) (*models.FilesRes, error)
 }
 
 // BlacklistDataService is an interface to database.BlacklistService
 type BlacklistDataService interface {
-	BlacklistAuthToken(authToken string) error
-	CheckTokenBlacklist(authToken string) bool
+	BlacklistAuthToken(ctx context.Context, authToken string) error
+	CheckTokenBlacklist(ctx context.Context, authToken string) bool
+}
+
+// AuditDataService is an interface to database.AuditService
+type AuditDataService interface {
+	AuditCreate(ctx context.Context, e *models.AuditEvent) (*models.AuditEvent, error)
+	AuditsQuery(ctx context.Context, f *models.AuditFilter, pagination *utilities.Pagination) (*models.AuditsRes, error)
+	AuditPurge(ctx context.Context, before time.Time) (int64, error)
+}
+
+// OutboxDataService is an interface to database.OutboxService
+type OutboxDataService interface {
+	OutboxCreate(ctx context.Context, e *models.DomainEvent) (*models.DomainEvent, error)
+	OutboxPending(ctx context.Context, limit int64) ([]*models.DomainEvent, error)
+	OutboxUpdate(ctx context.Context, e *models.DomainEvent) (*models.DomainEvent, error)
+}
+
+// WebhookDataService is an interface to database.WebhookService
+type WebhookDataService interface {
+	WebhookCreate(ctx context.Context, g *models.Webhook) (*models.Webhook, error)
+	WebhookFind(ctx context.Context, g *models.Webhook) (*models.Webhook, error)
+	WebhooksFind(ctx context.Context, g *models.Webhook) ([]*models.Webhook, error)
+	WebhookUpdate(ctx context.Context, g *models.Webhook) (*models.Webhook, error)
+	WebhookDelete(ctx context.Context, g *models.Webhook) (*models.Webhook, error)
+	WebhooksQuery(ctx context.Context, g *models.Webhook, pagination *utilities.Pagination) (*models.WebhooksRes, error)
+	DeliveryCreate(ctx context.Context, d *models.WebhookDelivery) (*models.WebhookDelivery, error)
+	DeliveryFind(ctx context.Context, d *models.WebhookDelivery) (*models.WebhookDelivery, error)
+	DeliveryUpdate(ctx context.Context, d *models.WebhookDelivery) (*models.WebhookDelivery, error)
+	DeliveriesDue(ctx context.Context, now time.Time, limit int64) ([]*models.WebhookDelivery, error)
+	DeliveriesQuery(ctx context.Context, d *models.WebhookDelivery, pagination *utilities.Pagination) (*models.DeliveriesRes, error)
 }
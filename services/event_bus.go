@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"sync"
+	"time"
+)
+
+// outboxBatchSize is the maximum number of outbox events relayed per dispatch
+const outboxBatchSize = 100
+
+// EventHandler consumes a dispatched DomainEvent
+type EventHandler func(ctx context.Context, e *models.DomainEvent) error
+
+// EventBus persists DomainEvents to an outbox and relays them to subscribed EventHandlers
+type EventBus struct {
+	log      utilities.Logger
+	outboxDB OutboxDataService
+	mu       sync.RWMutex
+	handlers map[string][]EventHandler
+}
+
+// NewEventBus constructs an EventBus backed by an outbox collection
+func NewEventBus(log utilities.Logger, o OutboxDataService) *EventBus {
+	return &EventBus{
+		log:      log,
+		outboxDB: o,
+		handlers: make(map[string][]EventHandler),
+	}
+}
+
+// Subscribe registers an EventHandler for an event type, or for every event type with models.EventAll
+func (b *EventBus) Subscribe(eventType string, h EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], h)
+}
+
+// Publish persists a DomainEvent to the outbox to be relayed on the next dispatch
+func (b *EventBus) Publish(ctx context.Context, e *models.DomainEvent) (*models.DomainEvent, error) {
+	return b.outboxDB.OutboxCreate(ctx, e)
+}
+
+// subscribers returns the EventHandlers registered for an event type
+func (b *EventBus) subscribers(eventType string) []EventHandler {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	hs := make([]EventHandler, 0, len(b.handlers[eventType])+len(b.handlers[models.EventAll]))
+	hs = append(hs, b.handlers[eventType]...)
+	return append(hs, b.handlers[models.EventAll]...)
+}
+
+// Dispatch relays pending outbox events to their subscribers and returns how many were dispatched
+func (b *EventBus) Dispatch(ctx context.Context) (int, error) {
+	events, err := b.outboxDB.OutboxPending(ctx, outboxBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	dispatched := 0
+	for _, e := range events {
+		e.Attempts++
+		e.LastError = ""
+		for _, h := range b.subscribers(e.Type) {
+			if hErr := h(ctx, e); hErr != nil {
+				e.LastError = hErr.Error()
+			}
+		}
+		if e.LastError == "" {
+			e.Status = models.OutboxDispatched
+			e.DispatchedAt = time.Now().UTC()
+			dispatched++
+		} else {
+			b.log.Errorf("EventBus.Dispatch %s %s: %s", e.Type, e.Id, e.LastError)
+		}
+		if _, err = b.outboxDB.OutboxUpdate(ctx, e); err != nil {
+			return dispatched, err
+		}
+	}
+	return dispatched, nil
+}
+
+// Run dispatches pending outbox events every interval until ctx is cancelled
+func (b *EventBus) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := b.Dispatch(ctx); err != nil {
+			b.log.Errorf("EventBus.Dispatch: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publishEvent records a DomainEvent on the bus. It runs in the unit of work of the change the event describes, so that
+// the event is only relayed when the change commits and the change fails when the event cannot be recorded
+func publishEvent(ctx context.Context, log utilities.Logger, bus *EventBus, eventType string, aggregateType string, aggregateId string, groupId string, payload interface{}) error {
+	if bus == nil {
+		return nil
+	}
+	e, err := models.NewDomainEvent(ctx, eventType, aggregateType, aggregateId, groupId, payload)
+	if err == nil {
+		_, err = bus.Publish(ctx, e)
+	}
+	if err != nil {
+		log.WithContext(ctx).Errorf("EventBus.Publish %s: %v", eventType, err)
+	}
+	return err
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	groupsService "github.com/JECSand/go-grpc-server-boilerplate/protos/group"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"strconv"
+	"strings"
 )
 
 // GroupService gRPC Service
@@ -14,19 +16,33 @@ type GroupService struct {
 	tokenService *TokenService
 	userDB       UserDataService
 	groupDB      GroupDataService
+	membershipDB MembershipDataService
+	inviteDB     InviteDataService
 	taskDB       TaskDataService
 	fileDB       FileDataService
+	commentDB    CommentDataService
+	auditDB      AuditDataService
+	bus          *EventBus
+	uow          UnitOfWork
+	quota        *QuotaChecker
 }
 
 // NewGroupService constructs a GroupService for controller gRPC service Group requests
-func NewGroupService(log utilities.Logger, ts *TokenService, u UserDataService, g GroupDataService, t TaskDataService, f FileDataService) *GroupService {
+func NewGroupService(log utilities.Logger, ts *TokenService, u UserDataService, g GroupDataService, m MembershipDataService, i InviteDataService, t TaskDataService, f FileDataService, c CommentDataService, a AuditDataService, bus *EventBus, uow UnitOfWork, quota *QuotaChecker) *GroupService {
 	return &GroupService{
 		log:          log,
 		tokenService: ts,
 		userDB:       u,
 		groupDB:      g,
+		membershipDB: m,
+		inviteDB:     i,
 		taskDB:       t,
 		fileDB:       f,
+		commentDB:    c,
+		auditDB:      a,
+		bus:          bus,
+		uow:          uow,
+		quota:        quota,
 	}
 }
 
@@ -35,9 +51,20 @@ func (u *GroupService) Create(ctx context.Context, req *groupsService.CreateReq)
 	group := models.LoadGroupCreateProto(req)
 	group.Id = utilities.GenerateObjectID()
 	group.RootAdmin = false
-	group, err := u.groupDB.GroupCreate(group)
+	err := u.checkParent(ctx, group, nil)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("GroupService.checkParent: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		group, err = u.groupDB.GroupCreate(ctx, group)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("groupDB.GroupCreate: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventGroupCreated, "group", group.Id, group.Id, group)
+	})
 	if err != nil {
-		u.log.Errorf("groupDB.GroupCreate: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &groupsService.CreateRes{Group: group.ToProto()}, nil
@@ -46,20 +73,26 @@ func (u *GroupService) Create(ctx context.Context, req *groupsService.CreateReq)
 // Update a Group
 func (u *GroupService) Update(ctx context.Context, req *groupsService.UpdateReq) (*groupsService.UpdateRes, error) {
 	if !utilities.CheckObjectID(req.GetId()) {
-		err := errors.New(req.GetId() + " is an invalid groupId")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument(req.GetId() + " is an invalid groupId")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	group := models.LoadGroupUpdateProto(req)
-	groupId, err := models.VerifyGroupRequestScope(ctx, group.Id)
+	groupId, err := models.VerifyGroupAdminScope(ctx, group.Id)
 	if err != nil {
-		u.log.Errorf("models.VerifyGroupRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	group.Id = groupId
-	group, err = u.groupDB.GroupUpdate(group)
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		group, err = u.groupDB.GroupUpdate(ctx, group)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("groupDB.GroupUpdate: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventGroupUpdated, "group", group.Id, group.Id, group)
+	})
 	if err != nil {
-		u.log.Errorf("groupDB.GroupUpdate: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &groupsService.UpdateRes{Group: group.ToProto()}, nil
@@ -68,18 +101,18 @@ func (u *GroupService) Update(ctx context.Context, req *groupsService.UpdateReq)
 // Get a specific Group
 func (u *GroupService) Get(ctx context.Context, req *groupsService.GetReq) (*groupsService.GetRes, error) {
 	if !utilities.CheckObjectID(req.GetId()) {
-		err := errors.New(req.GetId() + " is an invalid groupId")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument(req.GetId() + " is an invalid groupId")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	groupId, err := models.VerifyGroupRequestScope(ctx, req.GetId())
 	if err != nil {
-		u.log.Errorf("models.VerifyGroupRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupRequestScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	group, err := u.groupDB.GroupFind(&models.Group{Id: groupId})
+	group, err := u.groupDB.GroupFind(ctx, &models.Group{Id: groupId})
 	if err != nil {
-		u.log.Errorf("groupDB.GroupFind: %v", err)
+		u.log.WithContext(ctx).Errorf("groupDB.GroupFind: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &groupsService.GetRes{Group: group.ToProto()}, nil
@@ -87,10 +120,21 @@ func (u *GroupService) Get(ctx context.Context, req *groupsService.GetReq) (*gro
 
 // Find Groups from an input query
 func (u *GroupService) Find(ctx context.Context, req *groupsService.FindReq) (*groupsService.FindRes, error) {
-	// TODO NEXT FIX - valid req.GetQuery() authenticity / scope
-	groups, err := u.groupDB.GroupsQuery(ctx, models.LoadGroupFindProto(req), utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	filter := models.LoadGroupFindProto(req)
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	pagination := utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	var groups *models.GroupsRes
+	if tokenData.RootAdmin {
+		groups, err = u.groupDB.GroupsQuery(ctx, filter, pagination)
+	} else {
+		groups, err = u.findMemberGroups(ctx, tokenData, filter, pagination)
+	}
 	if err != nil {
-		u.log.Errorf("groupDB.GroupsQuery: %v", err)
+		u.log.WithContext(ctx).Errorf("GroupService.findMemberGroups: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &groupsService.FindRes{
@@ -103,85 +147,561 @@ func (u *GroupService) Find(ctx context.Context, req *groupsService.FindReq) (*g
 	}, nil
 }
 
-// Delete is the handler function that deletes a group
+// findMemberGroups finds the Groups of a query that the requester is a member of
+func (u *GroupService) findMemberGroups(ctx context.Context, tokenData *models.TokenData, filter *models.Group, pagination *utilities.Pagination) (*models.GroupsRes, error) {
+	found, err := u.groupDB.GroupsFind(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var groups []*models.Group
+	for _, g := range found {
+		if tokenData.GroupRole(g.Id) != "" {
+			groups = append(groups, g)
+		}
+	}
+	return models.NewGroupsRes(groups, pagination), nil
+}
+
+// Delete is the handler function that deletes a group along with every group nested within it; a group can be deleted
+// by an admin of its parent group, and a top level group by a root admin
 func (u *GroupService) Delete(ctx context.Context, req *groupsService.DeleteReq) (*groupsService.DeleteRes, error) {
 	if !utilities.CheckObjectID(req.GetId()) {
-		err := errors.New("invalid group id")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument("invalid group id")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	groupUsers, err := u.getGroupUsers(req.GetId())
+	group, err := u.groupDB.GroupFind(ctx, &models.Group{Id: req.GetId()})
 	if err != nil {
-		u.log.Errorf("GroupService.getGroupUsers: %v", err)
+		u.log.WithContext(ctx).Errorf("groupDB.GroupFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if err = verifyParentScope(ctx, group.ParentId); err != nil {
+		u.log.WithContext(ctx).Errorf("GroupService.verifyParentScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	err = u.deleteGroupAssets(groupUsers.Group, groupUsers.Users)
+	descendants, err := u.groupDB.GroupDescendants(ctx, group)
 	if err != nil {
-		u.log.Errorf("GroupService.deleteGroupAssets: %v", err)
+		u.log.WithContext(ctx).Errorf("groupDB.GroupDescendants: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	group, err := u.groupDB.GroupDelete(&models.Group{Id: req.GetId()})
+	// the deepest groups are deleted first, and the requested group last
+	cascade := make([]*models.GroupUsers, 0, len(descendants)+1)
+	for i := len(descendants) - 1; i >= -1; i-- {
+		groupId := req.GetId()
+		if i >= 0 {
+			groupId = descendants[i].Id
+		}
+		groupUsers, err := u.getGroupUsers(ctx, groupId)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("GroupService.getGroupUsers: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+		cascade = append(cascade, groupUsers)
+	}
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		for _, groupUsers := range cascade {
+			err = u.deleteGroupAssets(ctx, groupUsers.Group, groupUsers.Users)
+			if err != nil {
+				u.log.WithContext(ctx).Errorf("GroupService.deleteGroupAssets: %v", err)
+				return err
+			}
+			group, err = u.groupDB.GroupDelete(ctx, &models.Group{Id: groupUsers.Group.Id})
+			if err != nil {
+				u.log.WithContext(ctx).Errorf("groupDB.GroupDelete: %v", err)
+				return err
+			}
+			err = publishEvent(ctx, u.log, u.bus, models.EventGroupDeleted, "group", groupUsers.Group.Id, groupUsers.Group.Id, groupUsers.Group)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 	if err != nil {
-		u.log.Errorf("groupDB.GroupDelete: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
+	var userIds, groupIds []string
+	for _, groupUsers := range cascade {
+		for _, gu := range groupUsers.Users {
+			userIds = append(userIds, gu.Id)
+		}
+		if groupUsers.Group.Id != group.Id {
+			groupIds = append(groupIds, groupUsers.Group.Id)
+		}
+	}
+	event := models.NewAuditEvent(ctx, models.AuditGroupDelete, "group", group.Id, group.Id)
+	event.Changes = models.DiffAudit(cascade[len(cascade)-1].Group, nil)
+	event.Metadata = map[string]string{
+		"cascade_user_count":  strconv.Itoa(len(userIds)),
+		"cascade_user_ids":    strings.Join(userIds, ","),
+		"cascade_group_count": strconv.Itoa(len(groupIds)),
+		"cascade_group_ids":   strings.Join(groupIds, ","),
+	}
+	recordAudit(ctx, u.log, u.auditDB, event)
 	return &groupsService.DeleteRes{Group: group.ToProto()}, nil
 }
 
-// deleteGroupAssets asynchronously gets a group and its users from the database
-func (u *GroupService) deleteGroupAssets(group *models.Group, users []*models.User) error {
+// GetSettings returns the settings of a Group to its members
+func (u *GroupService) GetSettings(ctx context.Context, req *groupsService.GetSettingsReq) (*groupsService.GetSettingsRes, error) {
+	groupId, err := models.VerifyGroupRequestScope(ctx, req.GetId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupRequestScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	group, err := u.groupDB.GroupFind(ctx, &models.Group{Id: groupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("groupDB.GroupFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &groupsService.GetSettingsRes{Settings: group.Settings.ToProto()}, nil
+}
+
+// UpdateSettings replaces the settings of a Group; settings left unset are removed
+func (u *GroupService) UpdateSettings(ctx context.Context, req *groupsService.UpdateSettingsReq) (*groupsService.UpdateSettingsRes, error) {
+	groupId, err := models.VerifyGroupAdminScope(ctx, req.GetId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	settings := models.LoadGroupSettingsProto(req.GetSettings())
+	if err = settings.Validate(); err != nil {
+		u.log.WithContext(ctx).Errorf("settings.Validate: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	before, err := u.groupDB.GroupFind(ctx, &models.Group{Id: groupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("groupDB.GroupFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	var group *models.Group
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		group, err = u.groupDB.GroupUpdateSettings(ctx, &models.Group{Id: groupId, Settings: settings})
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("groupDB.GroupUpdateSettings: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventGroupUpdated, "group", group.Id, group.Id, group)
+	})
+	if err != nil {
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	event := models.NewAuditEvent(ctx, models.AuditGroupSettings, "group", group.Id, group.Id)
+	event.Changes = models.DiffAudit(before, group)
+	recordAudit(ctx, u.log, u.auditDB, event)
+	return &groupsService.UpdateSettingsRes{Settings: group.Settings.ToProto()}, nil
+}
+
+// Move nests a Group within another parent Group, or makes it a top level group when no parent is given. The requester
+// must be an admin of both the group and its new parent; only a root admin can move a group to the top level.
+func (u *GroupService) Move(ctx context.Context, req *groupsService.MoveReq) (*groupsService.MoveRes, error) {
+	groupId, err := models.VerifyGroupAdminScope(ctx, req.GetId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	group, err := u.groupDB.GroupFind(ctx, &models.Group{Id: groupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("groupDB.GroupFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	descendants, err := u.groupDB.GroupDescendants(ctx, group)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("groupDB.GroupDescendants: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	before := *group
+	group.ParentId = req.GetParentId()
+	if err = u.checkParent(ctx, group, descendants); err != nil {
+		u.log.WithContext(ctx).Errorf("GroupService.checkParent: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		group, err = u.groupDB.GroupMove(ctx, group)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("groupDB.GroupMove: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventGroupUpdated, "group", group.Id, group.Id, group)
+	})
+	if err != nil {
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	event := models.NewAuditEvent(ctx, models.AuditGroupMove, "group", group.Id, group.Id)
+	event.Changes = models.DiffAudit(&before, group)
+	recordAudit(ctx, u.log, u.auditDB, event)
+	return &groupsService.MoveRes{Group: group.ToProto()}, nil
+}
+
+// ListDescendants lists the Groups nested within a Group, at any depth
+func (u *GroupService) ListDescendants(ctx context.Context, req *groupsService.ListDescendantsReq) (*groupsService.ListDescendantsRes, error) {
+	groupId, err := models.VerifyGroupRequestScope(ctx, req.GetId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupRequestScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	descendants, err := u.groupDB.GroupDescendants(ctx, &models.Group{Id: groupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("groupDB.GroupDescendants: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	groups := models.NewGroupsRes(descendants, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	return &groupsService.ListDescendantsRes{
+		TotalCount: groups.TotalCount,
+		TotalPages: groups.TotalPages,
+		Page:       groups.Page,
+		Size:       groups.Size,
+		HasMore:    groups.HasMore,
+		Groups:     groups.ToProto(),
+	}, nil
+}
+
+// AddMember adds a User to a Group other than its primary group, with a role
+func (u *GroupService) AddMember(ctx context.Context, req *groupsService.AddMemberReq) (*groupsService.AddMemberRes, error) {
+	membership := models.LoadAddMemberProto(req)
+	groupId, err := models.VerifyGroupAdminScope(ctx, membership.GroupId)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	group, err := u.groupDB.GroupFind(ctx, &models.Group{Id: groupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("groupDB.GroupFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	user, err := u.userDB.UserFind(ctx, &models.User{Id: membership.UserId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("userDB.UserFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if user.GroupId == group.Id {
+		err = utilities.Conflict("membership", user.Id, "user is already a member of the group")
+		u.log.WithContext(ctx).Errorf("GroupService.AddMember: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if err = u.quota.CheckUsers(ctx, group.Id); err != nil {
+		u.log.WithContext(ctx).Errorf("quota.CheckUsers: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		membership, err = u.membershipDB.MembershipCreate(ctx, membership)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("membershipDB.MembershipCreate: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventUserJoinedGroup, "user", user.Id, group.Id, membership)
+	})
+	if err != nil {
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	event := models.NewAuditEvent(ctx, models.AuditMemberAdd, "user", user.Id, group.Id)
+	event.Metadata = map[string]string{"role": membership.Role}
+	recordAudit(ctx, u.log, u.auditDB, event)
+	return &groupsService.AddMemberRes{Member: membership.ToProto()}, nil
+}
+
+// RemoveMember removes a User from a Group other than its primary group; a group admin may remove any member, and a
+// member may leave
+func (u *GroupService) RemoveMember(ctx context.Context, req *groupsService.RemoveMemberReq) (*groupsService.RemoveMemberRes, error) {
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if tokenData.UserId != req.GetUserId() {
+		if _, err = models.VerifyGroupAdminScope(ctx, req.GetGroupId()); err != nil {
+			u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+	}
+	user, err := u.userDB.UserFind(ctx, &models.User{Id: req.GetUserId()})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("userDB.UserFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if user.GroupId == req.GetGroupId() {
+		err = utilities.FailedPrecondition("PRIMARY_GROUP", "a user cannot be removed from its primary group")
+		u.log.WithContext(ctx).Errorf("GroupService.RemoveMember: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if err = checkLastAdmin(ctx, u.userDB, u.membershipDB, user.Id, req.GetGroupId()); err != nil {
+		u.log.WithContext(ctx).Errorf("GroupService.checkLastAdmin: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	membership, err := u.membershipDB.MembershipDelete(ctx, &models.Membership{UserId: user.Id, GroupId: req.GetGroupId()})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("membershipDB.MembershipDelete: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	event := models.NewAuditEvent(ctx, models.AuditMemberRemove, "user", user.Id, membership.GroupId)
+	event.Metadata = map[string]string{"role": membership.Role}
+	recordAudit(ctx, u.log, u.auditDB, event)
+	return &groupsService.RemoveMemberRes{Member: membership.ToProto()}, nil
+}
+
+// ListMembers lists the members of a Group, both the users of which it is the primary group and the users added to it
+func (u *GroupService) ListMembers(ctx context.Context, req *groupsService.ListMembersReq) (*groupsService.ListMembersRes, error) {
+	groupId, err := models.VerifyGroupRequestScope(ctx, req.GetGroupId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupRequestScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	users, err := u.userDB.UsersFind(ctx, &models.User{GroupId: groupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("userDB.UsersFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	memberships, err := u.membershipDB.MembershipsFind(ctx, &models.Membership{GroupId: groupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("membershipDB.MembershipsFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	for _, gu := range users {
+		memberships = append(memberships, models.PrimaryMembership(gu))
+	}
+	members := models.NewMembershipsRes(memberships, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	return &groupsService.ListMembersRes{
+		TotalCount: members.TotalCount,
+		TotalPages: members.TotalPages,
+		Page:       members.Page,
+		Size:       members.Size,
+		HasMore:    members.HasMore,
+		Members:    members.ToProto(),
+	}, nil
+}
+
+// TransferAdmin makes a member of a Group its admin in place of the requester, who becomes a member, so that the last
+// admin of a group can hand it over before leaving
+func (u *GroupService) TransferAdmin(ctx context.Context, req *groupsService.TransferAdminReq) (*groupsService.TransferAdminRes, error) {
+	groupId, err := models.VerifyGroupAdminScope(ctx, req.GetGroupId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if tokenData.UserId == req.GetUserId() {
+		err = utilities.InvalidField("user_id", "the admin role must be transferred to another user")
+		u.log.WithContext(ctx).Errorf("GroupService.TransferAdmin: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	var member *models.Membership
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		member, err = u.setGroupRole(ctx, req.GetUserId(), groupId, "admin")
+		if err != nil {
+			return err
+		}
+		_, err = u.setGroupRole(ctx, tokenData.UserId, groupId, "member")
+		if errors.Is(err, utilities.ErrNotFound) { // a root admin or an admin of a parent group holds no role here
+			err = nil
+		}
+		return err
+	})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("GroupService.setGroupRole: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	event := models.NewAuditEvent(ctx, models.AuditAdminTransfer, "user", member.UserId, groupId)
+	event.Metadata = map[string]string{"from_user_id": tokenData.UserId}
+	recordAudit(ctx, u.log, u.auditDB, event)
+	return &groupsService.TransferAdminRes{Member: member.ToProto()}, nil
+}
+
+// CreateInvite issues an Invite to join a Group, either to an email address or as a code anyone can accept
+func (u *GroupService) CreateInvite(ctx context.Context, req *groupsService.CreateInviteReq) (*groupsService.CreateInviteRes, error) {
+	invite := models.LoadCreateInviteProto(req)
+	groupId, err := models.VerifyGroupAdminScope(ctx, invite.GroupId)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	group, err := u.groupDB.GroupFind(ctx, &models.Group{Id: groupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("groupDB.GroupFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if tokenData, err := models.LoadTokenFromContext(ctx); err == nil {
+		invite.CreatedBy = tokenData.UserId
+	}
+	invite.GroupId = group.Id
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		invite, err = u.inviteDB.InviteCreate(ctx, invite)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("inviteDB.InviteCreate: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventInviteCreated, "invite", invite.Id, group.Id, invite)
+	})
+	if err != nil {
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	event := models.NewAuditEvent(ctx, models.AuditInviteCreate, "invite", invite.Id, group.Id)
+	event.Metadata = map[string]string{"role": invite.Role, "email": invite.Email}
+	recordAudit(ctx, u.log, u.auditDB, event)
+	return &groupsService.CreateInviteRes{Invite: invite.ToProto()}, nil
+}
+
+// ListInvites lists the Invites of a Group, without their codes
+func (u *GroupService) ListInvites(ctx context.Context, req *groupsService.ListInvitesReq) (*groupsService.ListInvitesRes, error) {
+	groupId, err := models.VerifyGroupAdminScope(ctx, req.GetGroupId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	pagination := utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	invites, err := u.inviteDB.InvitesQuery(ctx, &models.Invite{GroupId: groupId}, pagination)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("inviteDB.InvitesQuery: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &groupsService.ListInvitesRes{
+		TotalCount: invites.TotalCount,
+		TotalPages: invites.TotalPages,
+		Page:       invites.Page,
+		Size:       invites.Size,
+		HasMore:    invites.HasMore,
+		Invites:    invites.ToProto(),
+	}, nil
+}
+
+// RevokeInvite deletes an Invite of a Group so that it can no longer be accepted
+func (u *GroupService) RevokeInvite(ctx context.Context, req *groupsService.RevokeInviteReq) (*groupsService.RevokeInviteRes, error) {
+	groupId, err := models.VerifyGroupAdminScope(ctx, req.GetGroupId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	invite, err := u.inviteDB.InviteFind(ctx, &models.Invite{Id: req.GetId()})
+	if err == nil && invite.GroupId != groupId {
+		err = utilities.NotFound("invite", req.GetId())
+	}
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("inviteDB.InviteFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	invite, err = u.inviteDB.InviteDelete(ctx, invite)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("inviteDB.InviteDelete: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	recordAudit(ctx, u.log, u.auditDB, models.NewAuditEvent(ctx, models.AuditInviteRevoke, "invite", invite.Id, groupId))
+	return &groupsService.RevokeInviteRes{Invite: invite.ToProto()}, nil
+}
+
+// setGroupRole sets the role of a User in a Group, through its primary group or its membership of the group
+func (u *GroupService) setGroupRole(ctx context.Context, userId string, groupId string, role string) (*models.Membership, error) {
+	user, err := u.userDB.UserFind(ctx, &models.User{Id: userId})
+	if err != nil {
+		return nil, err
+	}
+	if user.GroupId == groupId {
+		user, err = u.userDB.UserUpdate(ctx, &models.User{Id: user.Id, Role: role})
+		if err != nil {
+			return nil, err
+		}
+		return models.PrimaryMembership(user), nil
+	}
+	return u.membershipDB.MembershipUpdate(ctx, &models.Membership{UserId: user.Id, GroupId: groupId, Role: role})
+}
+
+// checkParent verifies that the requester may nest a group within its ParentId, and that doing so keeps the hierarchy
+// free of cycles and within the maximum depth
+func (u *GroupService) checkParent(ctx context.Context, group *models.Group, descendants []*models.Group) error {
+	if err := verifyParentScope(ctx, group.ParentId); err != nil {
+		return err
+	}
+	if group.ParentId == "" {
+		return group.CheckMove(nil, nil, descendants)
+	}
+	parent, err := u.groupDB.GroupFind(ctx, &models.Group{Id: group.ParentId})
+	if err != nil {
+		return err
+	}
+	ancestors, err := u.groupDB.GroupAncestors(ctx, parent)
+	if err != nil {
+		return err
+	}
+	return group.CheckMove(parent, ancestors, descendants)
+}
+
+// verifyParentScope checks that the requester is an admin of a parent group, or a root admin when there is no parent
+func verifyParentScope(ctx context.Context, parentId string) error {
+	if parentId != "" {
+		_, err := models.VerifyGroupAdminScope(ctx, parentId)
+		return err
+	}
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !tokenData.RootAdmin {
+		return utilities.PermissionDenied("only a root admin can manage a top level group")
+	}
+	return nil
+}
+
+// deleteGroupAssets deletes the files, memberships, invites, users, and tasks of a group, and the tasks its users own in
+// other groups, with the comments, activity, and attachments of those tasks, in sequence, as they share the caller's
+// unit of work
+func (u *GroupService) deleteGroupAssets(ctx context.Context, group *models.Group, users []*models.User) error {
 	if !group.CheckID("id") {
-		return errors.New("filter id cannot be empty for mass delete")
+		return utilities.InvalidArgument("filter id cannot be empty for mass delete")
 	}
-	ctx, cancel := context.WithCancel(context.Background())
-	errChan := make(chan error)
-	defer func() {
-		cancel()
-		close(errChan)
-	}()
-	go func() {
-		err := u.fileDB.FileDeleteMany(models.UsersToFiles(users))
-		select {
-		case <-ctx.Done():
-			return
-		default:
+	err := u.fileDB.FileDeleteMany(ctx, models.UsersToFiles(users))
+	if err != nil {
+		return err
+	}
+	_, err = u.membershipDB.MembershipDeleteMany(ctx, &models.Membership{GroupId: group.Id})
+	if err != nil {
+		return err
+	}
+	_, err = u.inviteDB.InviteDeleteMany(ctx, &models.Invite{GroupId: group.Id})
+	if err != nil {
+		return err
+	}
+	for _, gu := range users { // the deleted users' memberships and tasks of other groups
+		_, err = u.membershipDB.MembershipDeleteMany(ctx, &models.Membership{UserId: gu.Id})
+		if err != nil {
+			return err
 		}
-		errChan <- err
-	}()
-	go func() {
-		_, err := u.userDB.UserDeleteMany(&models.User{GroupId: group.Id})
-		select {
-		case <-ctx.Done():
-			return
-		default:
+		userTasks, err := u.taskDB.TasksFind(ctx, &models.Task{UserId: gu.Id})
+		if err != nil {
+			return err
 		}
-		errChan <- err
-	}()
-	go func() {
-		_, err := u.taskDB.TaskDeleteMany(&models.Task{GroupId: group.Id})
-		select {
-		case <-ctx.Done():
-			return
-		default:
+		if len(userTasks) == 0 {
+			continue
 		}
-		errChan <- err
-	}()
-	var err error
-	for i := 0; i < 3; i++ {
-		select {
-		case err = <-errChan:
-			if err != nil {
-				break
-			}
+		err = deleteTaskAssets(ctx, u.commentDB, u.fileDB, userTasks)
+		if err != nil {
+			return err
 		}
+		_, err = u.taskDB.TaskDeleteMany(ctx, &models.Task{UserId: gu.Id})
+		if err != nil {
+			return err
+		}
+	}
+	_, err = u.userDB.UserDeleteMany(ctx, &models.User{GroupId: group.Id})
+	if err != nil {
+		return err
+	}
+	tasks, err := u.taskDB.TasksFind(ctx, &models.Task{GroupId: group.Id})
+	if err != nil {
+		return err
+	}
+	err = deleteTaskAssets(ctx, u.commentDB, u.fileDB, tasks)
+	if err != nil {
+		return err
 	}
+	_, err = u.taskDB.TaskDeleteMany(ctx, &models.Task{GroupId: group.Id})
 	return err
 }
 
 // getGroupUsers asynchronously gets a group and its users from the database
-func (u *GroupService) getGroupUsers(groupId string) (*models.GroupUsers, error) {
+func (u *GroupService) getGroupUsers(ctx context.Context, groupId string) (*models.GroupUsers, error) {
 	m := &models.GroupUsers{Users: []*models.User{}}
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
 	groupChan := make(chan *models.Group)
 	errChan := make(chan error)
 	usersChan := make(chan []*models.User)
@@ -192,7 +712,7 @@ func (u *GroupService) getGroupUsers(groupId string) (*models.GroupUsers, error)
 		close(usersChan)
 	}()
 	go func() {
-		out, err := u.groupDB.GroupFind(&models.Group{Id: groupId})
+		out, err := u.groupDB.GroupFind(ctx, &models.Group{Id: groupId})
 		select {
 		case <-ctx.Done():
 			return
@@ -202,7 +722,7 @@ func (u *GroupService) getGroupUsers(groupId string) (*models.GroupUsers, error)
 		errChan <- err
 	}()
 	go func() {
-		out, err := u.userDB.UsersFind(&models.User{GroupId: groupId})
+		out, err := u.userDB.UsersFind(ctx, &models.User{GroupId: groupId})
 		select {
 		case <-ctx.Done():
 			return
@@ -228,9 +748,9 @@ func (u *GroupService) getGroupUsers(groupId string) (*models.GroupUsers, error)
 }
 
 // getGroupTasks asynchronously gets a Group and its Tasks from the database
-func (u *GroupService) getGroupTasks(groupId string) (*models.GroupTasks, error) {
+func (u *GroupService) getGroupTasks(ctx context.Context, groupId string) (*models.GroupTasks, error) {
 	var m *models.GroupTasks
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
 	groupChan := make(chan *models.Group)
 	tasksChan := make(chan []*models.Task)
 	errorChan := make(chan error)
@@ -241,7 +761,7 @@ func (u *GroupService) getGroupTasks(groupId string) (*models.GroupTasks, error)
 		close(errorChan)
 	}()
 	go func() {
-		out, err := u.groupDB.GroupFind(&models.Group{Id: groupId})
+		out, err := u.groupDB.GroupFind(ctx, &models.Group{Id: groupId})
 		select {
 		case <-ctx.Done():
 			return
@@ -251,7 +771,7 @@ func (u *GroupService) getGroupTasks(groupId string) (*models.GroupTasks, error)
 		errorChan <- err
 	}()
 	go func() {
-		out, err := u.taskDB.TasksFind(&models.Task{GroupId: groupId})
+		out, err := u.taskDB.TasksFind(ctx, &models.Task{GroupId: groupId})
 		select {
 		case <-ctx.Done():
 			return
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/JECSand/go-grpc-server-boilerplate/config"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+)
+
+// QuotaChecker enforces the per group user and task quotas of the configuration, and the member limit of the group
+// settings
+type QuotaChecker struct {
+	cfg          *config.Store
+	userDB       UserDataService
+	groupDB      GroupDataService
+	membershipDB MembershipDataService
+	taskDB       TaskDataService
+}
+
+// NewQuotaChecker constructs a QuotaChecker
+func NewQuotaChecker(cfg *config.Store, u UserDataService, g GroupDataService, m MembershipDataService, t TaskDataService) *QuotaChecker {
+	return &QuotaChecker{cfg: cfg, userDB: u, groupDB: g, membershipDB: m, taskDB: t}
+}
+
+// CheckUsers returns utilities.ErrQuotaExceeded when the group cannot take another user, under either its quota or
+// the member limit of its settings, whichever is lower; the users of a group are those of which it is the primary group
+// and its members
+func (q *QuotaChecker) CheckUsers(ctx context.Context, groupId string) error {
+	maxUsers, _ := q.cfg.Get().Quota.Limits(groupId)
+	group, err := q.groupDB.GroupFind(ctx, &models.Group{Id: groupId})
+	if err != nil {
+		return err
+	}
+	if limit := group.Settings.MemberLimit; limit > 0 && (maxUsers == 0 || limit < maxUsers) {
+		maxUsers = limit
+	}
+	if maxUsers == 0 {
+		return nil
+	}
+	count, err := q.userDB.UsersCount(ctx, &models.User{GroupId: groupId})
+	if err != nil {
+		return err
+	}
+	memberships, err := q.membershipDB.MembershipsFind(ctx, &models.Membership{GroupId: groupId})
+	if err != nil {
+		return err
+	}
+	count += int64(len(memberships))
+	if count >= int64(maxUsers) {
+		return fmt.Errorf("%w: group %s has reached its limit of %d users", utilities.ErrQuotaExceeded, groupId, maxUsers)
+	}
+	return nil
+}
+
+// CheckTasks returns utilities.ErrQuotaExceeded when the group cannot take another task
+func (q *QuotaChecker) CheckTasks(ctx context.Context, groupId string) error {
+	_, maxTasks := q.cfg.Get().Quota.Limits(groupId)
+	if maxTasks == 0 {
+		return nil
+	}
+	count, err := q.taskDB.TasksCount(ctx, &models.Task{GroupId: groupId})
+	if err != nil {
+		return err
+	}
+	if count >= int64(maxTasks) {
+		return fmt.Errorf("%w: group %s has reached its limit of %d tasks", utilities.ErrQuotaExceeded, groupId, maxTasks)
+	}
+	return nil
+}
@@ -2,31 +2,46 @@ package services
 
 import (
 	"context"
-	"errors"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	tasksService "github.com/JECSand/go-grpc-server-boilerplate/protos/task"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"google.golang.org/grpc/metadata"
+	"io"
+	"time"
 )
 
+// attachmentChunkSize is the number of bytes of an attachment sent in each message of a download
+const attachmentChunkSize = 64 * 1024
+
 // TaskService gRPC Service
 type TaskService struct {
 	log          utilities.Logger
 	tokenService *TokenService
 	userDB       UserDataService
 	groupDB      GroupDataService
+	membershipDB MembershipDataService
 	taskDB       TaskDataService
 	fileDB       FileDataService
+	commentDB    CommentDataService
+	bus          *EventBus
+	uow          UnitOfWork
+	quota        *QuotaChecker
 }
 
 // NewTaskService constructs a TaskService for controller gRPC service Task requests
-func NewTaskService(log utilities.Logger, ts *TokenService, u UserDataService, g GroupDataService, t TaskDataService, f FileDataService) *TaskService {
+func NewTaskService(log utilities.Logger, ts *TokenService, u UserDataService, g GroupDataService, m MembershipDataService, t TaskDataService, f FileDataService, c CommentDataService, bus *EventBus, uow UnitOfWork, quota *QuotaChecker) *TaskService {
 	return &TaskService{
 		log:          log,
 		tokenService: ts,
 		userDB:       u,
 		groupDB:      g,
+		membershipDB: m,
 		taskDB:       t,
 		fileDB:       f,
+		commentDB:    c,
+		bus:          bus,
+		uow:          uow,
+		quota:        quota,
 	}
 }
 
@@ -35,7 +50,7 @@ func (u *TaskService) Create(ctx context.Context, req *tasksService.CreateReq) (
 	task := models.LoadTaskCreateProto(req)
 	userScope, err := models.VerifyRequestScope(ctx, "create")
 	if err != nil {
-		u.log.Errorf("models.VerifyRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("models.VerifyRequestScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	task.LoadScope(userScope)
@@ -43,7 +58,7 @@ func (u *TaskService) Create(ctx context.Context, req *tasksService.CreateReq) (
 	if !task.CheckID("user_id") || !task.CheckID("group_id") {
 		tokenClaims, err := models.LoadTokenFromContext(ctx)
 		if err != nil {
-			u.log.Errorf("models.LoadTokenFromContext: %v", err)
+			u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
 			return nil, utilities.ErrorResponse(err, err.Error())
 		}
 		if !task.CheckID("user_id") {
@@ -53,9 +68,34 @@ func (u *TaskService) Create(ctx context.Context, req *tasksService.CreateReq) (
 			task.GroupId = tokenClaims.GroupId
 		}
 	}
-	task, err = u.taskDB.TaskCreate(task)
+	group, err := u.groupDB.GroupFind(ctx, &models.Group{Id: task.GroupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("groupDB.GroupFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if task.Due.IsZero() {
+		task.Due = group.Settings.DefaultDue(time.Now())
+	}
+	if group.Settings.AdminOnlyTasks {
+		if _, err = models.VerifyGroupAdminScope(ctx, group.Id); err != nil {
+			err = utilities.PermissionDenied("only group admins can create tasks in this group")
+			u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+	}
+	if err = u.quota.CheckTasks(ctx, task.GroupId); err != nil {
+		u.log.WithContext(ctx).Errorf("quota.CheckTasks: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		task, err = u.taskDB.TaskCreate(ctx, task)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("taskDB.TaskCreate: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventTaskCreated, "task", task.Id, task.GroupId, task)
+	})
 	if err != nil {
-		u.log.Errorf("taskDB.TaskCreate: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &tasksService.CreateRes{Task: task.ToProto()}, nil
@@ -63,16 +103,60 @@ func (u *TaskService) Create(ctx context.Context, req *tasksService.CreateReq) (
 
 // Update a Task
 func (u *TaskService) Update(ctx context.Context, req *tasksService.UpdateReq) (*tasksService.UpdateRes, error) {
-	var err error
-	if !utilities.CheckObjectID(req.GetId()) {
-		err = errors.New(req.GetId() + " is an invalid taskId")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+	task := models.LoadTaskUpdateProto(req)
+	before, err := u.findGroupTask(ctx, task.Id)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findGroupTask: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	task := models.LoadTaskUpdateProto(req)
-	task, err = u.taskDB.TaskUpdate(task)
+	if task.GroupId != "" && task.GroupId != before.GroupId { // a task only moves to another group of the requester
+		if _, err = models.VerifyGroupRequestScope(ctx, task.GroupId); err != nil {
+			u.log.WithContext(ctx).Errorf("models.VerifyGroupRequestScope: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+	}
+	group, err := u.groupDB.GroupFind(ctx, &models.Group{Id: before.GroupId})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("groupDB.GroupFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if !group.Settings.AllowsStatus(task.Status) {
+		err = utilities.InvalidField("status", "is not one of the statuses allowed in the group")
+		u.log.WithContext(ctx).Errorf("GroupSettings.AllowsStatus: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	change := task
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		task, err = u.taskDB.TaskUpdate(ctx, change)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("taskDB.TaskUpdate: %v", err)
+			return err
+		}
+		for _, activity := range models.NewTaskActivities(before, task, tokenData.UserId) {
+			if _, err = u.commentDB.ActivityCreate(ctx, activity); err != nil {
+				u.log.WithContext(ctx).Errorf("commentDB.ActivityCreate: %v", err)
+				return err
+			}
+		}
+		if err = publishEvent(ctx, u.log, u.bus, models.EventTaskUpdated, "task", task.Id, task.GroupId, task); err != nil {
+			return err
+		}
+		if task.Status == models.COMPLETED && before.Status != models.COMPLETED {
+			if err = publishEvent(ctx, u.log, u.bus, models.EventTaskCompleted, "task", task.Id, task.GroupId, task); err != nil {
+				return err
+			}
+		}
+		if task.UserId != before.UserId {
+			return publishEvent(ctx, u.log, u.bus, models.EventTaskReassigned, "task", task.Id, task.GroupId, task)
+		}
+		return nil
+	})
 	if err != nil {
-		u.log.Errorf("taskDB.TaskUpdate: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &tasksService.UpdateRes{Task: task.ToProto()}, nil
@@ -81,20 +165,20 @@ func (u *TaskService) Update(ctx context.Context, req *tasksService.UpdateReq) (
 // Get a specific Task
 func (u *TaskService) Get(ctx context.Context, req *tasksService.GetReq) (*tasksService.GetRes, error) {
 	if !utilities.CheckObjectID(req.GetId()) {
-		err := errors.New(req.GetId() + " is an invalid taskId")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument(req.GetId() + " is an invalid taskId")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	filter := models.Task{Id: req.GetId()}
 	userScope, err := models.VerifyRequestScope(ctx, "find")
 	if err != nil {
-		u.log.Errorf("models.VerifyRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("models.VerifyRequestScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	filter.LoadScope(userScope)
-	task, err := u.taskDB.TaskFind(&filter)
+	task, err := u.taskDB.TaskFind(ctx, &filter)
 	if err != nil {
-		u.log.Errorf("taskDB.TaskFind: %v", err)
+		u.log.WithContext(ctx).Errorf("taskDB.TaskFind: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &tasksService.GetRes{Task: task.ToProto()}, nil
@@ -102,10 +186,16 @@ func (u *TaskService) Get(ctx context.Context, req *tasksService.GetReq) (*tasks
 
 // Find Tasks from an input query
 func (u *TaskService) Find(ctx context.Context, req *tasksService.FindReq) (*tasksService.FindRes, error) {
-	// TODO NEXT FIX - valid req.GetQuery() authenticity / scope
-	tasks, err := u.taskDB.TasksQuery(ctx, models.LoadTaskFindProto(req), utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	filter := models.LoadTaskFindProto(req)
+	userScope, err := models.VerifyRequestScope(ctx, "find")
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.VerifyRequestScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	filter.LoadScope(userScope)
+	tasks, err := u.taskDB.TasksQuery(ctx, filter, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
 	if err != nil {
-		u.log.Errorf("taskDB.TasksQuery: %v", err)
+		u.log.WithContext(ctx).Errorf("taskDB.TasksQuery: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &tasksService.FindRes{
@@ -121,18 +211,24 @@ func (u *TaskService) Find(ctx context.Context, req *tasksService.FindReq) (*tas
 // GetGroupTasks returns the tasks for a given groupId
 func (u *TaskService) GetGroupTasks(ctx context.Context, req *tasksService.GetGroupTasksReq) (*tasksService.GetGroupTasksRes, error) {
 	if !utilities.CheckObjectID(req.GetGroupId()) {
-		err := errors.New("invalid group id")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument("invalid group id")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	groupId, err := models.VerifyGroupRequestScope(ctx, req.GetGroupId())
 	if err != nil {
-		u.log.Errorf("models.VerifyGroupRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupRequestScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	tasks, err := u.taskDB.TasksQuery(ctx, &models.Task{GroupId: groupId}, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	pagination := utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	var tasks *models.TasksRes
+	if req.GetIncludeDescendants() {
+		tasks, err = u.getDescendantTasks(ctx, groupId, pagination)
+	} else {
+		tasks, err = u.taskDB.TasksQuery(ctx, &models.Task{GroupId: groupId}, pagination)
+	}
 	if err != nil {
-		u.log.Errorf("taskDB.TasksQuery: %v", err)
+		u.log.WithContext(ctx).Errorf("taskDB.TasksQuery: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &tasksService.GetGroupTasksRes{
@@ -145,30 +241,52 @@ func (u *TaskService) GetGroupTasks(ctx context.Context, req *tasksService.GetGr
 	}, nil
 }
 
+// getDescendantTasks rolls up the tasks of a group and of every group nested within it
+func (u *TaskService) getDescendantTasks(ctx context.Context, groupId string, pagination *utilities.Pagination) (*models.TasksRes, error) {
+	descendants, err := u.groupDB.GroupDescendants(ctx, &models.Group{Id: groupId})
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := u.taskDB.TasksFind(ctx, &models.Task{GroupId: groupId})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range descendants {
+		dTasks, err := u.taskDB.TasksFind(ctx, &models.Task{GroupId: d.Id})
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, dTasks...)
+	}
+	return models.NewTasksRes(tasks, pagination), nil
+}
+
 // GetUserTasks returns the tasks for a given userId
 func (u *TaskService) GetUserTasks(ctx context.Context, req *tasksService.GetUserTasksReq) (*tasksService.GetUserTasksRes, error) {
 	if !utilities.CheckObjectID(req.GetUserId()) {
-		err := errors.New("invalid user id")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument("invalid user id")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	user, err := u.userDB.UserFind(&models.User{Id: req.GetUserId()})
+	user, err := u.userDB.UserFind(ctx, &models.User{Id: req.GetUserId()})
 	if err != nil {
-		u.log.Errorf("userDB.UserFind: %v", err)
+		u.log.WithContext(ctx).Errorf("userDB.UserFind: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	userScope, err := models.VerifyRequestScope(ctx, "find")
+	tokenData, err := models.LoadTokenFromContext(ctx)
 	if err != nil {
-		u.log.Errorf("models.VerifyRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	if userScope.GroupId != "" && userScope.GroupId != user.GroupId {
-		u.log.Errorf("taskDB.TasksQuery: %v", err)
-		return nil, utilities.ErrorResponse(err, err.Error())
+	pagination := utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	var tasks *models.TasksRes
+	if tokenData.RootAdmin {
+		tasks, err = u.taskDB.TasksQuery(ctx, &models.Task{UserId: user.Id}, pagination)
+	} else {
+		tasks, err = u.getMemberTasks(ctx, tokenData, user, pagination)
 	}
-	tasks, err := u.taskDB.TasksQuery(ctx, &models.Task{UserId: user.Id}, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
 	if err != nil {
-		u.log.Errorf("taskDB.TasksQuery: %v", err)
+		u.log.WithContext(ctx).Errorf("TaskService.getMemberTasks: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &tasksService.GetUserTasksRes{
@@ -181,24 +299,406 @@ func (u *TaskService) GetUserTasks(ctx context.Context, req *tasksService.GetUse
 	}, nil
 }
 
+// getMemberTasks returns the tasks of a User in the groups the requester is a member of, when the requester shares a
+// group with the User
+func (u *TaskService) getMemberTasks(ctx context.Context, tokenData *models.TokenData, user *models.User, pagination *utilities.Pagination) (*models.TasksRes, error) {
+	memberships, err := u.membershipDB.MembershipsFind(ctx, &models.Membership{UserId: user.Id})
+	if err != nil {
+		return nil, err
+	}
+	shared := tokenData.UserId == user.Id || tokenData.GroupRole(user.GroupId) != ""
+	for _, m := range memberships {
+		shared = shared || tokenData.GroupRole(m.GroupId) != ""
+	}
+	if !shared {
+		return nil, utilities.PermissionDenied("unauthorized")
+	}
+	userTasks, err := u.taskDB.TasksFind(ctx, &models.Task{UserId: user.Id})
+	if err != nil {
+		return nil, err
+	}
+	var tasks []*models.Task
+	for _, t := range userTasks {
+		if tokenData.GroupRole(t.GroupId) != "" {
+			tasks = append(tasks, t)
+		}
+	}
+	return models.NewTasksRes(tasks, pagination), nil
+}
+
 // Delete is the handler function that deletes a task
 func (u *TaskService) Delete(ctx context.Context, req *tasksService.DeleteReq) (*tasksService.DeleteRes, error) {
 	if !utilities.CheckObjectID(req.GetId()) {
-		err := errors.New(req.GetId() + " is an invalid taskId")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument(req.GetId() + " is an invalid taskId")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	filter := models.Task{Id: req.GetId()}
 	userScope, err := models.VerifyRequestScope(ctx, "update")
 	if err != nil {
-		u.log.Errorf("models.VerifyRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("models.VerifyRequestScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	filter.LoadScope(userScope)
-	task, err := u.taskDB.TaskDelete(&filter)
+	var task *models.Task
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		task, err = u.taskDB.TaskDelete(ctx, &filter)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("taskDB.TaskDelete: %v", err)
+			return err
+		}
+		err = deleteTaskAssets(ctx, u.commentDB, u.fileDB, []*models.Task{task})
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("TaskService.deleteTaskAssets: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventTaskDeleted, "task", task.Id, task.GroupId, task)
+	})
 	if err != nil {
-		u.log.Errorf("taskDB.TaskDelete: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &tasksService.DeleteRes{Task: task.ToProto()}, nil
 }
+
+// AddComment adds a Comment by the requester to a Task of a group it belongs to
+func (u *TaskService) AddComment(ctx context.Context, req *tasksService.AddCommentReq) (*tasksService.AddCommentRes, error) {
+	comment := models.LoadAddCommentProto(req)
+	if _, err := u.findGroupTask(ctx, comment.TaskId); err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findGroupTask: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	comment.UserId = tokenData.UserId
+	comment, err = u.commentDB.CommentCreate(ctx, comment)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("commentDB.CommentCreate: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &tasksService.AddCommentRes{Comment: comment.ToProto()}, nil
+}
+
+// UpdateComment edits the Body of a Comment; only the author of a comment can edit it
+func (u *TaskService) UpdateComment(ctx context.Context, req *tasksService.UpdateCommentReq) (*tasksService.UpdateCommentRes, error) {
+	update := models.LoadUpdateCommentProto(req)
+	comment, err := u.findComment(ctx, update.Id)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findComment: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if comment.UserId != tokenData.UserId {
+		err = utilities.PermissionDenied("only the author of a comment can edit it")
+		u.log.WithContext(ctx).Errorf("TaskService.UpdateComment: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	comment, err = u.commentDB.CommentUpdate(ctx, update)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("commentDB.CommentUpdate: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &tasksService.UpdateCommentRes{Comment: comment.ToProto()}, nil
+}
+
+// DeleteComment deletes a Comment; a comment can be deleted by its author or by an admin of the task's group
+func (u *TaskService) DeleteComment(ctx context.Context, req *tasksService.DeleteCommentReq) (*tasksService.DeleteCommentRes, error) {
+	comment, err := u.findComment(ctx, req.GetId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findComment: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if comment.UserId != tokenData.UserId {
+		task, err := u.taskDB.TaskFind(ctx, &models.Task{Id: comment.TaskId})
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("taskDB.TaskFind: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+		if _, err = models.VerifyGroupAdminScope(ctx, task.GroupId); err != nil {
+			err = utilities.PermissionDenied("only the author of a comment or a group admin can delete it")
+			u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+	}
+	comment, err = u.commentDB.CommentDelete(ctx, comment)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("commentDB.CommentDelete: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &tasksService.DeleteCommentRes{Comment: comment.ToProto()}, nil
+}
+
+// ListComments returns the Comments of a Task, oldest first
+func (u *TaskService) ListComments(ctx context.Context, req *tasksService.ListCommentsReq) (*tasksService.ListCommentsRes, error) {
+	task, err := u.findGroupTask(ctx, req.GetTaskId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findGroupTask: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	comments, err := u.commentDB.CommentsQuery(ctx, &models.Comment{TaskId: task.Id}, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("commentDB.CommentsQuery: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &tasksService.ListCommentsRes{
+		TotalCount: comments.TotalCount,
+		TotalPages: comments.TotalPages,
+		Page:       comments.Page,
+		Size:       comments.Size,
+		HasMore:    comments.HasMore,
+		Comments:   comments.ToProto(),
+	}, nil
+}
+
+// ListActivity returns the timeline of status, assignee, and due-date changes of a Task, oldest first
+func (u *TaskService) ListActivity(ctx context.Context, req *tasksService.ListActivityReq) (*tasksService.ListActivityRes, error) {
+	task, err := u.findGroupTask(ctx, req.GetTaskId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findGroupTask: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	activities, err := u.commentDB.ActivitiesQuery(ctx, &models.Activity{TaskId: task.Id}, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("commentDB.ActivitiesQuery: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &tasksService.ListActivityRes{
+		TotalCount: activities.TotalCount,
+		TotalPages: activities.TotalPages,
+		Page:       activities.Page,
+		Size:       activities.Size,
+		HasMore:    activities.HasMore,
+		Activities: activities.ToProto(),
+	}, nil
+}
+
+// findGroupTask finds a Task and verifies that the requester is a member of its group
+func (u *TaskService) findGroupTask(ctx context.Context, taskId string) (*models.Task, error) {
+	if !utilities.CheckObjectID(taskId) {
+		return nil, utilities.InvalidArgument(taskId + " is an invalid taskId")
+	}
+	task, err := u.taskDB.TaskFind(ctx, &models.Task{Id: taskId})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = models.VerifyGroupRequestScope(ctx, task.GroupId); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// findComment finds a Comment on a Task of a group the requester is a member of
+func (u *TaskService) findComment(ctx context.Context, commentId string) (*models.Comment, error) {
+	if !utilities.CheckObjectID(commentId) {
+		return nil, utilities.InvalidArgument(commentId + " is an invalid commentId")
+	}
+	comment, err := u.commentDB.CommentFind(ctx, &models.Comment{Id: commentId})
+	if err != nil {
+		return nil, err
+	}
+	if _, err = u.findGroupTask(ctx, comment.TaskId); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// deleteTaskAssets deletes the comments, activity, and attachments of tasks in sequence, as they share the caller's unit
+// of work; attachments go last, as their GridFS objects are not part of the unit of work
+func deleteTaskAssets(ctx context.Context, commentDB CommentDataService, fileDB FileDataService, tasks []*models.Task) error {
+	for _, task := range tasks {
+		_, err := commentDB.CommentDeleteMany(ctx, &models.Comment{TaskId: task.Id})
+		if err != nil {
+			return err
+		}
+		_, err = commentDB.ActivityDeleteMany(ctx, &models.Activity{TaskId: task.Id})
+		if err != nil {
+			return err
+		}
+	}
+	for _, task := range tasks {
+		files, err := fileDB.FilesFind(ctx, &models.File{OwnerId: task.Id})
+		if err != nil {
+			return err
+		}
+		err = fileDB.FileDeleteMany(ctx, files)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddAttachment streams a file from the client and attaches it to a Task of a group the requester belongs to. The
+// first message names the task and the file, and every message carries a chunk of its content.
+func (u *TaskService) AddAttachment(stream tasksService.TaskService_AddAttachmentServer) error {
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err == io.EOF {
+		err = utilities.InvalidArgument("an attachment upload needs at least one message")
+		u.log.WithContext(ctx).Errorf("stream.Recv: %v", err)
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+	file := models.LoadAddAttachmentProto(req)
+	if _, err = u.findGroupTask(ctx, file.OwnerId); err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findGroupTask: %v", err)
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	if err = file.Validate("create"); err != nil {
+		u.log.WithContext(ctx).Errorf("File.Validate: %v", err)
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	content := models.NewInFile(file.Name)
+	for {
+		if content.Len()+len(req.GetChunk()) > models.MaxAttachmentSize {
+			err = utilities.InvalidField("chunk", "attachments must be at most 32 MiB")
+			u.log.WithContext(ctx).Errorf("TaskService.AddAttachment: %v", err)
+			return utilities.ErrorResponse(err, err.Error())
+		}
+		if err = content.Write(req.GetChunk()); err != nil {
+			u.log.WithContext(ctx).Errorf("InFile.Write: %v", err)
+			return utilities.ErrorResponse(err, err.Error())
+		}
+		req, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	file, err = u.fileDB.FileCreate(ctx, file, content.Bytes())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("fileDB.FileCreate: %v", err)
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	return stream.SendAndClose(&tasksService.AddAttachmentRes{Attachment: file.ToAttachmentProto()})
+}
+
+// ListAttachments returns the files attached to a Task
+func (u *TaskService) ListAttachments(ctx context.Context, req *tasksService.ListAttachmentsReq) (*tasksService.ListAttachmentsRes, error) {
+	task, err := u.findGroupTask(ctx, req.GetTaskId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findGroupTask: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	files, err := u.fileDB.FilesQuery(ctx, &models.File{OwnerId: task.Id}, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("fileDB.FilesQuery: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &tasksService.ListAttachmentsRes{
+		TotalCount:  files.TotalCount,
+		TotalPages:  files.TotalPages,
+		Page:        files.Page,
+		Size:        files.Size,
+		HasMore:     files.HasMore,
+		Attachments: files.ToAttachmentsProto(),
+	}, nil
+}
+
+// DownloadAttachment streams the content of a file attached to a Task to the client, in chunks following a first
+// message that also carries the attachment
+func (u *TaskService) DownloadAttachment(req *tasksService.DownloadAttachmentReq, stream tasksService.TaskService_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+	file, err := u.findAttachment(ctx, req.GetTaskId(), req.GetId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findAttachment: %v", err)
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	content, err := u.fileDB.RetrieveFile(ctx, file)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("fileDB.RetrieveFile: %v", err)
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	res := &tasksService.DownloadAttachmentRes{Attachment: file.ToAttachmentProto()}
+	for {
+		res.Chunk = content.Next(attachmentChunkSize)
+		if err = stream.Send(res); err != nil {
+			return err
+		}
+		if content.Len() == 0 {
+			return nil
+		}
+		res = &tasksService.DownloadAttachmentRes{}
+	}
+}
+
+// DeleteAttachment deletes a file attached to a Task
+func (u *TaskService) DeleteAttachment(ctx context.Context, req *tasksService.DeleteAttachmentReq) (*tasksService.DeleteAttachmentRes, error) {
+	file, err := u.findAttachment(ctx, req.GetTaskId(), req.GetId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("TaskService.findAttachment: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	_, err = u.fileDB.FileDelete(ctx, &models.File{Id: file.Id})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("fileDB.FileDelete: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &tasksService.DeleteAttachmentRes{Attachment: file.ToAttachmentProto()}, nil
+}
+
+// findAttachment finds a File attached to a Task of a group the requester is a member of
+func (u *TaskService) findAttachment(ctx context.Context, taskId string, fileId string) (*models.File, error) {
+	task, err := u.findGroupTask(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+	if !utilities.CheckObjectID(fileId) {
+		return nil, utilities.InvalidArgument(fileId + " is an invalid attachmentId")
+	}
+	file, err := u.fileDB.FileFind(ctx, &models.File{Id: fileId})
+	if err != nil {
+		return nil, err
+	}
+	if file.OwnerType != "task" || file.OwnerId != task.Id {
+		return nil, utilities.NotFound("attachment", fileId)
+	}
+	return file, nil
+}
+
+// WatchTasks streams task create, update, and delete events within the requester's scope until the client disconnects
+func (u *TaskService) WatchTasks(req *tasksService.WatchTasksReq, stream tasksService.TaskService_WatchTasksServer) error {
+	ctx := stream.Context()
+	tokenClaims, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	changes, errs, err := u.taskDB.TasksWatch(ctx, req.GetResumeToken())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("taskDB.TasksWatch: %v", err)
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	// sending the headers signals the client that the watch is live
+	if err = stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	for change := range changes {
+		if !change.InScope(tokenClaims) {
+			continue
+		}
+		if err = stream.Send(change.ToProto()); err != nil {
+			return err
+		}
+	}
+	if err = <-errs; err != nil {
+		u.log.WithContext(ctx).Errorf("taskDB.TasksWatch: %v", err)
+		return utilities.ErrorResponse(err, err.Error())
+	}
+	return nil
+}
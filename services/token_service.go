@@ -1,8 +1,9 @@
 package services
 
 import (
-	"errors"
+	"context"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
 	"time"
 )
 
@@ -11,85 +12,147 @@ type TokenService struct {
 	uService UserDataService
 	gService GroupDataService
 	bService BlacklistDataService
+	mService MembershipDataService
 }
 
 // NewTokenService is an exported function used to initialize a new authService struct
-func NewTokenService(uService UserDataService, gService GroupDataService, bService BlacklistDataService) *TokenService {
-	return &TokenService{uService, gService, bService}
+func NewTokenService(uService UserDataService, gService GroupDataService, bService BlacklistDataService, mService MembershipDataService) *TokenService {
+	return &TokenService{uService, gService, bService, mService}
 }
 
-// verifyTokenUser verifies Token's User
-func (a *TokenService) verifyTokenUser(decodedToken *models.TokenData) (bool, string) {
-	tUser := decodedToken.ToUser()
-	checkUser, err := a.uService.UserFind(tUser)
+// GroupRoles returns the role of a User by group id, in its primary group and every group it is a member of. An admin
+// of a group is also an admin of every group nested within it.
+func (a *TokenService) GroupRoles(ctx context.Context, u *models.User) (map[string]string, error) {
+	memberships, err := a.mService.MembershipsFind(ctx, &models.Membership{UserId: u.Id})
 	if err != nil {
-		return false, err.Error()
+		return nil, err
 	}
-	checkGroup, err := a.gService.GroupFind(&models.Group{Id: tUser.GroupId})
+	roles := map[string]string{u.GroupId: u.Role}
+	for _, m := range memberships {
+		roles[m.GroupId] = m.Role
+	}
+	var adminOf []string
+	for groupId, role := range roles {
+		if role == "admin" {
+			adminOf = append(adminOf, groupId)
+		}
+	}
+	for _, groupId := range adminOf {
+		descendants, err := a.gService.GroupDescendants(ctx, &models.Group{Id: groupId})
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range descendants {
+			roles[d.Id] = "admin"
+		}
+	}
+	return roles, nil
+}
+
+// verifyTokenUser verifies Token's User, returning its role by group id
+func (a *TokenService) verifyTokenUser(ctx context.Context, decodedToken *models.TokenData) (map[string]string, bool, string) {
+	checkUser, err := a.uService.UserFind(ctx, &models.User{Id: decodedToken.UserId})
+	if err != nil {
+		return nil, false, err.Error()
+	}
+	checkGroup, err := a.gService.GroupFind(ctx, &models.Group{Id: decodedToken.GroupId})
 	if err != nil {
-		return false, err.Error()
+		return nil, false, err.Error()
+	}
+	roles, err := a.GroupRoles(ctx, checkUser)
+	if err != nil {
+		return nil, false, err.Error()
+	}
+	// validate the active Group of the token is one the User is still a member of
+	if _, ok := roles[checkGroup.Id]; !ok {
+		return nil, false, "Incorrect group id"
 	}
-	// validate the Group id of the User and the associated User's Group
-	if checkUser.GroupId != checkGroup.Id {
-		return false, "Incorrect group id"
+	// reject tokens issued before the User's tokens were last revoked, as stored to the millisecond
+	if !checkUser.TokensRevokedAt.IsZero() && decodedToken.IssuedAt.Before(checkUser.TokensRevokedAt.Truncate(time.Millisecond)) {
+		return nil, false, "Revoked token"
 	}
-	return true, "No Error"
+	return roles, true, "No Error"
 }
 
-// tokenVerifyMiddleWare inputs the route handler function along with User roleType to verify User token and permissions
-func (a *TokenService) tokenVerifyMiddleWare(roleType string, authToken string) (bool, error) {
-	if a.bService.CheckTokenBlacklist(authToken) {
-		return false, errors.New("invalid token")
+// tokenVerifyMiddleWare inputs the route handler function along with User roleType to verify User token and permissions,
+// returning the User's role by group id
+func (a *TokenService) tokenVerifyMiddleWare(ctx context.Context, roleType string, authToken string) (map[string]string, bool, error) {
+	if a.bService.CheckTokenBlacklist(ctx, authToken) {
+		return nil, false, utilities.Unauthenticated("invalid token")
 	}
 	decodedToken, err := models.DecodeJWT(authToken)
 	if err != nil {
-		return false, err
+		return nil, false, err
 	}
-	verified, verifyMsg := a.verifyTokenUser(decodedToken)
+	roles, verified, verifyMsg := a.verifyTokenUser(ctx, decodedToken)
 	if verified {
 		if roleType == "Root" && decodedToken.RootAdmin {
-			return true, nil
-		} else if roleType == "Admin" && decodedToken.Role == "admin" {
-			return true, nil
+			return roles, true, nil
+		} else if roleType == "Admin" && roles[decodedToken.GroupId] == "admin" {
+			return roles, true, nil
 		} else if roleType == "Member" {
-			return true, nil
+			return roles, true, nil
 		} else {
-			return false, errors.New("invalid token")
+			return roles, false, utilities.PermissionDenied("no permission to access this RPC")
 		}
 	} else {
-		return false, errors.New(verifyMsg)
+		return nil, false, utilities.Unauthenticated(verifyMsg)
 	}
 }
 
-// GenerateToken outputs an auth token string for an inputted User
-func (a *TokenService) GenerateToken(u *models.User, tType string) (string, error) {
-	expDT := time.Now().Add(time.Hour * 1).Unix() // Default 1 hour expiration for session token
+// tokenExpiration returns the expiration time of a new token of a type
+func tokenExpiration(tType string) int64 {
 	if tType == "api" {
-		expDT = time.Now().Add(time.Hour * 4380).Unix() // 6 month expiration for api key
+		return time.Now().Add(time.Hour * 4380).Unix() // 6 month expiration for api key
 	}
+	return time.Now().Add(time.Hour * 1).Unix() // Default 1 hour expiration for session token
+}
+
+// GenerateToken outputs an auth token string for an inputted User, with its primary group active
+func (a *TokenService) GenerateToken(u *models.User, tType string) (string, error) {
 	tData, err := models.InitUserToken(u)
 	if err != nil {
 		return "", err
 	}
-	return tData.CreateToken(expDT)
+	return tData.CreateToken(tokenExpiration(tType))
+}
+
+// GenerateGroupToken outputs an auth token string for an inputted User with a group it is a member of active, along
+// with the User's role in that group
+func (a *TokenService) GenerateGroupToken(ctx context.Context, u *models.User, groupId string, tType string) (string, string, error) {
+	tData, err := models.InitUserToken(u)
+	if err != nil {
+		return "", "", err
+	}
+	roles, err := a.GroupRoles(ctx, u)
+	if err != nil {
+		return "", "", err
+	}
+	role, ok := roles[groupId]
+	if !ok {
+		return "", "", utilities.PermissionDenied("user is not a member of the group")
+	}
+	tData.GroupId, tData.Role = groupId, role
+	token, err := tData.CreateToken(tokenExpiration(tType))
+	return token, role, err
 }
 
 // RootAdminTokenVerifyMiddleWare is used to verify that the requester is a valid admin
-func (a *TokenService) RootAdminTokenVerifyMiddleWare(authToken string) (bool, error) {
-	return a.tokenVerifyMiddleWare("Root", authToken)
+func (a *TokenService) RootAdminTokenVerifyMiddleWare(ctx context.Context, authToken string) (map[string]string, bool, error) {
+	return a.tokenVerifyMiddleWare(ctx, "Root", authToken)
 }
 
-// AdminTokenVerifyMiddleWare is used to verify that the requester is a valid admin
-func (a *TokenService) AdminTokenVerifyMiddleWare(authToken string) (bool, error) {
-	return a.tokenVerifyMiddleWare("Admin", authToken)
+// AdminTokenVerifyMiddleWare is used to verify that the requester is an admin of its active group
+func (a *TokenService) AdminTokenVerifyMiddleWare(ctx context.Context, authToken string) (map[string]string, bool, error) {
+	return a.tokenVerifyMiddleWare(ctx, "Admin", authToken)
 }
 
 // MemberTokenVerifyMiddleWare is used to verify that a requester is authenticated
-func (a *TokenService) MemberTokenVerifyMiddleWare(authToken string) (bool, error) {
-	return a.tokenVerifyMiddleWare("Member", authToken)
+func (a *TokenService) MemberTokenVerifyMiddleWare(ctx context.Context, authToken string) (map[string]string, bool, error) {
+	return a.tokenVerifyMiddleWare(ctx, "Member", authToken)
 }
 
 // BlacklistAuthToken is used to blacklist an unexpired token
-func (a *TokenService) BlacklistAuthToken(authToken string) error {
-	return a.bService.BlacklistAuthToken(authToken)
+func (a *TokenService) BlacklistAuthToken(ctx context.Context, authToken string) error {
+	return a.bService.BlacklistAuthToken(ctx, authToken)
 }
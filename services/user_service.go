@@ -2,10 +2,11 @@ package services
 
 import (
 	"context"
-	"errors"
 	"github.com/JECSand/go-grpc-server-boilerplate/models"
 	usersService "github.com/JECSand/go-grpc-server-boilerplate/protos/user"
 	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"strconv"
+	"time"
 )
 
 // UserService gRPC Service
@@ -14,19 +15,31 @@ type UserService struct {
 	tokenService *TokenService
 	userDB       UserDataService
 	groupDB      GroupDataService
+	membershipDB MembershipDataService
 	taskDB       TaskDataService
 	fileDB       FileDataService
+	commentDB    CommentDataService
+	auditDB      AuditDataService
+	bus          *EventBus
+	uow          UnitOfWork
+	quota        *QuotaChecker
 }
 
 // NewUserService constructs a UserService for controller gRPC service User requests
-func NewUserService(log utilities.Logger, ts *TokenService, u UserDataService, g GroupDataService, t TaskDataService, f FileDataService) *UserService {
+func NewUserService(log utilities.Logger, ts *TokenService, u UserDataService, g GroupDataService, m MembershipDataService, t TaskDataService, f FileDataService, c CommentDataService, a AuditDataService, bus *EventBus, uow UnitOfWork, quota *QuotaChecker) *UserService {
 	return &UserService{
 		log:          log,
 		tokenService: ts,
 		userDB:       u,
 		groupDB:      g,
+		membershipDB: m,
 		taskDB:       t,
 		fileDB:       f,
+		commentDB:    c,
+		auditDB:      a,
+		bus:          bus,
+		uow:          uow,
+		quota:        quota,
 	}
 }
 
@@ -35,17 +48,17 @@ func (u *UserService) Create(ctx context.Context, req *usersService.CreateReq) (
 	user := models.LoadUserCreateProto(req)
 	err := user.Validate("create")
 	if err != nil {
-		u.log.Errorf("user.Validate: %v", err)
+		u.log.WithContext(ctx).Errorf("user.Validate: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	accessToken, err := utilities.GetTokenFromContext(ctx)
 	if err != nil {
-		u.log.Errorf("utilities.GetTokenFromContext: %v", err)
+		u.log.WithContext(ctx).Errorf("utilities.GetTokenFromContext: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	decodedToken, err := models.DecodeJWT(accessToken)
 	if err != nil {
-		u.log.Errorf("utilities.GetTokenFromContext: %v", err)
+		u.log.WithContext(ctx).Errorf("utilities.GetTokenFromContext: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	userScope := decodedToken.GetUsersScope("create")
@@ -53,54 +66,92 @@ func (u *UserService) Create(ctx context.Context, req *usersService.CreateReq) (
 	if user.GroupId == "" {
 		user.GroupId = decodedToken.GroupId
 	}
-	user, err = u.userDB.UserCreate(user)
+	if err = u.quota.CheckUsers(ctx, user.GroupId); err != nil {
+		u.log.WithContext(ctx).Errorf("quota.CheckUsers: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		user, err = u.userDB.UserCreate(ctx, user)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("userDB.UserCreate: %v", err)
+			return err
+		}
+		if err = publishEvent(ctx, u.log, u.bus, models.EventUserCreated, "user", user.Id, user.GroupId, user.Public()); err != nil {
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventUserJoinedGroup, "user", user.Id, user.GroupId, user.Public())
+	})
 	if err != nil {
-		u.log.Errorf("userDB.UserCreate: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	user.Password = ""
+	event := models.NewAuditEvent(ctx, models.AuditUserCreate, "user", user.Id, user.GroupId)
+	event.Changes = models.DiffAudit(nil, user)
+	recordAudit(ctx, u.log, u.auditDB, event)
 	return &usersService.CreateRes{User: user.ToProto()}, nil
 }
 
 // Update a User
 func (u *UserService) Update(ctx context.Context, req *usersService.UpdateReq) (*usersService.UpdateRes, error) {
 	if !utilities.CheckObjectID(req.GetId()) {
-		err := errors.New(req.GetId() + " is an invalid userId")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument(req.GetId() + " is an invalid userId")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	user := models.LoadUserUpdateProto(req)
-	userScope, err := models.VerifyUserRequestScope(ctx, user.Id, "update")
+	before, userScope, err := u.verifyUserScope(ctx, user.Id, "update")
 	if err != nil {
-		u.log.Errorf("models.VerifyUserRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("UserService.verifyUserScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	user.LoadScope(userScope, "update")
-	user, err = u.userDB.UserUpdate(user)
+	tokenData, err := models.LoadTokenFromContext(ctx)
 	if err != nil {
-		u.log.Errorf("userDB.UserUpdate: %v", err)
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
+	if !tokenData.RootAdmin { // the role is scoped by the user's primary group rather than the active group
+		if _, err = models.VerifyGroupAdminScope(ctx, before.GroupId); err == nil {
+			user.Role = req.GetRole()
+		} else if tokenData.UserId == before.Id { // a user that is not an admin of it keeps its role when updating itself
+			user.Role = ""
+		} else { // only an admin of the user's primary group updates another user
+			u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+	}
+	user.GroupId = before.GroupId // the primary group only changes with MoveUser
+	if user.Role != "" && user.Role != "admin" && before.Role == "admin" {
+		if err = checkLastAdmin(ctx, u.userDB, u.membershipDB, before.Id, before.GroupId); err != nil {
+			u.log.WithContext(ctx).Errorf("UserService.checkLastAdmin: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+	}
+	user, err = u.userDB.UserUpdate(ctx, user)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("userDB.UserUpdate: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	action := models.AuditUserUpdate
+	if before.Role != user.Role || before.RootAdmin != user.RootAdmin {
+		action = models.AuditUserRoleChange
+	}
+	event := models.NewAuditEvent(ctx, action, "user", user.Id, user.GroupId)
+	event.Changes = models.DiffAudit(before, user)
+	recordAudit(ctx, u.log, u.auditDB, event)
 	return &usersService.UpdateRes{User: user.ToProto()}, nil
 }
 
 // Get a specific User
 func (u *UserService) Get(ctx context.Context, req *usersService.GetReq) (*usersService.GetRes, error) {
 	if !utilities.CheckObjectID(req.GetId()) {
-		err := errors.New(req.GetId() + " is an invalid userId")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
-		return nil, utilities.ErrorResponse(err, err.Error())
-	}
-	filter := models.User{Id: req.GetId()}
-	userScope, err := models.VerifyUserRequestScope(ctx, req.GetId(), "find")
-	if err != nil {
-		u.log.Errorf("models.VerifyUserRequestScope: %v", err)
+		err := utilities.InvalidArgument(req.GetId() + " is an invalid userId")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	filter.LoadScope(userScope, "find")
-	user, err := u.userDB.UserFind(&filter)
+	user, _, err := u.verifyUserScope(ctx, req.GetId(), "find")
 	if err != nil {
-		u.log.Errorf("userDB.UserFind: %v", err)
+		u.log.WithContext(ctx).Errorf("UserService.verifyUserScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	user.Password = ""
@@ -109,10 +160,21 @@ func (u *UserService) Get(ctx context.Context, req *usersService.GetReq) (*users
 
 // Find Users from an input query
 func (u *UserService) Find(ctx context.Context, req *usersService.FindReq) (*usersService.FindRes, error) {
-	// TODO NEXT FIX - valid req.GetQuery() authenticity / scope
-	users, err := u.userDB.UsersQuery(ctx, models.LoadUserFindProto(req), utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	filter := models.LoadUserFindProto(req)
+	tokenData, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	pagination := utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage()))
+	var users *models.UsersRes
+	if tokenData.RootAdmin {
+		users, err = u.userDB.UsersQuery(ctx, filter, pagination)
+	} else {
+		users, err = u.findMemberUsers(ctx, tokenData, filter, pagination)
+	}
 	if err != nil {
-		u.log.Errorf("userDB.UsersQuery: %v", err)
+		u.log.WithContext(ctx).Errorf("UserService.findMemberUsers: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &usersService.FindRes{
@@ -125,21 +187,51 @@ func (u *UserService) Find(ctx context.Context, req *usersService.FindReq) (*use
 	}, nil
 }
 
+// findMemberUsers finds the Users of a query that share a group with the requester
+func (u *UserService) findMemberUsers(ctx context.Context, tokenData *models.TokenData, filter *models.User, pagination *utilities.Pagination) (*models.UsersRes, error) {
+	if filter.GroupId != "" {
+		if _, err := models.VerifyGroupRequestScope(ctx, filter.GroupId); err != nil {
+			return nil, err
+		}
+	}
+	found, err := u.userDB.UsersFind(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	members := make(map[string]bool)
+	for _, groupId := range tokenData.GroupIds() {
+		memberships, err := u.membershipDB.MembershipsFind(ctx, &models.Membership{GroupId: groupId})
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range memberships {
+			members[m.UserId] = true
+		}
+	}
+	var users []*models.User
+	for _, fu := range found {
+		if tokenData.GroupRole(fu.GroupId) != "" || members[fu.Id] {
+			users = append(users, fu)
+		}
+	}
+	return models.NewUsersRes(users, pagination), nil
+}
+
 // GetGroupUsers returns the users for a given groupId
 func (u *UserService) GetGroupUsers(ctx context.Context, req *usersService.GetGroupUsersReq) (*usersService.GetGroupUsersRes, error) {
 	if !utilities.CheckObjectID(req.GetGroupId()) {
-		err := errors.New("invalid group id")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument("invalid group id")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	groupId, err := models.VerifyGroupRequestScope(ctx, req.GetGroupId())
 	if err != nil {
-		u.log.Errorf("models.VerifyGroupRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("models.VerifyGroupRequestScope: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	users, err := u.userDB.UsersQuery(ctx, &models.User{GroupId: groupId}, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
 	if err != nil {
-		u.log.Errorf("userDB.UsersQuery: %v", err)
+		u.log.WithContext(ctx).Errorf("userDB.UsersQuery: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	return &usersService.GetGroupUsersRes{
@@ -155,79 +247,260 @@ func (u *UserService) GetGroupUsers(ctx context.Context, req *usersService.GetGr
 // Delete is the handler function that deletes a user
 func (u *UserService) Delete(ctx context.Context, req *usersService.DeleteReq) (*usersService.DeleteRes, error) {
 	if !utilities.CheckObjectID(req.GetId()) {
-		err := errors.New(req.GetId() + " is an invalid userId")
-		u.log.Errorf("utilities.CheckObjectID: %v", err)
+		err := utilities.InvalidArgument(req.GetId() + " is an invalid userId")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
 	filter := models.User{Id: req.GetId()}
-	userScope, err := models.VerifyUserRequestScope(ctx, req.GetId(), "find")
+	user, _, err := u.verifyUserScope(ctx, req.GetId(), "find")
 	if err != nil {
-		u.log.Errorf("models.VerifyUserRequestScope: %v", err)
+		u.log.WithContext(ctx).Errorf("UserService.verifyUserScope: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	before := *user
+	if err = u.checkAdminOf(ctx, user); err != nil {
+		u.log.WithContext(ctx).Errorf("UserService.checkAdminOf: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	filter.LoadScope(userScope, "find")
-	user, err := u.userDB.UserFind(&filter)
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		err = u.deleteUserAssets(ctx, &before)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("userDB.deleteUserAssets: %v", err)
+			return err
+		}
+		user, err = u.userDB.UserDelete(ctx, &filter)
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("userDB.UserDelete: %v", err)
+			return err
+		}
+		return publishEvent(ctx, u.log, u.bus, models.EventUserDeleted, "user", before.Id, before.GroupId, before.Public())
+	})
 	if err != nil {
-		u.log.Errorf("userDB.UserFind: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	err = u.deleteUserAssets(user)
+	event := models.NewAuditEvent(ctx, models.AuditUserDelete, "user", before.Id, before.GroupId)
+	event.Changes = models.DiffAudit(&before, nil)
+	recordAudit(ctx, u.log, u.auditDB, event)
+	user.Password = ""
+	return &usersService.DeleteRes{User: user.ToProto()}, nil
+}
+
+// deleteUserAssets deletes the image, memberships, and tasks of a user, with the comments, activity, and attachments of
+// its tasks, in sequence, as they share the caller's unit of work
+func (u *UserService) deleteUserAssets(ctx context.Context, user *models.User) error {
+	if !user.CheckID("id") {
+		return utilities.InvalidArgument("filter id cannot be empty for mass delete")
+	}
+	if user.CheckID("image_id") {
+		_, err := u.fileDB.FileDelete(ctx, &models.File{OwnerId: user.Id, OwnerType: "user"})
+		if err != nil {
+			return err
+		}
+	}
+	_, err := u.membershipDB.MembershipDeleteMany(ctx, &models.Membership{UserId: user.Id})
+	if err != nil {
+		return err
+	}
+	tasks, err := u.taskDB.TasksFind(ctx, &models.Task{UserId: user.Id})
+	if err != nil {
+		return err
+	}
+	err = deleteTaskAssets(ctx, u.commentDB, u.fileDB, tasks)
 	if err != nil {
-		u.log.Errorf("userDB.deleteUserAssets: %v", err)
+		return err
+	}
+	_, err = u.taskDB.TaskDeleteMany(ctx, &models.Task{UserId: user.Id})
+	return err
+}
+
+// MoveUser moves a User to another primary group. The requester must be an admin of both groups. The user's tasks in
+// its former group move with it, or are reassigned to another user of that group; its files are owned by the user and
+// move with it. The user's tokens are revoked, as they are scoped to the former group.
+func (u *UserService) MoveUser(ctx context.Context, req *usersService.MoveUserReq) (*usersService.MoveUserRes, error) {
+	before, err := u.userDB.UserFind(ctx, &models.User{Id: req.GetId()})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("userDB.UserFind: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
-	user, err = u.userDB.UserDelete(&filter)
+	for _, groupId := range []string{before.GroupId, req.GetGroupId()} {
+		if _, err = models.VerifyGroupAdminScope(ctx, groupId); err != nil {
+			u.log.WithContext(ctx).Errorf("models.VerifyGroupAdminScope: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+	}
+	if before.GroupId == req.GetGroupId() {
+		err = utilities.InvalidField("group_id", "user is already in the group")
+		u.log.WithContext(ctx).Errorf("UserService.MoveUser: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	assigneeId := ""
+	if req.GetTasks() == usersService.TaskTransfer_REASSIGN {
+		if req.GetAssigneeId() == before.Id {
+			err = utilities.InvalidField("assignee_id", "the tasks cannot be reassigned to the moved user")
+			u.log.WithContext(ctx).Errorf("UserService.MoveUser: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+		assignee, err := u.userDB.UserFind(ctx, &models.User{Id: req.GetAssigneeId()})
+		if err == nil && assignee.GroupId != before.GroupId {
+			err = utilities.FailedPrecondition("ASSIGNEE_NOT_IN_GROUP", "the tasks can only be reassigned to a user of the former group")
+		}
+		if err != nil {
+			u.log.WithContext(ctx).Errorf("userDB.UserFind: %v", err)
+			return nil, utilities.ErrorResponse(err, err.Error())
+		}
+		assigneeId = assignee.Id
+	}
+	role := req.GetRole()
+	if role == "" {
+		role = "member"
+	}
+	user, tasks, err := u.relocateUser(ctx, before, &models.User{Id: before.Id, GroupId: req.GetGroupId(), Role: role}, assigneeId)
 	if err != nil {
-		u.log.Errorf("userDB.UserDelete: %v", err)
+		u.log.WithContext(ctx).Errorf("UserService.relocateUser: %v", err)
 		return nil, utilities.ErrorResponse(err, err.Error())
 	}
+	event := models.NewAuditEvent(ctx, models.AuditUserMove, "user", user.Id, user.GroupId)
+	event.Changes = models.DiffAudit(before, user)
+	event.Metadata = map[string]string{
+		"from_group_id": before.GroupId,
+		"task_count":    strconv.Itoa(len(tasks)),
+		"task_transfer": req.GetTasks().String(),
+	}
+	recordAudit(ctx, u.log, u.auditDB, event)
 	user.Password = ""
-	return &usersService.DeleteRes{User: user.ToProto()}, nil
+	return &usersService.MoveUserRes{User: user.ToProto(), TaskCount: int64(len(tasks))}, nil
 }
 
-// deleteUserAssets asynchronously gets a group and its users from the database
-func (u *UserService) deleteUserAssets(user *models.User) error {
-	if !user.CheckID("id") {
-		return errors.New("filter id cannot be empty for mass delete")
-	}
-	ctx, cancel := context.WithCancel(context.Background())
-	errChan := make(chan error)
-	defer func() {
-		cancel()
-		close(errChan)
-	}()
-	go func() {
-		if user.CheckID("image_id") {
-			_, err := u.fileDB.FileDelete(&models.File{OwnerId: user.Id, OwnerType: "user"})
-			select {
-			case <-ctx.Done():
-				return
-			default:
+// relocateUser applies an update that changes the primary group of a User in a unit of work. The user's tasks in its
+// former group move with it, or are reassigned to assigneeId when given; a membership of the new group is replaced by
+// the primary group; and the user's tokens are revoked. The events of the move are published in the same unit of work.
+func (u *UserService) relocateUser(ctx context.Context, before *models.User, update *models.User, assigneeId string) (user *models.User, moved []*models.Task, err error) {
+	if err = checkLastAdmin(ctx, u.userDB, u.membershipDB, before.Id, before.GroupId); err != nil {
+		return nil, nil, err
+	}
+	memberships, err := u.membershipDB.MembershipsFind(ctx, &models.Membership{UserId: before.Id})
+	if err != nil {
+		return nil, nil, err
+	}
+	if !models.InGroup(before, memberships, update.GroupId) { // a member already counts toward the new group's users
+		if err = u.quota.CheckUsers(ctx, update.GroupId); err != nil {
+			return nil, nil, err
+		}
+	}
+	tasks, err := u.taskDB.TasksFind(ctx, &models.Task{UserId: before.Id})
+	if err != nil {
+		return nil, nil, err
+	}
+	update.TokensRevokedAt = time.Now().UTC()
+	err = u.uow.WithTransaction(ctx, func(ctx context.Context) (err error) {
+		moved = nil
+		user, err = u.userDB.UserUpdate(ctx, update)
+		if err != nil {
+			return err
+		}
+		for _, task := range tasks {
+			if task.GroupId != before.GroupId {
+				continue
 			}
-			errChan <- err
-		} else {
-			errChan <- nil
+			change := &models.Task{Id: task.Id, GroupId: user.GroupId}
+			if assigneeId != "" {
+				change = &models.Task{Id: task.Id, UserId: assigneeId}
+			}
+			if task, err = u.taskDB.TaskUpdate(ctx, change); err != nil {
+				return err
+			}
+			moved = append(moved, task)
 		}
-	}()
-	go func() {
-		_, err := u.taskDB.TaskDeleteMany(&models.Task{UserId: user.Id})
-		select {
-		case <-ctx.Done():
-			return
-		default:
-		}
-		errChan <- err
-	}()
-	var err error
-	for i := 0; i < 2; i++ {
-		select {
-		case err = <-errChan:
-			if err != nil {
-				break
+		memberships, err := u.membershipDB.MembershipsFind(ctx, &models.Membership{UserId: user.Id})
+		if err != nil {
+			return err
+		}
+		for _, m := range memberships {
+			if m.GroupId != user.GroupId {
+				continue
+			}
+			if _, err = u.membershipDB.MembershipDelete(ctx, m); err != nil {
+				return err
 			}
 		}
+		if err = publishEvent(ctx, u.log, u.bus, models.EventUserJoinedGroup, "user", user.Id, user.GroupId, user.Public()); err != nil {
+			return err
+		}
+		eventType := models.EventTaskUpdated
+		if assigneeId != "" {
+			eventType = models.EventTaskReassigned
+		}
+		for _, task := range moved {
+			if err = publishEvent(ctx, u.log, u.bus, eventType, "task", task.Id, task.GroupId, task); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// verifyUserScope finds a User with its Memberships and returns it with the scope the request has over it
+func (u *UserService) verifyUserScope(ctx context.Context, userId string, scopeType string) (*models.User, *models.User, error) {
+	user, err := u.userDB.UserFind(ctx, &models.User{Id: userId})
+	if err != nil {
+		return nil, nil, err
 	}
-	return err
+	memberships, err := u.membershipDB.MembershipsFind(ctx, &models.Membership{UserId: userId})
+	if err != nil {
+		return nil, nil, err
+	}
+	userScope, err := models.VerifyUserRequestScope(ctx, user, memberships, scopeType)
+	if err != nil {
+		return nil, nil, err
+	}
+	return user, userScope, nil
+}
+
+// checkAdminOf returns a LAST_ADMIN error when a User is the only admin of its primary group or of a group it is a member
+// of
+func (u *UserService) checkAdminOf(ctx context.Context, user *models.User) error {
+	memberships, err := u.membershipDB.MembershipsFind(ctx, &models.Membership{UserId: user.Id})
+	if err != nil {
+		return err
+	}
+	memberships = append(memberships, models.PrimaryMembership(user))
+	for _, m := range memberships {
+		if m.Role != "admin" {
+			continue
+		}
+		if err = checkLastAdmin(ctx, u.userDB, u.membershipDB, user.Id, m.GroupId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkLastAdmin returns a LAST_ADMIN error when a User is the only admin of a group, which must first transfer its
+// admin role with GroupService.TransferAdmin
+func checkLastAdmin(ctx context.Context, userDB UserDataService, membershipDB MembershipDataService, userId string, groupId string) error {
+	users, err := userDB.UsersFind(ctx, &models.User{GroupId: groupId})
+	if err != nil {
+		return err
+	}
+	memberships, err := membershipDB.MembershipsFind(ctx, &models.Membership{GroupId: groupId})
+	if err != nil {
+		return err
+	}
+	for _, gu := range users {
+		memberships = append(memberships, models.PrimaryMembership(gu))
+	}
+	isAdmin, admins := false, 0
+	for _, m := range memberships {
+		if m.Role == "admin" {
+			admins++
+			isAdmin = isAdmin || m.UserId == userId
+		}
+	}
+	if isAdmin && admins == 1 {
+		return utilities.FailedPrecondition("LAST_ADMIN", "transfer the admin role of the group before removing its last admin")
+	}
+	return nil
 }
 
 /*
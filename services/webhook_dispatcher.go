@@ -0,0 +1,206 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/JECSand/go-grpc-server-boilerplate/config"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Headers sent with every webhook delivery
+const (
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+)
+
+// deliveryBatchSize is the maximum number of due webhook deliveries attempted per run
+const deliveryBatchSize = 100
+
+// WebhookDispatcher fans DomainEvents out to subscribed webhooks and delivers them with retries
+type WebhookDispatcher struct {
+	log       utilities.Logger
+	webhookDB WebhookDataService
+	client    *http.Client
+	cfg       *config.Store
+}
+
+// NewWebhookDispatcher constructs a WebhookDispatcher that reads the events configuration on every delivery, so that
+// reloaded delivery settings apply to the next attempt. Its client connects to public addresses only, unless
+// Events.WebhookAllowPrivate is set, and never follows redirects, which would bypass that check
+func NewWebhookDispatcher(log utilities.Logger, w WebhookDataService, cfg *config.Store) *WebhookDispatcher {
+	d := &WebhookDispatcher{
+		log:       log,
+		webhookDB: w,
+		cfg:       cfg,
+	}
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: d.checkAddress}
+	d.client = &http.Client{
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse // the redirect response fails the delivery
+		},
+	}
+	return d
+}
+
+// checkAddress refuses connections to loopback, private, link-local, multicast, and unspecified addresses, after the
+// webhook host is resolved, unless Events.WebhookAllowPrivate is set
+func (d *WebhookDispatcher) checkAddress(network string, address string, c syscall.RawConn) error {
+	if d.cfg.Get().Events.WebhookAllowPrivate {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("webhook address %s is not a public address", host)
+	}
+	return nil
+}
+
+// maxAttempts returns the number of attempts a delivery gets before it is dead lettered
+func (d *WebhookDispatcher) maxAttempts() int {
+	if n := d.cfg.Get().Events.WebhookMaxAttempts; n > 0 {
+		return n
+	}
+	return 1
+}
+
+// HandleEvent schedules a delivery of a DomainEvent to every webhook of its group subscribed to the event type
+func (d *WebhookDispatcher) HandleEvent(ctx context.Context, e *models.DomainEvent) error {
+	if !utilities.CheckObjectID(e.GroupId) {
+		return nil
+	}
+	hooks, err := d.webhookDB.WebhooksFind(ctx, &models.Webhook{GroupId: e.GroupId})
+	if err != nil {
+		return err
+	}
+	body, err := e.Envelope()
+	if err != nil {
+		return err
+	}
+	for _, h := range hooks {
+		if h.GroupId != e.GroupId || !h.Subscribed(e.Type) {
+			continue
+		}
+		_, err = d.webhookDB.DeliveryCreate(ctx, &models.WebhookDelivery{
+			WebhookId: h.Id,
+			GroupId:   h.GroupId,
+			EventId:   e.Id,
+			EventType: e.Type,
+			Payload:   string(body),
+			Status:    models.DeliveryPending,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ProcessDue attempts every pending delivery that is due and returns how many were attempted
+func (d *WebhookDispatcher) ProcessDue(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	deliveries, err := d.webhookDB.DeliveriesDue(ctx, now, deliveryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	for _, del := range deliveries {
+		d.attempt(ctx, del, now)
+		if _, err = d.webhookDB.DeliveryUpdate(ctx, del); err != nil {
+			return 0, err
+		}
+	}
+	return len(deliveries), nil
+}
+
+// attempt sends a delivery and records its outcome, scheduling a retry with exponential backoff on failure
+func (d *WebhookDispatcher) attempt(ctx context.Context, del *models.WebhookDelivery, now time.Time) {
+	del.Attempts++
+	del.ResponseCode, del.LastError = 0, ""
+	hook, err := d.webhookDB.WebhookFind(ctx, &models.Webhook{Id: del.WebhookId})
+	if err == nil && hook.Disabled {
+		err = errors.New("webhook is disabled")
+	}
+	if err == nil {
+		del.ResponseCode, err = d.send(ctx, hook, del)
+	}
+	if err == nil {
+		del.Status = models.DeliverySucceeded
+		return
+	}
+	del.LastError = err.Error()
+	if del.Attempts >= d.maxAttempts() {
+		del.Status = models.DeliveryDead
+		d.log.Warnf("webhook delivery %s moved to dead letters after %d attempts: %s", del.Id, del.Attempts, del.LastError)
+		return
+	}
+	backoff := d.cfg.Get().Events.WebhookBackoff * time.Second
+	del.NextAttemptAt = now.Add(backoff * time.Duration(1<<(del.Attempts-1)))
+}
+
+// send POSTs a signed delivery to the webhook URL and returns the response status code
+func (d *WebhookDispatcher) send(ctx context.Context, hook *models.Webhook, del *models.WebhookDelivery) (int, error) {
+	body := []byte(del.Payload)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.Get().Events.WebhookTimeout*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, del.EventType)
+	req.Header.Set(WebhookDeliveryHeader, del.Id)
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, "sha256="+utilities.SignPayload(hook.Secret, timestamp, body))
+	res, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<16))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook endpoint responded with status %d", res.StatusCode)
+	}
+	return res.StatusCode, nil
+}
+
+// Run attempts due webhook deliveries every interval until ctx is cancelled
+func (d *WebhookDispatcher) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := d.ProcessDue(ctx); err != nil {
+			d.log.Errorf("WebhookDispatcher.ProcessDue: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
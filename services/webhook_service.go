@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"github.com/JECSand/go-grpc-server-boilerplate/models"
+	webhooksService "github.com/JECSand/go-grpc-server-boilerplate/protos/webhook"
+	"github.com/JECSand/go-grpc-server-boilerplate/utilities"
+	"time"
+)
+
+// WebhookService gRPC Service
+type WebhookService struct {
+	log       utilities.Logger
+	webhookDB WebhookDataService
+}
+
+// NewWebhookService constructs a WebhookService for controller gRPC service Webhook requests
+func NewWebhookService(log utilities.Logger, w WebhookDataService) *WebhookService {
+	return &WebhookService{
+		log:       log,
+		webhookDB: w,
+	}
+}
+
+// scopedGroupId returns the group a request operates on, restricted to the requester's group unless they are a root admin
+func (u *WebhookService) scopedGroupId(ctx context.Context, groupId string) (string, error) {
+	tokenClaims, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !tokenClaims.RootAdmin || !utilities.CheckObjectID(groupId) {
+		return tokenClaims.GroupId, nil
+	}
+	return groupId, nil
+}
+
+// findScoped loads a Webhook and verifies the requester may manage it
+func (u *WebhookService) findScoped(ctx context.Context, id string) (*models.Webhook, error) {
+	if !utilities.CheckObjectID(id) {
+		return nil, utilities.InvalidArgument(id + " is an invalid webhookId")
+	}
+	tokenClaims, err := models.LoadTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hook, err := u.webhookDB.WebhookFind(ctx, &models.Webhook{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if !tokenClaims.RootAdmin && hook.GroupId != tokenClaims.GroupId {
+		return nil, utilities.NotFound("webhook", id)
+	}
+	return hook, nil
+}
+
+// Create a New Webhook, returning its signing secret once
+func (u *WebhookService) Create(ctx context.Context, req *webhooksService.CreateReq) (*webhooksService.CreateRes, error) {
+	hook := models.LoadWebhookCreateProto(req)
+	groupId, err := u.scopedGroupId(ctx, hook.GroupId)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	hook.GroupId = groupId
+	hook, err = u.webhookDB.WebhookCreate(ctx, hook)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("webhookDB.WebhookCreate: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &webhooksService.CreateRes{Webhook: hook.ToProto(), Secret: hook.Secret}, nil
+}
+
+// Update a Webhook
+func (u *WebhookService) Update(ctx context.Context, req *webhooksService.UpdateReq) (*webhooksService.UpdateRes, error) {
+	if _, err := u.findScoped(ctx, req.GetId()); err != nil {
+		u.log.WithContext(ctx).Errorf("WebhookService.findScoped: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	hook, err := u.webhookDB.WebhookUpdate(ctx, models.LoadWebhookUpdateProto(req))
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("webhookDB.WebhookUpdate: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &webhooksService.UpdateRes{Webhook: hook.ToProto()}, nil
+}
+
+// Get a specific Webhook
+func (u *WebhookService) Get(ctx context.Context, req *webhooksService.GetReq) (*webhooksService.GetRes, error) {
+	hook, err := u.findScoped(ctx, req.GetId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("WebhookService.findScoped: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &webhooksService.GetRes{Webhook: hook.ToProto()}, nil
+}
+
+// Find Webhooks registered for a group
+func (u *WebhookService) Find(ctx context.Context, req *webhooksService.FindReq) (*webhooksService.FindRes, error) {
+	groupId, err := u.scopedGroupId(ctx, req.GetGroupId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	hooks, err := u.webhookDB.WebhooksQuery(ctx, &models.Webhook{GroupId: groupId}, utilities.NewPaginationQuery(int(req.GetSize()), int(req.GetPage())))
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("webhookDB.WebhooksQuery: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &webhooksService.FindRes{
+		TotalCount: hooks.TotalCount,
+		TotalPages: hooks.TotalPages,
+		Page:       hooks.Page,
+		Size:       hooks.Size,
+		HasMore:    hooks.HasMore,
+		Webhooks:   hooks.ToProto(),
+	}, nil
+}
+
+// Delete a Webhook
+func (u *WebhookService) Delete(ctx context.Context, req *webhooksService.DeleteReq) (*webhooksService.DeleteRes, error) {
+	hook, err := u.findScoped(ctx, req.GetId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("WebhookService.findScoped: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	hook, err = u.webhookDB.WebhookDelete(ctx, &models.Webhook{Id: hook.Id})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("webhookDB.WebhookDelete: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &webhooksService.DeleteRes{Webhook: hook.ToProto()}, nil
+}
+
+// GetDeliveries returns the delivery history of a Webhook, newest first
+func (u *WebhookService) GetDeliveries(ctx context.Context, req *webhooksService.GetDeliveriesReq) (*webhooksService.GetDeliveriesRes, error) {
+	hook, err := u.findScoped(ctx, req.GetWebhookId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("WebhookService.findScoped: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	filter := &models.WebhookDelivery{WebhookId: hook.Id, Status: req.GetStatus()}
+	return u.queryDeliveries(ctx, filter, req.GetSize(), req.GetPage())
+}
+
+// GetDeadLetters returns the deliveries of a group that exhausted their retries
+func (u *WebhookService) GetDeadLetters(ctx context.Context, req *webhooksService.GetDeadLettersReq) (*webhooksService.GetDeliveriesRes, error) {
+	groupId, err := u.scopedGroupId(ctx, req.GetGroupId())
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("models.LoadTokenFromContext: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	filter := &models.WebhookDelivery{GroupId: groupId, Status: models.DeliveryDead}
+	return u.queryDeliveries(ctx, filter, req.GetSize(), req.GetPage())
+}
+
+// queryDeliveries runs a paginated delivery search and converts it to a GetDeliveriesRes
+func (u *WebhookService) queryDeliveries(ctx context.Context, filter *models.WebhookDelivery, size int64, page int64) (*webhooksService.GetDeliveriesRes, error) {
+	deliveries, err := u.webhookDB.DeliveriesQuery(ctx, filter, utilities.NewPaginationQuery(int(size), int(page)))
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("webhookDB.DeliveriesQuery: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &webhooksService.GetDeliveriesRes{
+		TotalCount: deliveries.TotalCount,
+		TotalPages: deliveries.TotalPages,
+		Page:       deliveries.Page,
+		Size:       deliveries.Size,
+		HasMore:    deliveries.HasMore,
+		Deliveries: deliveries.ToProto(),
+	}, nil
+}
+
+// Redeliver resets a dead lettered delivery so it is attempted again on the next dispatch
+func (u *WebhookService) Redeliver(ctx context.Context, req *webhooksService.RedeliverReq) (*webhooksService.RedeliverRes, error) {
+	if !utilities.CheckObjectID(req.GetDeliveryId()) {
+		err := utilities.InvalidArgument(req.GetDeliveryId() + " is an invalid deliveryId")
+		u.log.WithContext(ctx).Errorf("utilities.CheckObjectID: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	delivery, err := u.webhookDB.DeliveryFind(ctx, &models.WebhookDelivery{Id: req.GetDeliveryId()})
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("webhookDB.DeliveryFind: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if _, err = u.findScoped(ctx, delivery.WebhookId); err != nil {
+		u.log.WithContext(ctx).Errorf("WebhookService.findScoped: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	if delivery.Status != models.DeliveryDead {
+		err = utilities.FailedPrecondition("DELIVERY_NOT_DEAD_LETTERED", "only dead lettered deliveries can be redelivered")
+		u.log.WithContext(ctx).Errorf("WebhookService.Redeliver: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	delivery.Status = models.DeliveryPending
+	delivery.Attempts = 0
+	delivery.LastError = ""
+	delivery.ResponseCode = 0
+	delivery.NextAttemptAt = time.Now().UTC()
+	delivery, err = u.webhookDB.DeliveryUpdate(ctx, delivery)
+	if err != nil {
+		u.log.WithContext(ctx).Errorf("webhookDB.DeliveryUpdate: %v", err)
+		return nil, utilities.ErrorResponse(err, err.Error())
+	}
+	return &webhooksService.RedeliverRes{Delivery: delivery.ToProto()}, nil
+}
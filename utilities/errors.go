@@ -2,56 +2,161 @@ package utilities
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
 	"net/http"
 	"strings"
+	"unicode"
 )
 
+// ErrorDomain is the domain of the errdetails.ErrorInfo attached to error responses
+const ErrorDomain = "go-grpc-server-boilerplate"
+
+// Sentinel errors; every DomainError matches the sentinel of its kind with errors.Is
 var (
-	ErrNotFound         = errors.New("Not found")
-	ErrNoCtxMetaData    = errors.New("No ctx metadata")
-	ErrInvalidSessionId = errors.New("Invalid session id")
-	ErrEmailExists      = errors.New("Email already exists")
+	ErrNotFound           = errors.New("Not found")
+	ErrConflict           = errors.New("Conflict")
+	ErrPermissionDenied   = errors.New("Permission denied")
+	ErrUnauthenticated    = errors.New("Unauthenticated")
+	ErrInvalidArgument    = errors.New("Invalid argument")
+	ErrFailedPrecondition = errors.New("Failed precondition")
+	ErrNoCtxMetaData      = errors.New("No ctx metadata")
+	ErrInvalidSessionId   = errors.New("Invalid session id")
+	ErrEmailExists        = errors.New("Email already exists")
+	ErrQuotaExceeded      = errors.New("Quota exceeded")
 )
 
+// DomainError is an error of a known kind, such as ErrNotFound, with the machine readable reason and resource that
+// clients receive as errdetails.ErrorInfo and errdetails.ResourceInfo
+type DomainError struct {
+	Kind         error  // the sentinel error the DomainError matches
+	Reason       string // UPPER_SNAKE_CASE reason, e.g. TASK_NOT_FOUND
+	ResourceType string
+	ResourceName string
+	Msg          string
+	Err          error // the underlying error, if any
+}
+
+// Error returns the message of the DomainError
+func (e *DomainError) Error() string {
+	if e.Err != nil {
+		return e.Msg + ": " + e.Err.Error()
+	}
+	return e.Msg
+}
+
+// Is reports whether target is the kind of the DomainError
+func (e *DomainError) Is(target error) bool {
+	return target == e.Kind
+}
+
+// Unwrap returns the underlying error
+func (e *DomainError) Unwrap() error {
+	return e.Err
+}
+
+// Wrap sets the underlying error of the DomainError and returns it
+func (e *DomainError) Wrap(err error) *DomainError {
+	e.Err = err
+	return e
+}
+
+// NotFound returns a DomainError for a resource that does not exist; name identifies the resource and may be empty
+func NotFound(resourceType string, name string) *DomainError {
+	return &DomainError{
+		Kind:         ErrNotFound,
+		Reason:       strings.ToUpper(resourceType) + "_NOT_FOUND",
+		ResourceType: resourceType,
+		ResourceName: name,
+		Msg:          resourceType + " not found",
+	}
+}
+
+// Conflict returns a DomainError for a resource that clashes with an existing one
+func Conflict(resourceType string, name string, msg string) *DomainError {
+	return &DomainError{
+		Kind:         ErrConflict,
+		Reason:       strings.ToUpper(resourceType) + "_ALREADY_EXISTS",
+		ResourceType: resourceType,
+		ResourceName: name,
+		Msg:          msg,
+	}
+}
+
+// PermissionDenied returns a DomainError for a caller that may not perform an operation
+func PermissionDenied(msg string) *DomainError {
+	return &DomainError{Kind: ErrPermissionDenied, Reason: "PERMISSION_DENIED", Msg: msg}
+}
+
+// Unauthenticated returns a DomainError for a caller without valid credentials
+func Unauthenticated(msg string) *DomainError {
+	return &DomainError{Kind: ErrUnauthenticated, Reason: "UNAUTHENTICATED", Msg: msg}
+}
+
+// InvalidArgument returns a DomainError for a malformed request
+func InvalidArgument(msg string) *DomainError {
+	return &DomainError{Kind: ErrInvalidArgument, Reason: "INVALID_ARGUMENT", Msg: msg}
+}
+
+// FailedPrecondition returns a DomainError for an operation the current state of the system does not allow
+func FailedPrecondition(reason string, msg string) *DomainError {
+	return &DomainError{Kind: ErrFailedPrecondition, Reason: reason, Msg: msg}
+}
+
 // ParseGRPCErrStatusCode Parse error and get code
 func ParseGRPCErrStatusCode(err error) codes.Code {
+	var vErr *ValidationError
+	var sErr interface{ GRPCStatus() *status.Status }
 	switch {
-	case errors.Is(err, sql.ErrNoRows):
-		return codes.NotFound
 	case errors.Is(err, context.Canceled):
 		return codes.Canceled
 	case errors.Is(err, context.DeadlineExceeded):
 		return codes.DeadlineExceeded
-	case errors.Is(err, ErrEmailExists):
+	case errors.Is(err, ErrNotFound), errors.Is(err, mongo.ErrNoDocuments), errors.Is(err, gridfs.ErrFileNotFound):
+		return codes.NotFound
+	case errors.Is(err, ErrConflict), errors.Is(err, ErrEmailExists), mongo.IsDuplicateKeyError(err):
 		return codes.AlreadyExists
-	case errors.Is(err, ErrNoCtxMetaData):
+	case errors.Is(err, ErrQuotaExceeded):
+		return codes.ResourceExhausted
+	case errors.Is(err, ErrUnauthenticated), errors.Is(err, ErrNoCtxMetaData):
 		return codes.Unauthenticated
-	case errors.Is(err, ErrInvalidSessionId):
+	case errors.Is(err, ErrPermissionDenied), errors.Is(err, ErrInvalidSessionId):
 		return codes.PermissionDenied
-	case strings.Contains(err.Error(), "Validate"):
+	case errors.Is(err, ErrInvalidArgument), errors.As(err, &vErr):
 		return codes.InvalidArgument
-	case strings.Contains(err.Error(), "redis"):
-		return codes.NotFound
-	case errors.Is(err, context.Canceled):
-		return codes.Canceled
-	case errors.Is(err, context.DeadlineExceeded):
-		return codes.DeadlineExceeded
+	case errors.Is(err, ErrFailedPrecondition):
+		return codes.FailedPrecondition
+	case errors.As(err, &sErr):
+		return sErr.GRPCStatus().Code()
 	}
 	return codes.Internal
 }
 
+// reasonForCode returns the UPPER_SNAKE_CASE name of a gRPC code, e.g. ALREADY_EXISTS
+func reasonForCode(code codes.Code) string {
+	var b strings.Builder
+	for i, r := range code.String() {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
 // MapGRPCErrCodeToHttpStatus Map GRPC errors codes to http status
 func MapGRPCErrCodeToHttpStatus(code codes.Code) int {
 	switch code {
 	case codes.Unauthenticated:
 		return http.StatusUnauthorized
 	case codes.AlreadyExists:
-		return http.StatusBadRequest
+		return http.StatusConflict
 	case codes.NotFound:
 		return http.StatusNotFound
 	case codes.Internal:
@@ -64,11 +169,39 @@ func MapGRPCErrCodeToHttpStatus(code codes.Code) int {
 		return http.StatusGatewayTimeout
 	case codes.InvalidArgument:
 		return http.StatusBadRequest
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
 	}
 	return http.StatusInternalServerError
 }
 
-// ErrorResponse GRPC Error response
+// ErrorResponse GRPC Error response. Every response carries an errdetails.ErrorInfo whose reason clients can branch on;
+// DomainErrors about a resource add an errdetails.ResourceInfo, and validation errors an errdetails.BadRequest.
 func ErrorResponse(err error, msg string) error {
-	return status.Errorf(ParseGRPCErrStatusCode(err), fmt.Sprintf("%s: %v", msg, err))
+	code := ParseGRPCErrStatusCode(err)
+	st := status.New(code, fmt.Sprintf("%s: %v", msg, err))
+	info := &errdetails.ErrorInfo{Reason: reasonForCode(code), Domain: ErrorDomain}
+	details := []protoiface.MessageV1{info}
+	var dErr *DomainError
+	var vErr *ValidationError
+	if errors.As(err, &dErr) {
+		info.Reason = dErr.Reason
+		if dErr.ResourceType != "" {
+			info.Metadata = map[string]string{"resource_type": dErr.ResourceType}
+			details = append(details, &errdetails.ResourceInfo{ResourceType: dErr.ResourceType, ResourceName: dErr.ResourceName})
+		}
+	}
+	if errors.As(err, &vErr) {
+		details = append(details, &errdetails.BadRequest{FieldViolations: vErr.Violations})
+	}
+	if detailed, wErr := st.WithDetails(details...); wErr == nil {
+		st = detailed
+	}
+	return st.Err()
 }
@@ -1,6 +1,8 @@
 package utilities
 
 import (
+	"context"
+	"errors"
 	"github.com/JECSand/go-grpc-server-boilerplate/config"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -23,17 +25,35 @@ type Logger interface {
 	Fatal(args ...interface{})
 	Fatalf(template string, args ...interface{})
 	Printf(template string, args ...interface{})
+	With(args ...interface{}) Logger
+	WithContext(ctx context.Context) Logger
+	SetLevel(level string) error
+}
+
+// loggerCtxKey is the context key a request-scoped Logger is stored under
+type loggerCtxKey struct{}
+
+// ContextWithLogger returns a copy of ctx carrying a request-scoped Logger
+func ContextWithLogger(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey{}, l)
+}
+
+// LoggerFromContext returns the request-scoped Logger carried by ctx, if any
+func LoggerFromContext(ctx context.Context) (Logger, bool) {
+	l, ok := ctx.Value(loggerCtxKey{}).(Logger)
+	return l, ok
 }
 
 // Logger
 type apiLogger struct {
 	cfg         *config.Configuration
+	level       zap.AtomicLevel
 	sugarLogger *zap.SugaredLogger
 }
 
 // NewAPILogger Logger constructor
 func NewAPILogger(cfg *config.Configuration) *apiLogger {
-	return &apiLogger{cfg: cfg}
+	return &apiLogger{cfg: cfg, level: zap.NewAtomicLevel()}
 }
 
 // For mapping config logger to email_service logger levels
@@ -47,6 +67,16 @@ var loggerLevelMap = map[string]zapcore.Level{
 	"fatal":  zapcore.FatalLevel,
 }
 
+// SetLevel changes the level of the Logger and every Logger derived from it while they are in use
+func (l *apiLogger) SetLevel(level string) error {
+	zapLevel, exist := loggerLevelMap[level]
+	if !exist {
+		return errors.New("unknown logger level: " + level)
+	}
+	l.level.SetLevel(zapLevel)
+	return nil
+}
+
 func (l *apiLogger) getLoggerLevel(cfg *config.Configuration) zapcore.Level {
 	level, exist := loggerLevelMap[cfg.Logger.Level]
 	if !exist {
@@ -57,7 +87,7 @@ func (l *apiLogger) getLoggerLevel(cfg *config.Configuration) zapcore.Level {
 
 // InitLogger Init logger
 func (l *apiLogger) InitLogger() {
-	logLevel := l.getLoggerLevel(l.cfg)
+	l.level.SetLevel(l.getLoggerLevel(l.cfg))
 	logWriter := zapcore.AddSync(os.Stderr)
 	var encoderCfg zapcore.EncoderConfig
 	if l.cfg.ENV == "production" {
@@ -77,7 +107,7 @@ func (l *apiLogger) InitLogger() {
 		encoder = zapcore.NewJSONEncoder(encoderCfg)
 	}
 	encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
-	core := zapcore.NewCore(encoder, logWriter, zap.NewAtomicLevelAt(logLevel))
+	core := zapcore.NewCore(encoder, logWriter, l.level)
 	logger := zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1))
 	l.sugarLogger = logger.Sugar()
 	if err := l.sugarLogger.Sync(); err != nil {
@@ -85,6 +115,19 @@ func (l *apiLogger) InitLogger() {
 	}
 }
 
+// With returns a child Logger that adds the input key-value pairs to every log line
+func (l *apiLogger) With(args ...interface{}) Logger {
+	return &apiLogger{cfg: l.cfg, level: l.level, sugarLogger: l.sugarLogger.With(RedactKeysAndValues(args...)...)}
+}
+
+// WithContext returns the request-scoped Logger carried by ctx, falling back to the receiver
+func (l *apiLogger) WithContext(ctx context.Context) Logger {
+	if reqLog, ok := LoggerFromContext(ctx); ok {
+		return reqLog
+	}
+	return l
+}
+
 // Debug Logger method
 func (l *apiLogger) Debug(args ...interface{}) {
 	l.sugarLogger.Debug(args...)
@@ -0,0 +1,74 @@
+package utilities
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RetryAfterHeader is the metadata key that tells rate limited clients how many seconds to wait before retrying
+const RetryAfterHeader = "retry-after"
+
+// rateLimitSweepInterval is how often the MemoryRateLimitStore drops buckets that have refilled completely
+const rateLimitSweepInterval = time.Minute
+
+// RateLimitStore keeps the token buckets of the rate limiter. Implementations backed by a shared store let every server
+// instance draw from the same buckets.
+type RateLimitStore interface {
+	// Take removes a token from the bucket under key, which refills rate tokens per second up to burst. It returns
+	// whether a token was available and, when none was, how long until one will be
+	Take(ctx context.Context, key string, rate float64, burst int) (allowed bool, retryAfter time.Duration, err error)
+}
+
+// tokenBucket is the state of a single bucket of the MemoryRateLimitStore
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Duration // time an empty bucket takes to refill completely
+}
+
+// MemoryRateLimitStore is a RateLimitStore that keeps its buckets in process memory
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// NewMemoryRateLimitStore constructs a MemoryRateLimitStore
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{buckets: make(map[string]*tokenBucket), lastSweep: time.Now()}
+}
+
+// Take removes a token from the bucket under key
+func (s *MemoryRateLimitStore) Take(ctx context.Context, key string, rate float64, burst int) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if now.Sub(s.lastSweep) >= rateLimitSweepInterval {
+		s.sweep(now)
+	}
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: float64(burst), last: now}
+		s.buckets[key] = b
+	}
+	b.full = time.Duration(float64(burst) / rate * float64(time.Second))
+	b.tokens = math.Min(float64(burst), b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+	return false, time.Duration((1 - b.tokens) / rate * float64(time.Second)), nil
+}
+
+// sweep drops the buckets that have been idle long enough to refill completely, as they equal a new bucket
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	for key, b := range s.buckets {
+		if now.Sub(b.last) >= b.full {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastSweep = now
+}
@@ -0,0 +1,63 @@
+package utilities
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func Test_MemoryRateLimitStoreTake(t *testing.T) {
+	// Defining our test slice. Each unit test should have the following properties:
+	tests := []struct {
+		name      string        // The name of the test
+		takes     int           // How many tokens are taken from the bucket before the checked one
+		key       string        // The key of the checked take
+		wantAllow bool          // whether we want the checked take to be allowed
+		wantRetry time.Duration // The retry-after we want, to the second
+	}{
+		// Here we're declaring each unit test input and output data as defined before
+		{"within burst", 1, "ip:203.0.113.7", true, 0},
+		{"burst spent", 2, "ip:203.0.113.7", false, 100 * time.Second},
+		{"other key", 2, "ip:198.51.100.4", true, 0},
+	}
+	// Iterating over the previous test slice
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewMemoryRateLimitStore()
+			ctx := context.Background()
+			for i := 0; i < tt.takes; i++ {
+				if _, _, err := s.Take(ctx, "ip:203.0.113.7", 0.01, 2); err != nil {
+					t.Fatalf("MemoryRateLimitStore.Take() error = %v", err)
+				}
+			}
+			allowed, retryAfter, err := s.Take(ctx, tt.key, 0.01, 2)
+			// Checking the error
+			if err != nil {
+				t.Errorf("MemoryRateLimitStore.Take() error = %v", err)
+				return
+			}
+			if allowed != tt.wantAllow || retryAfter.Round(time.Second) != tt.wantRetry { // Asserting whether we get the correct wanted value
+				t.Errorf("MemoryRateLimitStore.Take() = %v, %v, want %v, %v", allowed, retryAfter, tt.wantAllow, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func Test_MemoryRateLimitStoreSweep(t *testing.T) {
+	s := NewMemoryRateLimitStore()
+	ctx := context.Background()
+	if _, _, err := s.Take(ctx, "idle", 1000, 1); err != nil {
+		t.Fatalf("MemoryRateLimitStore.Take() error = %v", err)
+	}
+	if _, _, err := s.Take(ctx, "busy", 0.01, 1); err != nil {
+		t.Fatalf("MemoryRateLimitStore.Take() error = %v", err)
+	}
+	time.Sleep(2 * time.Millisecond) // the idle bucket refills completely in a millisecond
+	s.sweep(time.Now())
+	if _, ok := s.buckets["idle"]; ok {
+		t.Errorf("MemoryRateLimitStore.sweep() kept a bucket that refilled completely")
+	}
+	if _, ok := s.buckets["busy"]; !ok {
+		t.Errorf("MemoryRateLimitStore.sweep() dropped a bucket that has not refilled")
+	}
+}
@@ -0,0 +1,82 @@
+package utilities
+
+import (
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+// RedactedValue replaces sensitive values before they are written to logs
+const RedactedValue = "[REDACTED]"
+
+// sensitiveKeys are lowercase fragments of metadata, field, and log keys whose values must never be logged
+var sensitiveKeys = []string{"authorization", "password", "token", "secret", "apikey", "api_key", "cookie"}
+
+// IsSensitiveKey determines whether a metadata, proto field, or log key holds a secret value
+func IsSensitiveKey(key string) bool {
+	k := strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactMetadata returns a copy of gRPC metadata with the values of sensitive keys replaced
+func RedactMetadata(md metadata.MD) metadata.MD {
+	out := make(metadata.MD, len(md))
+	for k, vals := range md {
+		if IsSensitiveKey(k) {
+			out[k] = []string{RedactedValue}
+			continue
+		}
+		out[k] = vals
+	}
+	return out
+}
+
+// RedactKeysAndValues replaces the values of sensitive keys in a loosely typed key-value list
+func RedactKeysAndValues(args ...interface{}) []interface{} {
+	out := make([]interface{}, len(args))
+	copy(out, args)
+	for i := 0; i+1 < len(out); i += 2 {
+		if k, ok := out[i].(string); ok && IsSensitiveKey(k) {
+			out[i+1] = RedactedValue
+		}
+	}
+	return out
+}
+
+// RedactProto returns a copy of a proto message with every sensitive string field replaced
+func RedactProto(msg proto.Message) proto.Message {
+	c := proto.Clone(msg)
+	redactMessage(c.ProtoReflect())
+	return c
+}
+
+// redactMessage walks a proto message and replaces the values of sensitive string fields
+func redactMessage(m protoreflect.Message) {
+	var redact []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+		case fd.IsList():
+			if fd.Kind() == protoreflect.MessageKind {
+				l := v.List()
+				for i := 0; i < l.Len(); i++ {
+					redactMessage(l.Get(i).Message())
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			redactMessage(v.Message())
+		case fd.Kind() == protoreflect.StringKind && IsSensitiveKey(string(fd.Name())):
+			redact = append(redact, fd)
+		}
+		return true
+	})
+	for _, fd := range redact {
+		m.Set(fd, protoreflect.ValueOfString(RedactedValue))
+	}
+}
@@ -0,0 +1,84 @@
+package utilities
+
+import (
+	"context"
+	"errors"
+	"github.com/JECSand/go-grpc-server-boilerplate/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"os"
+)
+
+// tracerName is the instrumentation name used for spans created by the application
+const tracerName = "github.com/JECSand/go-grpc-server-boilerplate"
+
+// InitTracer configures the global OpenTelemetry tracer provider and W3C propagator from the config settings
+func InitTracer(cfg *config.Configuration) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	exporter, err := newSpanExporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+	serviceName := cfg.Tracer.ServiceName
+	if serviceName == "" {
+		serviceName = "go-grpc-server-boilerplate"
+	}
+	res := resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceNameKey.String(serviceName),
+		semconv.DeploymentEnvironmentKey.String(cfg.ENV),
+	)
+	sampleRatio := cfg.Tracer.SampleRatio
+	if sampleRatio <= 0 {
+		sampleRatio = 1
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// newSpanExporter returns the span exporter for the configured exporter type, or nil when tracing is disabled
+func newSpanExporter(cfg *config.Configuration) (sdktrace.SpanExporter, error) {
+	switch cfg.Tracer.Exporter {
+	case "", "none":
+		return nil, nil
+	case "stdout":
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case "otlp":
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Tracer.Endpoint)}
+		if cfg.Tracer.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(context.Background(), opts...)
+	}
+	return nil, errors.New("unrecognized tracer exporter: " + cfg.Tracer.Exporter)
+}
+
+// StartSpan starts a new span as a child of any span stored in ctx
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records a non-nil error on a span and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -2,16 +2,34 @@ package utilities
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"encoding/json"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"net"
 )
 
+// RequestIDHeader is the metadata key used to receive and return request IDs
+const RequestIDHeader = "x-request-id"
+
+// ForwardedForHeader is the metadata key the HTTP gateway passes the IP address of its client in
+const ForwardedForHeader = "x-forwarded-for"
+
+// requestIDCtxKey is the context key a request ID is stored under
+type requestIDCtxKey struct{}
+
 // JsonErr structures a standard error to return
 type JsonErr struct {
-	Code int    `json:"code"`
-	Text string `json:"text"`
+	Code    int               `json:"code"`
+	Text    string            `json:"text"`
+	Details []json.RawMessage `json:"details,omitempty"`
 }
 
 // JWTError is a struct that is used to contain a json encoded error message for any JWT related errors
@@ -66,3 +84,93 @@ func GetTokenFromContext(ctx context.Context) (string, error) {
 func AttachTokenToContext(ctx context.Context, authToken string) context.Context {
 	return metadata.AppendToOutgoingContext(ctx, "authorization", authToken)
 }
+
+// NewRequestID generates a random hex encoded request ID
+func NewRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return GenerateObjectID()
+	}
+	return hex.EncodeToString(b)
+}
+
+// GetRequestIDFromMetadata parses a well-formed request ID from incoming context metadata
+func GetRequestIDFromMetadata(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(RequestIDHeader)
+	if len(values) == 0 || len(values[0]) == 0 || len(values[0]) > 128 {
+		return "", false
+	}
+	for _, c := range values[0] {
+		if c < '!' || c > '~' {
+			return "", false
+		}
+	}
+	return values[0], true
+}
+
+// ContextWithRequestID returns a copy of ctx carrying a request ID
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDCtxKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID carried by ctx, if any
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDCtxKey{}).(string)
+	return requestID
+}
+
+// PeerIP returns the IP address of the client of a request, or an empty string when it is unknown. Requests over the
+// in-process listener come from the HTTP gateway, which passes the IP address of its own client along.
+func PeerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	if p.Addr.Network() == "bufconn" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(ForwardedForHeader)) > 0 {
+			return md.Get(ForwardedForHeader)[0]
+		}
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// GenerateSecret returns a random hex encoded secret used to sign outgoing payloads
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// GenerateCode returns a random 24 character code, short enough to share by hand, such as an invite code
+func GenerateCode() (string, error) {
+	b := make([]byte, 15)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.EncodeToString(b), nil
+}
+
+// HashSecret returns the hex encoded SHA-256 digest of a secret, used to look up secrets without storing them
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// SignPayload returns the hex encoded HMAC-SHA256 signature of a timestamp and body using secret
+func SignPayload(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,257 @@
+package utilities
+
+import (
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/mail"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxDueDrift is how far from now a due date may lie before it is rejected as a mistake
+const maxDueDrift = 100 * 365 * 24 * time.Hour
+
+// ValidationError reports the fields of a request or record that failed validation
+type ValidationError struct {
+	Message    string
+	Violations []*errdetails.BadRequest_FieldViolation
+}
+
+// Error returns the validation message
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// GRPCStatus returns an InvalidArgument status that carries the field violations as errdetails.BadRequest, like the
+// status ErrorResponse returns for a ValidationError
+func (e *ValidationError) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Message)
+	info := &errdetails.ErrorInfo{Reason: reasonForCode(codes.InvalidArgument), Domain: ErrorDomain}
+	if detailed, err := st.WithDetails(info, &errdetails.BadRequest{FieldViolations: e.Violations}); err == nil {
+		return detailed
+	}
+	return st
+}
+
+// NewValidationError returns a ValidationError listing every violation in its message
+func NewValidationError(violations []*errdetails.BadRequest_FieldViolation) *ValidationError {
+	msgs := make([]string, len(violations))
+	for i, v := range violations {
+		msgs[i] = v.Field + " " + v.Description
+	}
+	return &ValidationError{Message: "invalid request: " + strings.Join(msgs, "; "), Violations: violations}
+}
+
+// InvalidField returns a ValidationError for a single invalid field
+func InvalidField(field string, description string) *ValidationError {
+	return &ValidationError{
+		Message:    field + " " + description,
+		Violations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	}
+}
+
+// MissingFields returns a ValidationError for the required fields of a record that are not set
+func MissingFields(record string, fields []string) *ValidationError {
+	violations := make([]*errdetails.BadRequest_FieldViolation, len(fields))
+	for i, f := range fields {
+		violations[i] = &errdetails.BadRequest_FieldViolation{Field: f, Description: "is required"}
+	}
+	return &ValidationError{
+		Message:    "missing the following " + record + " fields: " + strings.Join(fields, ", "),
+		Violations: violations,
+	}
+}
+
+// FieldRule checks the value of a request field and returns a description of its violation, or "" when it is valid.
+// set reports whether the field holds a non-default value.
+type FieldRule func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string
+
+// MessageRules maps the dotted field paths of a request message, such as Email or User.GroupId, to their rules
+type MessageRules map[string][]FieldRule
+
+// resolveField walks a dotted field path of msg and returns the descriptor and value of the field it ends at
+func resolveField(msg protoreflect.Message, path string) (protoreflect.FieldDescriptor, protoreflect.Value, bool, error) {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, protoreflect.Value{}, false, fmt.Errorf("%s has no field %s", msg.Descriptor().FullName(), path)
+		}
+		if i == len(names)-1 {
+			return fd, msg.Get(fd), msg.Has(fd), nil
+		}
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() {
+			return nil, protoreflect.Value{}, false, fmt.Errorf("%s field %s is not a message", msg.Descriptor().FullName(), name)
+		}
+		msg = msg.Get(fd).Message()
+	}
+	return nil, protoreflect.Value{}, false, fmt.Errorf("%s has an empty field path", msg.Descriptor().FullName())
+}
+
+// CheckRules returns an error when the rules refer to a field path that msg does not have
+func CheckRules(msg proto.Message, rules MessageRules) error {
+	for path := range rules {
+		if _, _, _, err := resolveField(msg.ProtoReflect(), path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateMessage applies the rules to msg and returns a *ValidationError listing every violation, ordered by field
+func ValidateMessage(msg proto.Message, rules MessageRules) error {
+	paths := make([]string, 0, len(rules))
+	for path := range rules {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, path := range paths {
+		fd, v, set, err := resolveField(msg.ProtoReflect(), path)
+		if err != nil {
+			return err
+		}
+		for _, rule := range rules[path] {
+			if desc := rule(fd, v, set); desc != "" {
+				violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: path, Description: desc})
+				break
+			}
+		}
+	}
+	if len(violations) > 0 {
+		return NewValidationError(violations)
+	}
+	return nil
+}
+
+// Required rejects a field that is not set
+func Required() FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		if !set {
+			return "is required"
+		}
+		return ""
+	}
+}
+
+// Length rejects a set string field shorter than min or longer than max characters
+func Length(min int, max int) FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		if !set {
+			return ""
+		}
+		if n := utf8.RuneCountInString(v.String()); n < min || n > max {
+			return fmt.Sprintf("must be between %d and %d characters", min, max)
+		}
+		return ""
+	}
+}
+
+// Email rejects a set string field that is not a plain email address
+func Email() FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		if !set {
+			return ""
+		}
+		addr, err := mail.ParseAddress(v.String())
+		if err != nil || addr.Address != v.String() {
+			return "must be a valid email address"
+		}
+		return ""
+	}
+}
+
+// ObjectID rejects a set string field that is not a 24 character hex object id
+func ObjectID() FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		if set && (!primitive.IsValidObjectID(v.String()) || !CheckObjectID(v.String())) {
+			return "must be a 24 character hex object id"
+		}
+		return ""
+	}
+}
+
+// OneOf rejects a set string field that is not one of the allowed values
+func OneOf(allowed ...string) FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		if !set {
+			return ""
+		}
+		for _, a := range allowed {
+			if v.String() == a {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(allowed, ", ")
+	}
+}
+
+// HTTPURL rejects a set string field that is not an absolute http or https url
+func HTTPURL() FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		if !set {
+			return ""
+		}
+		u, err := url.Parse(v.String())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "must be an absolute http or https url"
+		}
+		return ""
+	}
+}
+
+// NonNegative rejects a set integer field below 0
+func NonNegative() FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		if set && v.Int() < 0 {
+			return "must not be negative"
+		}
+		return ""
+	}
+}
+
+// Enum rejects a set enum field whose value is not defined by its enum
+func Enum() FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		if set && fd.Enum().Values().ByNumber(v.Enum()) == nil {
+			return "must be a defined " + string(fd.Enum().Name()) + " value"
+		}
+		return ""
+	}
+}
+
+// Timestamp rejects a set google.protobuf.Timestamp field that is out of range
+func Timestamp() FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		if ts, ok := v.Message().Interface().(*timestamppb.Timestamp); set && ok && ts.CheckValid() != nil {
+			return "must be a valid timestamp"
+		}
+		return ""
+	}
+}
+
+// DueDate rejects a set google.protobuf.Timestamp field that is out of range or over 100 years from now
+func DueDate() FieldRule {
+	return func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) string {
+		ts, ok := v.Message().Interface().(*timestamppb.Timestamp)
+		if !set || !ok {
+			return ""
+		}
+		if ts.CheckValid() != nil {
+			return "must be a valid timestamp"
+		}
+		if drift := time.Until(ts.AsTime()); drift > maxDueDrift || drift < -maxDueDrift {
+			return "must be within 100 years of now"
+		}
+		return ""
+	}
+}